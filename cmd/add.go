@@ -15,10 +15,19 @@ import (
 // worktreeAdder interface abstracts the Manager operations needed for adding worktrees
 type worktreeAdder interface {
 	AddWorktree(worktreeName, branchName string, newBranch bool, baseBranch string) error
+	CreateWorktreeFromRef(worktreeName, newBranch, startRef string) error
+	AddWorktreeTracking(worktreeName, localBranch, remoteRef string) error
 	GetDefaultBranch() (string, error)
 	BranchExists(branch string) (bool, error)
+	ResolveCommitInPath(path, ref string) (string, error)
 	GetJiraIssues() ([]internal.JiraIssue, error)
 	GenerateBranchFromJira(jiraKey string) (string, error)
+	GetRemoteBranches() ([]string, error)
+	ListTags() ([]string, error)
+	GetDefaultRemote() string
+	SetSkipHooks(skip bool)
+	SetSparsePatterns(patterns []string)
+	NextAvailableWorktreeName(base string) string
 }
 
 // WorktreeArgs represents the resolved arguments for creating a worktree
@@ -32,6 +41,14 @@ type WorktreeArgs struct {
 // ArgsResolver handles the complex logic of resolving command arguments
 type ArgsResolver struct {
 	manager worktreeAdder
+	// base is the --base ref, used when no base-branch argument is given
+	base string
+	// workDir is where non-branch base refs such as HEAD are resolved; defaults to the current directory
+	workDir string
+	// assumeYes creates the worktree for a bare JIRA key on its generated branch without asking
+	assumeYes bool
+	// confirm asks whether to use the generated branch for a bare JIRA key; nil means never
+	confirm internal.ConfirmationFunc
 }
 
 // ResolveArgs processes command arguments and flags to determine worktree parameters
@@ -46,20 +63,27 @@ func (r *ArgsResolver) ResolveArgs(cmdArgs []string, newBranchFlag bool) (*Workt
 	}
 
 	// Resolve branch name
-	branchName, err := r.resolveBranchName(cmdArgs, newBranchFlag, args.WorktreeName)
+	branchName, newBranch, err := r.resolveBranchName(cmdArgs, newBranchFlag, args.WorktreeName)
 	if err != nil {
 		return nil, err
 	}
 
 	args.BranchName = branchName
+	args.NewBranch = newBranch
 
 	// Resolve base branch
-	var baseBranch string
+	baseBranch := r.base
 	if len(cmdArgs) > 2 {
+		if r.base != "" {
+			return nil, fmt.Errorf("specify the base either as an argument or with --base, not both")
+		}
 		baseBranch = cmdArgs[2]
 	}
+	if r.base != "" && !args.NewBranch {
+		return nil, fmt.Errorf("--base requires -b to create a new branch")
+	}
 
-	resolvedBaseBranch, err := r.resolveBaseBranch(newBranchFlag, baseBranch)
+	resolvedBaseBranch, err := r.resolveBaseBranch(args.NewBranch, baseBranch)
 	if err != nil {
 		return nil, err
 	}
@@ -68,28 +92,38 @@ func (r *ArgsResolver) ResolveArgs(cmdArgs []string, newBranchFlag bool) (*Workt
 	return args, nil
 }
 
-// resolveBranchName determines the branch name based on arguments and flags
-func (r *ArgsResolver) resolveBranchName(cmdArgs []string, newBranchFlag bool, worktreeName string) (string, error) {
+// resolveBranchName determines the branch name based on arguments and flags, and whether the branch
+// has to be created
+func (r *ArgsResolver) resolveBranchName(cmdArgs []string, newBranchFlag bool, worktreeName string) (string, bool, error) {
 	// Handle direct specification
 	if len(cmdArgs) > 1 {
-		return cmdArgs[1], nil
+		return cmdArgs[1], newBranchFlag, nil
 	}
 
 	if newBranchFlag {
 		// Generate branch name from worktree name
-		return generateBranchName(worktreeName, r.manager), nil
+		return generateBranchName(worktreeName, r.manager), true, nil
 	}
 
 	if internal.IsJiraKey(worktreeName) {
 		// Auto-suggest branch name for JIRA keys
 		suggestedBranch := generateBranchName(worktreeName, r.manager)
-		return "", fmt.Errorf("branch name required. Suggested: %s\n\nTry: gbm add %s %s -b", suggestedBranch, worktreeName, suggestedBranch)
+		if r.assumeYes || (r.confirm != nil && r.confirm(fmt.Sprintf("Create worktree '%s' on branch '%s'?", worktreeName, suggestedBranch))) {
+			// Reuse the branch if it was generated before, e.g. for a worktree that has since been removed
+			exists, err := r.manager.BranchExists(suggestedBranch)
+			if err != nil {
+				return "", false, fmt.Errorf("failed to check if branch exists: %w", err)
+			}
+			return suggestedBranch, !exists, nil
+		}
+		return "", false, fmt.Errorf("branch name required. Suggested: %s\n\nTry: gbm add %s %s -b (or gbm add %s -y)", suggestedBranch, worktreeName, suggestedBranch, worktreeName)
 	}
 
-	return "", fmt.Errorf("branch name required when not creating new branch (use -b to create new branch)")
+	return "", false, fmt.Errorf("branch name required when not creating new branch (use -b to create new branch)")
 }
 
-// resolveBaseBranch determines the base branch for new branch creation
+// resolveBaseBranch determines the base for new branch creation. Branches are kept by name;
+// any other ref (HEAD, a tag or a SHA) is resolved to its commit hash.
 func (r *ArgsResolver) resolveBaseBranch(newBranchFlag bool, baseBranch string) (string, error) {
 	if !newBranchFlag {
 		return "", nil
@@ -101,16 +135,20 @@ func (r *ArgsResolver) resolveBaseBranch(newBranchFlag bool, baseBranch string)
 		return r.manager.GetDefaultBranch()
 	}
 
-	// Validate that the base branch exists
 	exists, err := r.manager.BranchExists(baseBranch)
 	if err != nil {
 		return "", fmt.Errorf("failed to check if base branch exists: %w", err)
 	}
-	if !exists {
-		return "", fmt.Errorf("base branch '%s' does not exist", baseBranch)
+	if exists {
+		return baseBranch, nil
 	}
 
-	return baseBranch, nil
+	commit, err := r.manager.ResolveCommitInPath(r.workDir, baseBranch)
+	if err != nil {
+		return "", fmt.Errorf("base '%s' does not exist: %w", baseBranch, err)
+	}
+
+	return commit, nil
 }
 
 func newAddCommand(manager worktreeAdder) *cobra.Command {
@@ -121,11 +159,41 @@ func newAddCommand(manager worktreeAdder) *cobra.Command {
 - Create on existing branch: gbm add INGSVC-5544 existing-branch-name
 - Create on new branch: gbm add INGSVC-5544 feature/new-branch -b
 - Create on new branch with base: gbm add INGSVC-5544 feature/new-branch main -b
+- Create on new branch from the current commit: gbm add experiment -b --base HEAD
+- Create from a tag or commit: gbm add hotfix-1.2 hotfix/1.2.1 --from v1.2.0
+- Inspect a tag or commit (detached HEAD): gbm add release-check --from v1.2.0
+- Track a remote branch: gbm add myfeature --track origin/feature/x
+- Create from a JIRA issue: gbm add PROJ-123 -y (branch named from the issue summary)
+- Check out only some directories: gbm add api feature/api -b --sparse services/api --sparse libs
+- Pick a free worktree name: gbm add experiment experiment-branch --auto-suffix (experiment-2, ...)
 - Tab completion: Shows JIRA keys with summaries, suggests branch names when needed
 
-The third argument specifies which branch/commit to use as the starting point for new branches.
-If not specified for new branches, the repository's default branch (main/master) is used.
-This matches the behavior of 'git worktree add'.`,
+The third argument (or --base) specifies which branch, tag or commit to use as the starting
+point for new branches; HEAD refers to the worktree you run the command from. If not specified
+for new branches, the repository's default branch (main/master) is used. This matches the
+behavior of 'git worktree add'.
+
+With --from, the worktree starts at the given tag or commit instead of a branch. A branch
+name (or -b to generate one) creates a new branch at that ref; otherwise the worktree is
+checked out with a detached HEAD.
+
+With --track, a new local branch is created from the given remote branch with its upstream
+set. The local branch is named after the remote branch unless a branch name is given.
+
+For a bare JIRA key, the branch name is generated from the issue summary (or
+feature/<key> when the issue can't be fetched) and gbm asks before using it; -y skips
+the question.
+
+With --sparse, the worktree is a cone-mode sparse checkout containing only the given
+directories (plus files at the top level). The directories are remembered, so 'gbm sync'
+restores them when it recreates the worktree. Other worktrees keep a full checkout.
+Sparse worktrees need git 2.36 or newer.
+
+With --auto-suffix, a worktree name that is already taken gets -2, -3, ... appended until
+a free one is found. Only the worktree name changes; the branch is resolved as usual.
+
+Commands listed under [hooks] post_create in .gbm/config.toml run in the new worktree after
+it is created; use --no-hooks to skip them.`,
 		Args: cobra.MinimumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if manager == nil {
@@ -133,12 +201,49 @@ This matches the behavior of 'git worktree add'.`,
 			}
 
 			newBranch, _ := cmd.Flags().GetBool("new-branch")
+			base, _ := cmd.Flags().GetString("base")
+			fromRef, _ := cmd.Flags().GetString("from")
+			trackRef, _ := cmd.Flags().GetString("track")
+			noHooks, _ := cmd.Flags().GetBool("no-hooks")
+			assumeYes, _ := cmd.Flags().GetBool("yes")
+			autoSuffix, _ := cmd.Flags().GetBool("auto-suffix")
+			sparse, _ := cmd.Flags().GetStringSlice("sparse")
+
+			if noHooks {
+				manager.SetSkipHooks(true)
+			}
+			if len(sparse) > 0 {
+				manager.SetSparsePatterns(sparse)
+			}
 
-			resolver := &ArgsResolver{manager: manager}
+			if trackRef != "" {
+				if newBranch || fromRef != "" || base != "" {
+					return fmt.Errorf("--track cannot be combined with -b, --from or --base")
+				}
+				if autoSuffix {
+					args = withWorktreeName(args, nextAvailableWorktreeName(manager, args[0]))
+				}
+				return handleAddTracking(manager, args, trackRef)
+			}
+
+			if fromRef != "" {
+				if base != "" {
+					return fmt.Errorf("--from cannot be combined with --base")
+				}
+				if autoSuffix {
+					args = withWorktreeName(args, nextAvailableWorktreeName(manager, args[0]))
+				}
+				return handleAddFromRef(manager, args, newBranch, fromRef)
+			}
+
+			resolver := &ArgsResolver{manager: manager, base: base, assumeYes: assumeYes, confirm: newConfirmation(cmd)}
 			worktreeArgs, err := resolver.ResolveArgs(args, newBranch)
 			if err != nil {
 				return err
 			}
+			if autoSuffix {
+				worktreeArgs.WorktreeName = nextAvailableWorktreeName(manager, worktreeArgs.WorktreeName)
+			}
 
 			PrintInfo("Adding worktree '%s' on branch '%s'", worktreeArgs.WorktreeName, worktreeArgs.BranchName)
 
@@ -158,6 +263,54 @@ This matches the behavior of 'git worktree add'.`,
 	}
 
 	cmd.Flags().BoolP("new-branch", "b", false, "Create a new branch for the worktree")
+	cmd.Flags().String("base", "", "Branch, tag, commit or HEAD to start the new branch from (requires -b)")
+	cmd.Flags().String("from", "", "Start the worktree from a tag or commit instead of a branch")
+	cmd.Flags().String("track", "", "Create a local branch tracking the given remote branch (e.g. origin/feature/x)")
+	cmd.Flags().Bool("no-hooks", false, "Skip the [hooks] post_create commands from .gbm/config.toml")
+	cmd.Flags().StringSlice("sparse", nil, "Only check out these directories (repeatable or comma-separated)")
+	cmd.Flags().Bool("auto-suffix", false, "Append -2, -3, ... to the worktree name if it is already taken")
+
+	_ = cmd.RegisterFlagCompletionFunc("track", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if manager == nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		branches, err := manager.GetRemoteBranches()
+		if err != nil {
+			PrintVerbose("Failed to list remote branches for completion: %v", err)
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		var completions []string
+		for _, branch := range branches {
+			remoteRef := internal.RemoteFor(manager.GetDefaultRemote(), branch)
+			if strings.HasPrefix(remoteRef, toComplete) {
+				completions = append(completions, remoteRef)
+			}
+		}
+		return completions, cobra.ShellCompDirectiveNoFileComp
+	})
+
+	_ = cmd.RegisterFlagCompletionFunc("from", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if manager == nil {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		// Tags are listed newest first so recent releases come up first
+		tags, err := manager.ListTags()
+		if err != nil {
+			PrintVerbose("Failed to list tags for completion: %v", err)
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		var completions []string
+		for _, tag := range tags {
+			if strings.HasPrefix(tag, toComplete) {
+				completions = append(completions, tag)
+			}
+		}
+		return completions, cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveKeepOrder
+	})
 
 	// Add JIRA key completions for the first positional argument
 	cmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -208,6 +361,75 @@ This matches the behavior of 'git worktree add'.`,
 	return cmd
 }
 
+// handleAddFromRef creates a worktree starting at a tag or commit. A new branch is created when
+// a branch name is given or -b is set; otherwise the worktree is left on a detached HEAD.
+func handleAddFromRef(adder worktreeAdder, cmdArgs []string, newBranchFlag bool, fromRef string) error {
+	if len(cmdArgs) > 2 {
+		return fmt.Errorf("base branch cannot be combined with --from; the worktree starts at '%s'", fromRef)
+	}
+
+	worktreeName := cmdArgs[0]
+	var branchName string
+	if len(cmdArgs) > 1 {
+		branchName = cmdArgs[1]
+	} else if newBranchFlag {
+		branchName = generateBranchName(worktreeName, adder)
+	}
+
+	if branchName != "" {
+		PrintInfo("Adding worktree '%s' on new branch '%s' from '%s'", worktreeName, branchName, fromRef)
+	} else {
+		PrintInfo("Adding worktree '%s' at '%s' (detached HEAD)", worktreeName, fromRef)
+	}
+
+	if err := adder.CreateWorktreeFromRef(worktreeName, branchName, fromRef); err != nil {
+		return fmt.Errorf("failed to add worktree: %w", err)
+	}
+
+	PrintInfo("Worktree '%s' added successfully", worktreeName)
+
+	return nil
+}
+
+// handleAddTracking creates a worktree on a new local branch tracking remoteRef. An optional second
+// argument names the local branch; otherwise it matches the remote branch name.
+func handleAddTracking(adder worktreeAdder, cmdArgs []string, remoteRef string) error {
+	if len(cmdArgs) > 2 {
+		return fmt.Errorf("base branch cannot be combined with --track; the worktree starts at '%s'", remoteRef)
+	}
+
+	worktreeName := cmdArgs[0]
+	var localBranch string
+	if len(cmdArgs) > 1 {
+		localBranch = cmdArgs[1]
+	}
+
+	PrintInfo("Adding worktree '%s' tracking '%s'", worktreeName, remoteRef)
+
+	if err := adder.AddWorktreeTracking(worktreeName, localBranch, remoteRef); err != nil {
+		return fmt.Errorf("failed to add worktree: %w", err)
+	}
+
+	PrintInfo("Worktree '%s' added successfully", worktreeName)
+
+	return nil
+}
+
+// nextAvailableWorktreeName returns a free worktree name based on name, telling the user when it
+// had to be changed
+func nextAvailableWorktreeName(adder worktreeAdder, name string) string {
+	available := adder.NextAvailableWorktreeName(name)
+	if available != name {
+		PrintInfo("Worktree '%s' already exists, using '%s'", name, available)
+	}
+	return available
+}
+
+// withWorktreeName returns a copy of the command arguments with the worktree name replaced
+func withWorktreeName(cmdArgs []string, worktreeName string) []string {
+	return append([]string{worktreeName}, cmdArgs[1:]...)
+}
+
 func generateBranchName(worktreeName string, manager worktreeAdder) string {
 	// Check if this is a JIRA key first
 	if internal.IsJiraKey(worktreeName) {
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"fmt"
 	"testing"
 
 	"gbm/internal"
@@ -108,16 +109,41 @@ func TestArgsResolver_ResolveArgs(t *testing.T) {
 					BranchExistsFunc: func(branch string) (bool, error) {
 						return false, nil
 					},
+					ResolveCommitInPathFunc: func(path, ref string) (string, error) {
+						return "", fmt.Errorf("'%s' is not a branch, tag or commit", ref)
+					},
 				}
 			},
 			expectErr: func(t *testing.T, err error) {
 				assert.Error(t, err)
-				assert.Contains(t, err.Error(), "base branch 'invalid-base' does not exist")
+				assert.Contains(t, err.Error(), "base 'invalid-base' does not exist")
 			},
 			expect: func(t *testing.T, result *WorktreeArgs) {
 				assert.Nil(t, result)
 			},
 		},
+		{
+			name:      "new branch based on HEAD resolves to a commit",
+			args:      []string{"experiment", "experiment/try", "HEAD"},
+			newBranch: true,
+			mockSetup: func() *worktreeAdderMock {
+				return &worktreeAdderMock{
+					BranchExistsFunc: func(branch string) (bool, error) {
+						return false, nil
+					},
+					ResolveCommitInPathFunc: func(path, ref string) (string, error) {
+						return "0123456789abcdef0123456789abcdef01234567", nil
+					},
+				}
+			},
+			expectErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+			expect: func(t *testing.T, result *WorktreeArgs) {
+				assert.Equal(t, "experiment/try", result.BranchName)
+				assert.Equal(t, "0123456789abcdef0123456789abcdef01234567", result.ResolvedBaseBranch)
+			},
+		},
 		{
 			name:      "JIRA key without branch name should suggest",
 			args:      []string{"PROJ-123"},
@@ -154,6 +180,102 @@ func TestArgsResolver_ResolveArgs(t *testing.T) {
 	}
 }
 
+func TestArgsResolver_JiraKeyWithoutBranch(t *testing.T) {
+	newMock := func(existing ...string) *worktreeAdderMock {
+		return &worktreeAdderMock{
+			GenerateBranchFromJiraFunc: func(jiraKey string) (string, error) {
+				return "feature/PROJ-123-implement-feature", nil
+			},
+			BranchExistsFunc: func(branch string) (bool, error) {
+				for _, name := range existing {
+					if name == branch {
+						return true, nil
+					}
+				}
+				return false, nil
+			},
+			GetDefaultBranchFunc: func() (string, error) {
+				return "main", nil
+			},
+		}
+	}
+
+	t.Run("yes creates the generated branch from the default branch", func(t *testing.T) {
+		resolver := &ArgsResolver{manager: newMock(), assumeYes: true}
+		result, err := resolver.ResolveArgs([]string{"PROJ-123"}, false)
+		assert.NoError(t, err)
+		assert.Equal(t, "PROJ-123", result.WorktreeName)
+		assert.Equal(t, "feature/PROJ-123-implement-feature", result.BranchName)
+		assert.True(t, result.NewBranch)
+		assert.Equal(t, "main", result.ResolvedBaseBranch)
+	})
+
+	t.Run("yes reuses an existing generated branch", func(t *testing.T) {
+		resolver := &ArgsResolver{manager: newMock("feature/PROJ-123-implement-feature"), assumeYes: true}
+		result, err := resolver.ResolveArgs([]string{"PROJ-123"}, false)
+		assert.NoError(t, err)
+		assert.Equal(t, "feature/PROJ-123-implement-feature", result.BranchName)
+		assert.False(t, result.NewBranch)
+		assert.Equal(t, "", result.ResolvedBaseBranch)
+	})
+
+	t.Run("yes honours --base", func(t *testing.T) {
+		resolver := &ArgsResolver{manager: newMock("develop"), base: "develop", assumeYes: true}
+		result, err := resolver.ResolveArgs([]string{"PROJ-123"}, false)
+		assert.NoError(t, err)
+		assert.Equal(t, "develop", result.ResolvedBaseBranch)
+	})
+
+	t.Run("confirmation accepts the generated branch", func(t *testing.T) {
+		var asked string
+		resolver := &ArgsResolver{manager: newMock(), confirm: func(message string) bool {
+			asked = message
+			return true
+		}}
+		result, err := resolver.ResolveArgs([]string{"PROJ-123"}, false)
+		assert.NoError(t, err)
+		assert.Contains(t, asked, "feature/PROJ-123-implement-feature")
+		assert.True(t, result.NewBranch)
+	})
+
+	t.Run("declining keeps the suggestion error", func(t *testing.T) {
+		resolver := &ArgsResolver{manager: newMock(), confirm: func(message string) bool { return false }}
+		_, err := resolver.ResolveArgs([]string{"PROJ-123"}, false)
+		assert.ErrorContains(t, err, "gbm add PROJ-123 -y")
+	})
+
+	t.Run("JIRA lookup failure falls back to feature/<key>", func(t *testing.T) {
+		mock := newMock()
+		mock.GenerateBranchFromJiraFunc = func(jiraKey string) (string, error) {
+			return "", fmt.Errorf("jira CLI not found")
+		}
+		resolver := &ArgsResolver{manager: mock, assumeYes: true}
+		result, err := resolver.ResolveArgs([]string{"PROJ-123"}, false)
+		assert.NoError(t, err)
+		assert.Equal(t, "feature/proj-123", result.BranchName)
+		assert.True(t, result.NewBranch)
+	})
+}
+
+func TestArgsResolver_BaseFlag(t *testing.T) {
+	mock := &worktreeAdderMock{
+		BranchExistsFunc: func(branch string) (bool, error) {
+			return branch == "develop", nil
+		},
+	}
+
+	resolver := &ArgsResolver{manager: mock, base: "develop"}
+	result, err := resolver.ResolveArgs([]string{"test-worktree", "new-branch"}, true)
+	assert.NoError(t, err)
+	assert.Equal(t, "develop", result.ResolvedBaseBranch)
+
+	_, err = resolver.ResolveArgs([]string{"test-worktree", "new-branch", "main"}, true)
+	assert.ErrorContains(t, err, "not both")
+
+	_, err = resolver.ResolveArgs([]string{"test-worktree", "new-branch"}, false)
+	assert.ErrorContains(t, err, "--base requires -b")
+}
+
 func TestGenerateBranchName(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -401,6 +523,279 @@ func TestAddCommand_Execute(t *testing.T) {
 				assert.Len(t, mock.AddWorktreeCalls(), 1)
 			},
 		},
+		{
+			name: "from ref with explicit branch",
+			args: []string{"hotfix", "hotfix/1.2.1", "--from", "v1.2.0"},
+			mockSetup: func() *worktreeAdderMock {
+				return &worktreeAdderMock{
+					CreateWorktreeFromRefFunc: func(worktreeName, newBranch, startRef string) error {
+						return nil
+					},
+				}
+			},
+			expectErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+			expect: func(t *testing.T, mock *worktreeAdderMock) {
+				assert.Len(t, mock.AddWorktreeCalls(), 0)
+				assert.Len(t, mock.CreateWorktreeFromRefCalls(), 1)
+
+				call := mock.CreateWorktreeFromRefCalls()[0]
+				assert.Equal(t, "hotfix", call.WorktreeName)
+				assert.Equal(t, "hotfix/1.2.1", call.NewBranch)
+				assert.Equal(t, "v1.2.0", call.StartRef)
+			},
+		},
+		{
+			name: "from ref with generated branch",
+			args: []string{"hotfix", "-b", "--from", "abc1234"},
+			mockSetup: func() *worktreeAdderMock {
+				return &worktreeAdderMock{
+					CreateWorktreeFromRefFunc: func(worktreeName, newBranch, startRef string) error {
+						return nil
+					},
+				}
+			},
+			expectErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+			expect: func(t *testing.T, mock *worktreeAdderMock) {
+				call := mock.CreateWorktreeFromRefCalls()[0]
+				assert.Equal(t, "feature/hotfix", call.NewBranch)
+				assert.Equal(t, "abc1234", call.StartRef)
+			},
+		},
+		{
+			name: "from ref without branch is detached",
+			args: []string{"release-check", "--from", "v1.2.0"},
+			mockSetup: func() *worktreeAdderMock {
+				return &worktreeAdderMock{
+					CreateWorktreeFromRefFunc: func(worktreeName, newBranch, startRef string) error {
+						return nil
+					},
+				}
+			},
+			expectErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+			expect: func(t *testing.T, mock *worktreeAdderMock) {
+				call := mock.CreateWorktreeFromRefCalls()[0]
+				assert.Equal(t, "release-check", call.WorktreeName)
+				assert.Empty(t, call.NewBranch)
+			},
+		},
+		{
+			name: "from ref rejects base branch argument",
+			args: []string{"hotfix", "hotfix/1.2.1", "main", "--from", "v1.2.0"},
+			mockSetup: func() *worktreeAdderMock {
+				return &worktreeAdderMock{}
+			},
+			expectErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "cannot be combined with --from")
+			},
+			expect: func(t *testing.T, mock *worktreeAdderMock) {
+				assert.Len(t, mock.CreateWorktreeFromRefCalls(), 0)
+			},
+		},
+		{
+			name: "from ref error",
+			args: []string{"hotfix", "--from", "missing"},
+			mockSetup: func() *worktreeAdderMock {
+				return &worktreeAdderMock{
+					CreateWorktreeFromRefFunc: func(worktreeName, newBranch, startRef string) error {
+						return assert.AnError
+					},
+				}
+			},
+			expectErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "failed to add worktree")
+			},
+			expect: func(t *testing.T, mock *worktreeAdderMock) {
+				assert.Len(t, mock.CreateWorktreeFromRefCalls(), 1)
+			},
+		},
+		{
+			name: "track remote branch",
+			args: []string{"myfeature", "--track", "origin/feature/x"},
+			mockSetup: func() *worktreeAdderMock {
+				return &worktreeAdderMock{
+					AddWorktreeTrackingFunc: func(worktreeName, localBranch, remoteRef string) error {
+						return nil
+					},
+				}
+			},
+			expectErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+			expect: func(t *testing.T, mock *worktreeAdderMock) {
+				assert.Len(t, mock.AddWorktreeCalls(), 0)
+				assert.Len(t, mock.AddWorktreeTrackingCalls(), 1)
+
+				call := mock.AddWorktreeTrackingCalls()[0]
+				assert.Equal(t, "myfeature", call.WorktreeName)
+				assert.Empty(t, call.LocalBranch)
+				assert.Equal(t, "origin/feature/x", call.RemoteRef)
+			},
+		},
+		{
+			name: "track remote branch with local branch name",
+			args: []string{"myfeature", "feature/mine", "--track", "origin/feature/x"},
+			mockSetup: func() *worktreeAdderMock {
+				return &worktreeAdderMock{
+					AddWorktreeTrackingFunc: func(worktreeName, localBranch, remoteRef string) error {
+						return nil
+					},
+				}
+			},
+			expectErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+			expect: func(t *testing.T, mock *worktreeAdderMock) {
+				call := mock.AddWorktreeTrackingCalls()[0]
+				assert.Equal(t, "feature/mine", call.LocalBranch)
+			},
+		},
+		{
+			name: "track rejects new branch flag",
+			args: []string{"myfeature", "-b", "--track", "origin/feature/x"},
+			mockSetup: func() *worktreeAdderMock {
+				return &worktreeAdderMock{}
+			},
+			expectErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "--track cannot be combined")
+			},
+			expect: func(t *testing.T, mock *worktreeAdderMock) {
+				assert.Len(t, mock.AddWorktreeTrackingCalls(), 0)
+			},
+		},
+		{
+			name: "track error",
+			args: []string{"myfeature", "--track", "origin/missing"},
+			mockSetup: func() *worktreeAdderMock {
+				return &worktreeAdderMock{
+					AddWorktreeTrackingFunc: func(worktreeName, localBranch, remoteRef string) error {
+						return assert.AnError
+					},
+				}
+			},
+			expectErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "failed to add worktree")
+			},
+			expect: func(t *testing.T, mock *worktreeAdderMock) {
+				assert.Len(t, mock.AddWorktreeTrackingCalls(), 1)
+			},
+		},
+		{
+			name: "no-hooks disables post_create hooks",
+			args: []string{"myfeature", "--track", "origin/feature/x", "--no-hooks"},
+			mockSetup: func() *worktreeAdderMock {
+				return &worktreeAdderMock{
+					SetSkipHooksFunc: func(skip bool) {},
+					AddWorktreeTrackingFunc: func(worktreeName, localBranch, remoteRef string) error {
+						return nil
+					},
+				}
+			},
+			expectErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+			expect: func(t *testing.T, mock *worktreeAdderMock) {
+				assert.Len(t, mock.SetSkipHooksCalls(), 1)
+				assert.True(t, mock.SetSkipHooksCalls()[0].Skip)
+				assert.Len(t, mock.AddWorktreeTrackingCalls(), 1)
+			},
+		},
+		{
+			name: "sparse directories are passed to the manager",
+			args: []string{"api", "feature/api", "-b", "--sparse", "services/api", "--sparse", "libs,tools"},
+			mockSetup: func() *worktreeAdderMock {
+				return &worktreeAdderMock{
+					GetDefaultBranchFunc: func() (string, error) {
+						return "main", nil
+					},
+					SetSparsePatternsFunc: func(patterns []string) {},
+					AddWorktreeFunc: func(worktreeName, branchName string, newBranch bool, baseBranch string) error {
+						return nil
+					},
+				}
+			},
+			expectErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+			expect: func(t *testing.T, mock *worktreeAdderMock) {
+				assert.Len(t, mock.SetSparsePatternsCalls(), 1)
+				assert.Equal(t, []string{"services/api", "libs", "tools"}, mock.SetSparsePatternsCalls()[0].Patterns)
+				assert.Len(t, mock.AddWorktreeCalls(), 1)
+			},
+		},
+		{
+			name: "auto-suffix picks a free worktree name but keeps the branch",
+			args: []string{"experiment", "-b", "--auto-suffix"},
+			mockSetup: func() *worktreeAdderMock {
+				return &worktreeAdderMock{
+					GetDefaultBranchFunc: func() (string, error) {
+						return "main", nil
+					},
+					NextAvailableWorktreeNameFunc: func(base string) string {
+						return base + "-2"
+					},
+					AddWorktreeFunc: func(worktreeName, branchName string, newBranch bool, baseBranch string) error {
+						return nil
+					},
+				}
+			},
+			expectErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+			expect: func(t *testing.T, mock *worktreeAdderMock) {
+				assert.Len(t, mock.NextAvailableWorktreeNameCalls(), 1)
+				assert.Equal(t, "experiment", mock.NextAvailableWorktreeNameCalls()[0].Base)
+
+				addCall := mock.AddWorktreeCalls()[0]
+				assert.Equal(t, "experiment-2", addCall.WorktreeName)
+				assert.Equal(t, "feature/experiment", addCall.BranchName)
+			},
+		},
+		{
+			name: "auto-suffix applies to tracking worktrees",
+			args: []string{"myfeature", "--track", "origin/feature/x", "--auto-suffix"},
+			mockSetup: func() *worktreeAdderMock {
+				return &worktreeAdderMock{
+					NextAvailableWorktreeNameFunc: func(base string) string {
+						return base + "-3"
+					},
+					AddWorktreeTrackingFunc: func(worktreeName, localBranch, remoteRef string) error {
+						return nil
+					},
+				}
+			},
+			expectErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+			expect: func(t *testing.T, mock *worktreeAdderMock) {
+				call := mock.AddWorktreeTrackingCalls()[0]
+				assert.Equal(t, "myfeature-3", call.WorktreeName)
+				assert.Equal(t, "origin/feature/x", call.RemoteRef)
+			},
+		},
+		{
+			name: "without auto-suffix the name is not checked",
+			args: []string{"experiment", "experiment-branch"},
+			mockSetup: func() *worktreeAdderMock {
+				return &worktreeAdderMock{
+					AddWorktreeFunc: func(worktreeName, branchName string, newBranch bool, baseBranch string) error {
+						return nil
+					},
+				}
+			},
+			expectErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+			expect: func(t *testing.T, mock *worktreeAdderMock) {
+				assert.Len(t, mock.NextAvailableWorktreeNameCalls(), 0)
+				assert.Equal(t, "experiment", mock.AddWorktreeCalls()[0].WorktreeName)
+			},
+		},
 		{
 			name: "GetDefaultBranch error",
 			args: []string{"test-worktree", "-b"},
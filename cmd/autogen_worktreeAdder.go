@@ -21,18 +21,45 @@ var _ worktreeAdder = &worktreeAdderMock{}
 //			AddWorktreeFunc: func(worktreeName string, branchName string, newBranch bool, baseBranch string) error {
 //				panic("mock out the AddWorktree method")
 //			},
+//			AddWorktreeTrackingFunc: func(worktreeName string, localBranch string, remoteRef string) error {
+//				panic("mock out the AddWorktreeTracking method")
+//			},
 //			BranchExistsFunc: func(branch string) (bool, error) {
 //				panic("mock out the BranchExists method")
 //			},
+//			CreateWorktreeFromRefFunc: func(worktreeName string, newBranch string, startRef string) error {
+//				panic("mock out the CreateWorktreeFromRef method")
+//			},
 //			GenerateBranchFromJiraFunc: func(jiraKey string) (string, error) {
 //				panic("mock out the GenerateBranchFromJira method")
 //			},
 //			GetDefaultBranchFunc: func() (string, error) {
 //				panic("mock out the GetDefaultBranch method")
 //			},
+//			GetDefaultRemoteFunc: func() string {
+//				panic("mock out the GetDefaultRemote method")
+//			},
 //			GetJiraIssuesFunc: func() ([]internal.JiraIssue, error) {
 //				panic("mock out the GetJiraIssues method")
 //			},
+//			GetRemoteBranchesFunc: func() ([]string, error) {
+//				panic("mock out the GetRemoteBranches method")
+//			},
+//			ListTagsFunc: func() ([]string, error) {
+//				panic("mock out the ListTags method")
+//			},
+//			NextAvailableWorktreeNameFunc: func(base string) string {
+//				panic("mock out the NextAvailableWorktreeName method")
+//			},
+//			ResolveCommitInPathFunc: func(path string, ref string) (string, error) {
+//				panic("mock out the ResolveCommitInPath method")
+//			},
+//			SetSkipHooksFunc: func(skip bool) {
+//				panic("mock out the SetSkipHooks method")
+//			},
+//			SetSparsePatternsFunc: func(patterns []string) {
+//				panic("mock out the SetSparsePatterns method")
+//			},
 //		}
 //
 //		// use mockedworktreeAdder in code that requires worktreeAdder
@@ -43,18 +70,45 @@ type worktreeAdderMock struct {
 	// AddWorktreeFunc mocks the AddWorktree method.
 	AddWorktreeFunc func(worktreeName string, branchName string, newBranch bool, baseBranch string) error
 
+	// AddWorktreeTrackingFunc mocks the AddWorktreeTracking method.
+	AddWorktreeTrackingFunc func(worktreeName string, localBranch string, remoteRef string) error
+
 	// BranchExistsFunc mocks the BranchExists method.
 	BranchExistsFunc func(branch string) (bool, error)
 
+	// CreateWorktreeFromRefFunc mocks the CreateWorktreeFromRef method.
+	CreateWorktreeFromRefFunc func(worktreeName string, newBranch string, startRef string) error
+
 	// GenerateBranchFromJiraFunc mocks the GenerateBranchFromJira method.
 	GenerateBranchFromJiraFunc func(jiraKey string) (string, error)
 
 	// GetDefaultBranchFunc mocks the GetDefaultBranch method.
 	GetDefaultBranchFunc func() (string, error)
 
+	// GetDefaultRemoteFunc mocks the GetDefaultRemote method.
+	GetDefaultRemoteFunc func() string
+
 	// GetJiraIssuesFunc mocks the GetJiraIssues method.
 	GetJiraIssuesFunc func() ([]internal.JiraIssue, error)
 
+	// GetRemoteBranchesFunc mocks the GetRemoteBranches method.
+	GetRemoteBranchesFunc func() ([]string, error)
+
+	// ListTagsFunc mocks the ListTags method.
+	ListTagsFunc func() ([]string, error)
+
+	// NextAvailableWorktreeNameFunc mocks the NextAvailableWorktreeName method.
+	NextAvailableWorktreeNameFunc func(base string) string
+
+	// ResolveCommitInPathFunc mocks the ResolveCommitInPath method.
+	ResolveCommitInPathFunc func(path string, ref string) (string, error)
+
+	// SetSkipHooksFunc mocks the SetSkipHooks method.
+	SetSkipHooksFunc func(skip bool)
+
+	// SetSparsePatternsFunc mocks the SetSparsePatterns method.
+	SetSparsePatternsFunc func(patterns []string)
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// AddWorktree holds details about calls to the AddWorktree method.
@@ -68,11 +122,29 @@ type worktreeAdderMock struct {
 			// BaseBranch is the baseBranch argument value.
 			BaseBranch string
 		}
+		// AddWorktreeTracking holds details about calls to the AddWorktreeTracking method.
+		AddWorktreeTracking []struct {
+			// WorktreeName is the worktreeName argument value.
+			WorktreeName string
+			// LocalBranch is the localBranch argument value.
+			LocalBranch string
+			// RemoteRef is the remoteRef argument value.
+			RemoteRef string
+		}
 		// BranchExists holds details about calls to the BranchExists method.
 		BranchExists []struct {
 			// Branch is the branch argument value.
 			Branch string
 		}
+		// CreateWorktreeFromRef holds details about calls to the CreateWorktreeFromRef method.
+		CreateWorktreeFromRef []struct {
+			// WorktreeName is the worktreeName argument value.
+			WorktreeName string
+			// NewBranch is the newBranch argument value.
+			NewBranch string
+			// StartRef is the startRef argument value.
+			StartRef string
+		}
 		// GenerateBranchFromJira holds details about calls to the GenerateBranchFromJira method.
 		GenerateBranchFromJira []struct {
 			// JiraKey is the jiraKey argument value.
@@ -81,15 +153,55 @@ type worktreeAdderMock struct {
 		// GetDefaultBranch holds details about calls to the GetDefaultBranch method.
 		GetDefaultBranch []struct {
 		}
+		// GetDefaultRemote holds details about calls to the GetDefaultRemote method.
+		GetDefaultRemote []struct {
+		}
 		// GetJiraIssues holds details about calls to the GetJiraIssues method.
 		GetJiraIssues []struct {
 		}
+		// GetRemoteBranches holds details about calls to the GetRemoteBranches method.
+		GetRemoteBranches []struct {
+		}
+		// ListTags holds details about calls to the ListTags method.
+		ListTags []struct {
+		}
+		// NextAvailableWorktreeName holds details about calls to the NextAvailableWorktreeName method.
+		NextAvailableWorktreeName []struct {
+			// Base is the base argument value.
+			Base string
+		}
+		// ResolveCommitInPath holds details about calls to the ResolveCommitInPath method.
+		ResolveCommitInPath []struct {
+			// Path is the path argument value.
+			Path string
+			// Ref is the ref argument value.
+			Ref string
+		}
+		// SetSkipHooks holds details about calls to the SetSkipHooks method.
+		SetSkipHooks []struct {
+			// Skip is the skip argument value.
+			Skip bool
+		}
+		// SetSparsePatterns holds details about calls to the SetSparsePatterns method.
+		SetSparsePatterns []struct {
+			// Patterns is the patterns argument value.
+			Patterns []string
+		}
 	}
-	lockAddWorktree            sync.RWMutex
-	lockBranchExists           sync.RWMutex
-	lockGenerateBranchFromJira sync.RWMutex
-	lockGetDefaultBranch       sync.RWMutex
-	lockGetJiraIssues          sync.RWMutex
+	lockAddWorktree               sync.RWMutex
+	lockAddWorktreeTracking       sync.RWMutex
+	lockBranchExists              sync.RWMutex
+	lockCreateWorktreeFromRef     sync.RWMutex
+	lockGenerateBranchFromJira    sync.RWMutex
+	lockGetDefaultBranch          sync.RWMutex
+	lockGetDefaultRemote          sync.RWMutex
+	lockGetJiraIssues             sync.RWMutex
+	lockGetRemoteBranches         sync.RWMutex
+	lockListTags                  sync.RWMutex
+	lockNextAvailableWorktreeName sync.RWMutex
+	lockResolveCommitInPath       sync.RWMutex
+	lockSetSkipHooks              sync.RWMutex
+	lockSetSparsePatterns         sync.RWMutex
 }
 
 // AddWorktree calls AddWorktreeFunc.
@@ -136,6 +248,46 @@ func (mock *worktreeAdderMock) AddWorktreeCalls() []struct {
 	return calls
 }
 
+// AddWorktreeTracking calls AddWorktreeTrackingFunc.
+func (mock *worktreeAdderMock) AddWorktreeTracking(worktreeName string, localBranch string, remoteRef string) error {
+	if mock.AddWorktreeTrackingFunc == nil {
+		panic("worktreeAdderMock.AddWorktreeTrackingFunc: method is nil but worktreeAdder.AddWorktreeTracking was just called")
+	}
+	callInfo := struct {
+		WorktreeName string
+		LocalBranch  string
+		RemoteRef    string
+	}{
+		WorktreeName: worktreeName,
+		LocalBranch:  localBranch,
+		RemoteRef:    remoteRef,
+	}
+	mock.lockAddWorktreeTracking.Lock()
+	mock.calls.AddWorktreeTracking = append(mock.calls.AddWorktreeTracking, callInfo)
+	mock.lockAddWorktreeTracking.Unlock()
+	return mock.AddWorktreeTrackingFunc(worktreeName, localBranch, remoteRef)
+}
+
+// AddWorktreeTrackingCalls gets all the calls that were made to AddWorktreeTracking.
+// Check the length with:
+//
+//	len(mockedworktreeAdder.AddWorktreeTrackingCalls())
+func (mock *worktreeAdderMock) AddWorktreeTrackingCalls() []struct {
+	WorktreeName string
+	LocalBranch  string
+	RemoteRef    string
+} {
+	var calls []struct {
+		WorktreeName string
+		LocalBranch  string
+		RemoteRef    string
+	}
+	mock.lockAddWorktreeTracking.RLock()
+	calls = mock.calls.AddWorktreeTracking
+	mock.lockAddWorktreeTracking.RUnlock()
+	return calls
+}
+
 // BranchExists calls BranchExistsFunc.
 func (mock *worktreeAdderMock) BranchExists(branch string) (bool, error) {
 	if mock.BranchExistsFunc == nil {
@@ -168,6 +320,46 @@ func (mock *worktreeAdderMock) BranchExistsCalls() []struct {
 	return calls
 }
 
+// CreateWorktreeFromRef calls CreateWorktreeFromRefFunc.
+func (mock *worktreeAdderMock) CreateWorktreeFromRef(worktreeName string, newBranch string, startRef string) error {
+	if mock.CreateWorktreeFromRefFunc == nil {
+		panic("worktreeAdderMock.CreateWorktreeFromRefFunc: method is nil but worktreeAdder.CreateWorktreeFromRef was just called")
+	}
+	callInfo := struct {
+		WorktreeName string
+		NewBranch    string
+		StartRef     string
+	}{
+		WorktreeName: worktreeName,
+		NewBranch:    newBranch,
+		StartRef:     startRef,
+	}
+	mock.lockCreateWorktreeFromRef.Lock()
+	mock.calls.CreateWorktreeFromRef = append(mock.calls.CreateWorktreeFromRef, callInfo)
+	mock.lockCreateWorktreeFromRef.Unlock()
+	return mock.CreateWorktreeFromRefFunc(worktreeName, newBranch, startRef)
+}
+
+// CreateWorktreeFromRefCalls gets all the calls that were made to CreateWorktreeFromRef.
+// Check the length with:
+//
+//	len(mockedworktreeAdder.CreateWorktreeFromRefCalls())
+func (mock *worktreeAdderMock) CreateWorktreeFromRefCalls() []struct {
+	WorktreeName string
+	NewBranch    string
+	StartRef     string
+} {
+	var calls []struct {
+		WorktreeName string
+		NewBranch    string
+		StartRef     string
+	}
+	mock.lockCreateWorktreeFromRef.RLock()
+	calls = mock.calls.CreateWorktreeFromRef
+	mock.lockCreateWorktreeFromRef.RUnlock()
+	return calls
+}
+
 // GenerateBranchFromJira calls GenerateBranchFromJiraFunc.
 func (mock *worktreeAdderMock) GenerateBranchFromJira(jiraKey string) (string, error) {
 	if mock.GenerateBranchFromJiraFunc == nil {
@@ -227,6 +419,33 @@ func (mock *worktreeAdderMock) GetDefaultBranchCalls() []struct {
 	return calls
 }
 
+// GetDefaultRemote calls GetDefaultRemoteFunc.
+func (mock *worktreeAdderMock) GetDefaultRemote() string {
+	if mock.GetDefaultRemoteFunc == nil {
+		panic("worktreeAdderMock.GetDefaultRemoteFunc: method is nil but worktreeAdder.GetDefaultRemote was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetDefaultRemote.Lock()
+	mock.calls.GetDefaultRemote = append(mock.calls.GetDefaultRemote, callInfo)
+	mock.lockGetDefaultRemote.Unlock()
+	return mock.GetDefaultRemoteFunc()
+}
+
+// GetDefaultRemoteCalls gets all the calls that were made to GetDefaultRemote.
+// Check the length with:
+//
+//	len(mockedworktreeAdder.GetDefaultRemoteCalls())
+func (mock *worktreeAdderMock) GetDefaultRemoteCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetDefaultRemote.RLock()
+	calls = mock.calls.GetDefaultRemote
+	mock.lockGetDefaultRemote.RUnlock()
+	return calls
+}
+
 // GetJiraIssues calls GetJiraIssuesFunc.
 func (mock *worktreeAdderMock) GetJiraIssues() ([]internal.JiraIssue, error) {
 	if mock.GetJiraIssuesFunc == nil {
@@ -253,3 +472,189 @@ func (mock *worktreeAdderMock) GetJiraIssuesCalls() []struct {
 	mock.lockGetJiraIssues.RUnlock()
 	return calls
 }
+
+// GetRemoteBranches calls GetRemoteBranchesFunc.
+func (mock *worktreeAdderMock) GetRemoteBranches() ([]string, error) {
+	if mock.GetRemoteBranchesFunc == nil {
+		panic("worktreeAdderMock.GetRemoteBranchesFunc: method is nil but worktreeAdder.GetRemoteBranches was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetRemoteBranches.Lock()
+	mock.calls.GetRemoteBranches = append(mock.calls.GetRemoteBranches, callInfo)
+	mock.lockGetRemoteBranches.Unlock()
+	return mock.GetRemoteBranchesFunc()
+}
+
+// GetRemoteBranchesCalls gets all the calls that were made to GetRemoteBranches.
+// Check the length with:
+//
+//	len(mockedworktreeAdder.GetRemoteBranchesCalls())
+func (mock *worktreeAdderMock) GetRemoteBranchesCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetRemoteBranches.RLock()
+	calls = mock.calls.GetRemoteBranches
+	mock.lockGetRemoteBranches.RUnlock()
+	return calls
+}
+
+// ListTags calls ListTagsFunc.
+func (mock *worktreeAdderMock) ListTags() ([]string, error) {
+	if mock.ListTagsFunc == nil {
+		panic("worktreeAdderMock.ListTagsFunc: method is nil but worktreeAdder.ListTags was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockListTags.Lock()
+	mock.calls.ListTags = append(mock.calls.ListTags, callInfo)
+	mock.lockListTags.Unlock()
+	return mock.ListTagsFunc()
+}
+
+// ListTagsCalls gets all the calls that were made to ListTags.
+// Check the length with:
+//
+//	len(mockedworktreeAdder.ListTagsCalls())
+func (mock *worktreeAdderMock) ListTagsCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockListTags.RLock()
+	calls = mock.calls.ListTags
+	mock.lockListTags.RUnlock()
+	return calls
+}
+
+// NextAvailableWorktreeName calls NextAvailableWorktreeNameFunc.
+func (mock *worktreeAdderMock) NextAvailableWorktreeName(base string) string {
+	if mock.NextAvailableWorktreeNameFunc == nil {
+		panic("worktreeAdderMock.NextAvailableWorktreeNameFunc: method is nil but worktreeAdder.NextAvailableWorktreeName was just called")
+	}
+	callInfo := struct {
+		Base string
+	}{
+		Base: base,
+	}
+	mock.lockNextAvailableWorktreeName.Lock()
+	mock.calls.NextAvailableWorktreeName = append(mock.calls.NextAvailableWorktreeName, callInfo)
+	mock.lockNextAvailableWorktreeName.Unlock()
+	return mock.NextAvailableWorktreeNameFunc(base)
+}
+
+// NextAvailableWorktreeNameCalls gets all the calls that were made to NextAvailableWorktreeName.
+// Check the length with:
+//
+//	len(mockedworktreeAdder.NextAvailableWorktreeNameCalls())
+func (mock *worktreeAdderMock) NextAvailableWorktreeNameCalls() []struct {
+	Base string
+} {
+	var calls []struct {
+		Base string
+	}
+	mock.lockNextAvailableWorktreeName.RLock()
+	calls = mock.calls.NextAvailableWorktreeName
+	mock.lockNextAvailableWorktreeName.RUnlock()
+	return calls
+}
+
+// ResolveCommitInPath calls ResolveCommitInPathFunc.
+func (mock *worktreeAdderMock) ResolveCommitInPath(path string, ref string) (string, error) {
+	if mock.ResolveCommitInPathFunc == nil {
+		panic("worktreeAdderMock.ResolveCommitInPathFunc: method is nil but worktreeAdder.ResolveCommitInPath was just called")
+	}
+	callInfo := struct {
+		Path string
+		Ref  string
+	}{
+		Path: path,
+		Ref:  ref,
+	}
+	mock.lockResolveCommitInPath.Lock()
+	mock.calls.ResolveCommitInPath = append(mock.calls.ResolveCommitInPath, callInfo)
+	mock.lockResolveCommitInPath.Unlock()
+	return mock.ResolveCommitInPathFunc(path, ref)
+}
+
+// ResolveCommitInPathCalls gets all the calls that were made to ResolveCommitInPath.
+// Check the length with:
+//
+//	len(mockedworktreeAdder.ResolveCommitInPathCalls())
+func (mock *worktreeAdderMock) ResolveCommitInPathCalls() []struct {
+	Path string
+	Ref  string
+} {
+	var calls []struct {
+		Path string
+		Ref  string
+	}
+	mock.lockResolveCommitInPath.RLock()
+	calls = mock.calls.ResolveCommitInPath
+	mock.lockResolveCommitInPath.RUnlock()
+	return calls
+}
+
+// SetSkipHooks calls SetSkipHooksFunc.
+func (mock *worktreeAdderMock) SetSkipHooks(skip bool) {
+	if mock.SetSkipHooksFunc == nil {
+		panic("worktreeAdderMock.SetSkipHooksFunc: method is nil but worktreeAdder.SetSkipHooks was just called")
+	}
+	callInfo := struct {
+		Skip bool
+	}{
+		Skip: skip,
+	}
+	mock.lockSetSkipHooks.Lock()
+	mock.calls.SetSkipHooks = append(mock.calls.SetSkipHooks, callInfo)
+	mock.lockSetSkipHooks.Unlock()
+	mock.SetSkipHooksFunc(skip)
+}
+
+// SetSkipHooksCalls gets all the calls that were made to SetSkipHooks.
+// Check the length with:
+//
+//	len(mockedworktreeAdder.SetSkipHooksCalls())
+func (mock *worktreeAdderMock) SetSkipHooksCalls() []struct {
+	Skip bool
+} {
+	var calls []struct {
+		Skip bool
+	}
+	mock.lockSetSkipHooks.RLock()
+	calls = mock.calls.SetSkipHooks
+	mock.lockSetSkipHooks.RUnlock()
+	return calls
+}
+
+// SetSparsePatterns calls SetSparsePatternsFunc.
+func (mock *worktreeAdderMock) SetSparsePatterns(patterns []string) {
+	if mock.SetSparsePatternsFunc == nil {
+		panic("worktreeAdderMock.SetSparsePatternsFunc: method is nil but worktreeAdder.SetSparsePatterns was just called")
+	}
+	callInfo := struct {
+		Patterns []string
+	}{
+		Patterns: patterns,
+	}
+	mock.lockSetSparsePatterns.Lock()
+	mock.calls.SetSparsePatterns = append(mock.calls.SetSparsePatterns, callInfo)
+	mock.lockSetSparsePatterns.Unlock()
+	mock.SetSparsePatternsFunc(patterns)
+}
+
+// SetSparsePatternsCalls gets all the calls that were made to SetSparsePatterns.
+// Check the length with:
+//
+//	len(mockedworktreeAdder.SetSparsePatternsCalls())
+func (mock *worktreeAdderMock) SetSparsePatternsCalls() []struct {
+	Patterns []string
+} {
+	var calls []struct {
+		Patterns []string
+	}
+	mock.lockSetSparsePatterns.RLock()
+	calls = mock.calls.SetSparsePatterns
+	mock.lockSetSparsePatterns.RUnlock()
+	return calls
+}
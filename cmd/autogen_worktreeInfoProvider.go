@@ -6,6 +6,7 @@ package cmd
 import (
 	"gbm/internal"
 	"sync"
+	"time"
 )
 
 // Ensure, that worktreeInfoProviderMock does implement worktreeInfoProvider.
@@ -18,17 +19,29 @@ var _ worktreeInfoProvider = &worktreeInfoProviderMock{}
 //
 //		// make and configure a mocked worktreeInfoProvider
 //		mockedworktreeInfoProvider := &worktreeInfoProviderMock{
+//			BuildJiraURLFunc: func(key string) string {
+//				panic("mock out the BuildJiraURL method")
+//			},
+//			GetAllWorktreesFunc: func() (map[string]*internal.WorktreeListInfo, error) {
+//				panic("mock out the GetAllWorktrees method")
+//			},
 //			GetConfigFunc: func() *internal.Config {
 //				panic("mock out the GetConfig method")
 //			},
+//			GetGBMConfigFunc: func() *internal.GBMConfig {
+//				panic("mock out the GetGBMConfig method")
+//			},
 //			GetJiraTicketDetailsFunc: func(jiraKey string) (*internal.JiraTicketDetails, error) {
 //				panic("mock out the GetJiraTicketDetails method")
 //			},
+//			GetSortedWorktreeNamesFunc: func(worktrees map[string]*internal.WorktreeListInfo) []string {
+//				panic("mock out the GetSortedWorktreeNames method")
+//			},
 //			GetStateFunc: func() *internal.State {
 //				panic("mock out the GetState method")
 //			},
-//			GetWorktreeAheadBehindCountFunc: func(worktreePath string) (int, int, error) {
-//				panic("mock out the GetWorktreeAheadBehindCount method")
+//			GetWorktreeAheadBehindAgainstFunc: func(worktreePath string, ref string) (int, int, error) {
+//				panic("mock out the GetWorktreeAheadBehindAgainst method")
 //			},
 //			GetWorktreeCommitHistoryFunc: func(worktreePath string, limit int) ([]internal.CommitInfo, error) {
 //				panic("mock out the GetWorktreeCommitHistory method")
@@ -39,6 +52,12 @@ var _ worktreeInfoProvider = &worktreeInfoProviderMock{}
 //			GetWorktreeFileChangesFunc: func(worktreePath string) ([]internal.FileChange, error) {
 //				panic("mock out the GetWorktreeFileChanges method")
 //			},
+//			GetWorktreeMergeBaseFunc: func(worktreePath string, baseBranch string) (string, time.Time, error) {
+//				panic("mock out the GetWorktreeMergeBase method")
+//			},
+//			GetWorktreeStashesFunc: func(worktreePath string) ([]internal.StashEntry, error) {
+//				panic("mock out the GetWorktreeStashes method")
+//			},
 //			GetWorktreeStatusFunc: func(worktreePath string) (*internal.GitStatus, error) {
 //				panic("mock out the GetWorktreeStatus method")
 //			},
@@ -58,17 +77,29 @@ var _ worktreeInfoProvider = &worktreeInfoProviderMock{}
 //
 //	}
 type worktreeInfoProviderMock struct {
+	// BuildJiraURLFunc mocks the BuildJiraURL method.
+	BuildJiraURLFunc func(key string) string
+
+	// GetAllWorktreesFunc mocks the GetAllWorktrees method.
+	GetAllWorktreesFunc func() (map[string]*internal.WorktreeListInfo, error)
+
 	// GetConfigFunc mocks the GetConfig method.
 	GetConfigFunc func() *internal.Config
 
+	// GetGBMConfigFunc mocks the GetGBMConfig method.
+	GetGBMConfigFunc func() *internal.GBMConfig
+
 	// GetJiraTicketDetailsFunc mocks the GetJiraTicketDetails method.
 	GetJiraTicketDetailsFunc func(jiraKey string) (*internal.JiraTicketDetails, error)
 
+	// GetSortedWorktreeNamesFunc mocks the GetSortedWorktreeNames method.
+	GetSortedWorktreeNamesFunc func(worktrees map[string]*internal.WorktreeListInfo) []string
+
 	// GetStateFunc mocks the GetState method.
 	GetStateFunc func() *internal.State
 
-	// GetWorktreeAheadBehindCountFunc mocks the GetWorktreeAheadBehindCount method.
-	GetWorktreeAheadBehindCountFunc func(worktreePath string) (int, int, error)
+	// GetWorktreeAheadBehindAgainstFunc mocks the GetWorktreeAheadBehindAgainst method.
+	GetWorktreeAheadBehindAgainstFunc func(worktreePath string, ref string) (int, int, error)
 
 	// GetWorktreeCommitHistoryFunc mocks the GetWorktreeCommitHistory method.
 	GetWorktreeCommitHistoryFunc func(worktreePath string, limit int) ([]internal.CommitInfo, error)
@@ -79,6 +110,12 @@ type worktreeInfoProviderMock struct {
 	// GetWorktreeFileChangesFunc mocks the GetWorktreeFileChanges method.
 	GetWorktreeFileChangesFunc func(worktreePath string) ([]internal.FileChange, error)
 
+	// GetWorktreeMergeBaseFunc mocks the GetWorktreeMergeBase method.
+	GetWorktreeMergeBaseFunc func(worktreePath string, baseBranch string) (string, time.Time, error)
+
+	// GetWorktreeStashesFunc mocks the GetWorktreeStashes method.
+	GetWorktreeStashesFunc func(worktreePath string) ([]internal.StashEntry, error)
+
 	// GetWorktreeStatusFunc mocks the GetWorktreeStatus method.
 	GetWorktreeStatusFunc func(worktreePath string) (*internal.GitStatus, error)
 
@@ -93,21 +130,39 @@ type worktreeInfoProviderMock struct {
 
 	// calls tracks calls to the methods.
 	calls struct {
+		// BuildJiraURL holds details about calls to the BuildJiraURL method.
+		BuildJiraURL []struct {
+			// Key is the key argument value.
+			Key string
+		}
+		// GetAllWorktrees holds details about calls to the GetAllWorktrees method.
+		GetAllWorktrees []struct {
+		}
 		// GetConfig holds details about calls to the GetConfig method.
 		GetConfig []struct {
 		}
+		// GetGBMConfig holds details about calls to the GetGBMConfig method.
+		GetGBMConfig []struct {
+		}
 		// GetJiraTicketDetails holds details about calls to the GetJiraTicketDetails method.
 		GetJiraTicketDetails []struct {
 			// JiraKey is the jiraKey argument value.
 			JiraKey string
 		}
+		// GetSortedWorktreeNames holds details about calls to the GetSortedWorktreeNames method.
+		GetSortedWorktreeNames []struct {
+			// Worktrees is the worktrees argument value.
+			Worktrees map[string]*internal.WorktreeListInfo
+		}
 		// GetState holds details about calls to the GetState method.
 		GetState []struct {
 		}
-		// GetWorktreeAheadBehindCount holds details about calls to the GetWorktreeAheadBehindCount method.
-		GetWorktreeAheadBehindCount []struct {
+		// GetWorktreeAheadBehindAgainst holds details about calls to the GetWorktreeAheadBehindAgainst method.
+		GetWorktreeAheadBehindAgainst []struct {
 			// WorktreePath is the worktreePath argument value.
 			WorktreePath string
+			// Ref is the ref argument value.
+			Ref string
 		}
 		// GetWorktreeCommitHistory holds details about calls to the GetWorktreeCommitHistory method.
 		GetWorktreeCommitHistory []struct {
@@ -126,6 +181,18 @@ type worktreeInfoProviderMock struct {
 			// WorktreePath is the worktreePath argument value.
 			WorktreePath string
 		}
+		// GetWorktreeMergeBase holds details about calls to the GetWorktreeMergeBase method.
+		GetWorktreeMergeBase []struct {
+			// WorktreePath is the worktreePath argument value.
+			WorktreePath string
+			// BaseBranch is the baseBranch argument value.
+			BaseBranch string
+		}
+		// GetWorktreeStashes holds details about calls to the GetWorktreeStashes method.
+		GetWorktreeStashes []struct {
+			// WorktreePath is the worktreePath argument value.
+			WorktreePath string
+		}
 		// GetWorktreeStatus holds details about calls to the GetWorktreeStatus method.
 		GetWorktreeStatus []struct {
 			// WorktreePath is the worktreePath argument value.
@@ -147,17 +214,82 @@ type worktreeInfoProviderMock struct {
 			WorktreePath string
 		}
 	}
-	lockGetConfig                   sync.RWMutex
-	lockGetJiraTicketDetails        sync.RWMutex
-	lockGetState                    sync.RWMutex
-	lockGetWorktreeAheadBehindCount sync.RWMutex
-	lockGetWorktreeCommitHistory    sync.RWMutex
-	lockGetWorktreeCurrentBranch    sync.RWMutex
-	lockGetWorktreeFileChanges      sync.RWMutex
-	lockGetWorktreeStatus           sync.RWMutex
-	lockGetWorktreeUpstreamBranch   sync.RWMutex
-	lockGetWorktrees                sync.RWMutex
-	lockVerifyWorktreeRef           sync.RWMutex
+	lockBuildJiraURL                  sync.RWMutex
+	lockGetAllWorktrees               sync.RWMutex
+	lockGetConfig                     sync.RWMutex
+	lockGetGBMConfig                  sync.RWMutex
+	lockGetJiraTicketDetails          sync.RWMutex
+	lockGetSortedWorktreeNames        sync.RWMutex
+	lockGetState                      sync.RWMutex
+	lockGetWorktreeAheadBehindAgainst sync.RWMutex
+	lockGetWorktreeCommitHistory      sync.RWMutex
+	lockGetWorktreeCurrentBranch      sync.RWMutex
+	lockGetWorktreeFileChanges        sync.RWMutex
+	lockGetWorktreeMergeBase          sync.RWMutex
+	lockGetWorktreeStashes            sync.RWMutex
+	lockGetWorktreeStatus             sync.RWMutex
+	lockGetWorktreeUpstreamBranch     sync.RWMutex
+	lockGetWorktrees                  sync.RWMutex
+	lockVerifyWorktreeRef             sync.RWMutex
+}
+
+// BuildJiraURL calls BuildJiraURLFunc.
+func (mock *worktreeInfoProviderMock) BuildJiraURL(key string) string {
+	if mock.BuildJiraURLFunc == nil {
+		panic("worktreeInfoProviderMock.BuildJiraURLFunc: method is nil but worktreeInfoProvider.BuildJiraURL was just called")
+	}
+	callInfo := struct {
+		Key string
+	}{
+		Key: key,
+	}
+	mock.lockBuildJiraURL.Lock()
+	mock.calls.BuildJiraURL = append(mock.calls.BuildJiraURL, callInfo)
+	mock.lockBuildJiraURL.Unlock()
+	return mock.BuildJiraURLFunc(key)
+}
+
+// BuildJiraURLCalls gets all the calls that were made to BuildJiraURL.
+// Check the length with:
+//
+//	len(mockedworktreeInfoProvider.BuildJiraURLCalls())
+func (mock *worktreeInfoProviderMock) BuildJiraURLCalls() []struct {
+	Key string
+} {
+	var calls []struct {
+		Key string
+	}
+	mock.lockBuildJiraURL.RLock()
+	calls = mock.calls.BuildJiraURL
+	mock.lockBuildJiraURL.RUnlock()
+	return calls
+}
+
+// GetAllWorktrees calls GetAllWorktreesFunc.
+func (mock *worktreeInfoProviderMock) GetAllWorktrees() (map[string]*internal.WorktreeListInfo, error) {
+	if mock.GetAllWorktreesFunc == nil {
+		panic("worktreeInfoProviderMock.GetAllWorktreesFunc: method is nil but worktreeInfoProvider.GetAllWorktrees was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetAllWorktrees.Lock()
+	mock.calls.GetAllWorktrees = append(mock.calls.GetAllWorktrees, callInfo)
+	mock.lockGetAllWorktrees.Unlock()
+	return mock.GetAllWorktreesFunc()
+}
+
+// GetAllWorktreesCalls gets all the calls that were made to GetAllWorktrees.
+// Check the length with:
+//
+//	len(mockedworktreeInfoProvider.GetAllWorktreesCalls())
+func (mock *worktreeInfoProviderMock) GetAllWorktreesCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetAllWorktrees.RLock()
+	calls = mock.calls.GetAllWorktrees
+	mock.lockGetAllWorktrees.RUnlock()
+	return calls
 }
 
 // GetConfig calls GetConfigFunc.
@@ -187,6 +319,33 @@ func (mock *worktreeInfoProviderMock) GetConfigCalls() []struct {
 	return calls
 }
 
+// GetGBMConfig calls GetGBMConfigFunc.
+func (mock *worktreeInfoProviderMock) GetGBMConfig() *internal.GBMConfig {
+	if mock.GetGBMConfigFunc == nil {
+		panic("worktreeInfoProviderMock.GetGBMConfigFunc: method is nil but worktreeInfoProvider.GetGBMConfig was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetGBMConfig.Lock()
+	mock.calls.GetGBMConfig = append(mock.calls.GetGBMConfig, callInfo)
+	mock.lockGetGBMConfig.Unlock()
+	return mock.GetGBMConfigFunc()
+}
+
+// GetGBMConfigCalls gets all the calls that were made to GetGBMConfig.
+// Check the length with:
+//
+//	len(mockedworktreeInfoProvider.GetGBMConfigCalls())
+func (mock *worktreeInfoProviderMock) GetGBMConfigCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetGBMConfig.RLock()
+	calls = mock.calls.GetGBMConfig
+	mock.lockGetGBMConfig.RUnlock()
+	return calls
+}
+
 // GetJiraTicketDetails calls GetJiraTicketDetailsFunc.
 func (mock *worktreeInfoProviderMock) GetJiraTicketDetails(jiraKey string) (*internal.JiraTicketDetails, error) {
 	if mock.GetJiraTicketDetailsFunc == nil {
@@ -219,6 +378,38 @@ func (mock *worktreeInfoProviderMock) GetJiraTicketDetailsCalls() []struct {
 	return calls
 }
 
+// GetSortedWorktreeNames calls GetSortedWorktreeNamesFunc.
+func (mock *worktreeInfoProviderMock) GetSortedWorktreeNames(worktrees map[string]*internal.WorktreeListInfo) []string {
+	if mock.GetSortedWorktreeNamesFunc == nil {
+		panic("worktreeInfoProviderMock.GetSortedWorktreeNamesFunc: method is nil but worktreeInfoProvider.GetSortedWorktreeNames was just called")
+	}
+	callInfo := struct {
+		Worktrees map[string]*internal.WorktreeListInfo
+	}{
+		Worktrees: worktrees,
+	}
+	mock.lockGetSortedWorktreeNames.Lock()
+	mock.calls.GetSortedWorktreeNames = append(mock.calls.GetSortedWorktreeNames, callInfo)
+	mock.lockGetSortedWorktreeNames.Unlock()
+	return mock.GetSortedWorktreeNamesFunc(worktrees)
+}
+
+// GetSortedWorktreeNamesCalls gets all the calls that were made to GetSortedWorktreeNames.
+// Check the length with:
+//
+//	len(mockedworktreeInfoProvider.GetSortedWorktreeNamesCalls())
+func (mock *worktreeInfoProviderMock) GetSortedWorktreeNamesCalls() []struct {
+	Worktrees map[string]*internal.WorktreeListInfo
+} {
+	var calls []struct {
+		Worktrees map[string]*internal.WorktreeListInfo
+	}
+	mock.lockGetSortedWorktreeNames.RLock()
+	calls = mock.calls.GetSortedWorktreeNames
+	mock.lockGetSortedWorktreeNames.RUnlock()
+	return calls
+}
+
 // GetState calls GetStateFunc.
 func (mock *worktreeInfoProviderMock) GetState() *internal.State {
 	if mock.GetStateFunc == nil {
@@ -246,35 +437,39 @@ func (mock *worktreeInfoProviderMock) GetStateCalls() []struct {
 	return calls
 }
 
-// GetWorktreeAheadBehindCount calls GetWorktreeAheadBehindCountFunc.
-func (mock *worktreeInfoProviderMock) GetWorktreeAheadBehindCount(worktreePath string) (int, int, error) {
-	if mock.GetWorktreeAheadBehindCountFunc == nil {
-		panic("worktreeInfoProviderMock.GetWorktreeAheadBehindCountFunc: method is nil but worktreeInfoProvider.GetWorktreeAheadBehindCount was just called")
+// GetWorktreeAheadBehindAgainst calls GetWorktreeAheadBehindAgainstFunc.
+func (mock *worktreeInfoProviderMock) GetWorktreeAheadBehindAgainst(worktreePath string, ref string) (int, int, error) {
+	if mock.GetWorktreeAheadBehindAgainstFunc == nil {
+		panic("worktreeInfoProviderMock.GetWorktreeAheadBehindAgainstFunc: method is nil but worktreeInfoProvider.GetWorktreeAheadBehindAgainst was just called")
 	}
 	callInfo := struct {
 		WorktreePath string
+		Ref          string
 	}{
 		WorktreePath: worktreePath,
+		Ref:          ref,
 	}
-	mock.lockGetWorktreeAheadBehindCount.Lock()
-	mock.calls.GetWorktreeAheadBehindCount = append(mock.calls.GetWorktreeAheadBehindCount, callInfo)
-	mock.lockGetWorktreeAheadBehindCount.Unlock()
-	return mock.GetWorktreeAheadBehindCountFunc(worktreePath)
+	mock.lockGetWorktreeAheadBehindAgainst.Lock()
+	mock.calls.GetWorktreeAheadBehindAgainst = append(mock.calls.GetWorktreeAheadBehindAgainst, callInfo)
+	mock.lockGetWorktreeAheadBehindAgainst.Unlock()
+	return mock.GetWorktreeAheadBehindAgainstFunc(worktreePath, ref)
 }
 
-// GetWorktreeAheadBehindCountCalls gets all the calls that were made to GetWorktreeAheadBehindCount.
+// GetWorktreeAheadBehindAgainstCalls gets all the calls that were made to GetWorktreeAheadBehindAgainst.
 // Check the length with:
 //
-//	len(mockedworktreeInfoProvider.GetWorktreeAheadBehindCountCalls())
-func (mock *worktreeInfoProviderMock) GetWorktreeAheadBehindCountCalls() []struct {
+//	len(mockedworktreeInfoProvider.GetWorktreeAheadBehindAgainstCalls())
+func (mock *worktreeInfoProviderMock) GetWorktreeAheadBehindAgainstCalls() []struct {
 	WorktreePath string
+	Ref          string
 } {
 	var calls []struct {
 		WorktreePath string
+		Ref          string
 	}
-	mock.lockGetWorktreeAheadBehindCount.RLock()
-	calls = mock.calls.GetWorktreeAheadBehindCount
-	mock.lockGetWorktreeAheadBehindCount.RUnlock()
+	mock.lockGetWorktreeAheadBehindAgainst.RLock()
+	calls = mock.calls.GetWorktreeAheadBehindAgainst
+	mock.lockGetWorktreeAheadBehindAgainst.RUnlock()
 	return calls
 }
 
@@ -378,6 +573,74 @@ func (mock *worktreeInfoProviderMock) GetWorktreeFileChangesCalls() []struct {
 	return calls
 }
 
+// GetWorktreeMergeBase calls GetWorktreeMergeBaseFunc.
+func (mock *worktreeInfoProviderMock) GetWorktreeMergeBase(worktreePath string, baseBranch string) (string, time.Time, error) {
+	if mock.GetWorktreeMergeBaseFunc == nil {
+		panic("worktreeInfoProviderMock.GetWorktreeMergeBaseFunc: method is nil but worktreeInfoProvider.GetWorktreeMergeBase was just called")
+	}
+	callInfo := struct {
+		WorktreePath string
+		BaseBranch   string
+	}{
+		WorktreePath: worktreePath,
+		BaseBranch:   baseBranch,
+	}
+	mock.lockGetWorktreeMergeBase.Lock()
+	mock.calls.GetWorktreeMergeBase = append(mock.calls.GetWorktreeMergeBase, callInfo)
+	mock.lockGetWorktreeMergeBase.Unlock()
+	return mock.GetWorktreeMergeBaseFunc(worktreePath, baseBranch)
+}
+
+// GetWorktreeMergeBaseCalls gets all the calls that were made to GetWorktreeMergeBase.
+// Check the length with:
+//
+//	len(mockedworktreeInfoProvider.GetWorktreeMergeBaseCalls())
+func (mock *worktreeInfoProviderMock) GetWorktreeMergeBaseCalls() []struct {
+	WorktreePath string
+	BaseBranch   string
+} {
+	var calls []struct {
+		WorktreePath string
+		BaseBranch   string
+	}
+	mock.lockGetWorktreeMergeBase.RLock()
+	calls = mock.calls.GetWorktreeMergeBase
+	mock.lockGetWorktreeMergeBase.RUnlock()
+	return calls
+}
+
+// GetWorktreeStashes calls GetWorktreeStashesFunc.
+func (mock *worktreeInfoProviderMock) GetWorktreeStashes(worktreePath string) ([]internal.StashEntry, error) {
+	if mock.GetWorktreeStashesFunc == nil {
+		panic("worktreeInfoProviderMock.GetWorktreeStashesFunc: method is nil but worktreeInfoProvider.GetWorktreeStashes was just called")
+	}
+	callInfo := struct {
+		WorktreePath string
+	}{
+		WorktreePath: worktreePath,
+	}
+	mock.lockGetWorktreeStashes.Lock()
+	mock.calls.GetWorktreeStashes = append(mock.calls.GetWorktreeStashes, callInfo)
+	mock.lockGetWorktreeStashes.Unlock()
+	return mock.GetWorktreeStashesFunc(worktreePath)
+}
+
+// GetWorktreeStashesCalls gets all the calls that were made to GetWorktreeStashes.
+// Check the length with:
+//
+//	len(mockedworktreeInfoProvider.GetWorktreeStashesCalls())
+func (mock *worktreeInfoProviderMock) GetWorktreeStashesCalls() []struct {
+	WorktreePath string
+} {
+	var calls []struct {
+		WorktreePath string
+	}
+	mock.lockGetWorktreeStashes.RLock()
+	calls = mock.calls.GetWorktreeStashes
+	mock.lockGetWorktreeStashes.RUnlock()
+	return calls
+}
+
 // GetWorktreeStatus calls GetWorktreeStatusFunc.
 func (mock *worktreeInfoProviderMock) GetWorktreeStatus(worktreePath string) (*internal.GitStatus, error) {
 	if mock.GetWorktreeStatusFunc == nil {
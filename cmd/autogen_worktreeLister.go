@@ -27,9 +27,15 @@ var _ worktreeLister = &worktreeListerMock{}
 //			GetSyncStatusFunc: func() (*internal.SyncStatus, error) {
 //				panic("mock out the GetSyncStatus method")
 //			},
+//			GetWorktreeCommitHistoryFunc: func(worktreePath string, limit int) ([]internal.CommitInfo, error) {
+//				panic("mock out the GetWorktreeCommitHistory method")
+//			},
 //			GetWorktreeMappingFunc: func() (map[string]string, error) {
 //				panic("mock out the GetWorktreeMapping method")
 //			},
+//			IsMainWorktreeFunc: func(name string) bool {
+//				panic("mock out the IsMainWorktree method")
+//			},
 //		}
 //
 //		// use mockedworktreeLister in code that requires worktreeLister
@@ -46,9 +52,15 @@ type worktreeListerMock struct {
 	// GetSyncStatusFunc mocks the GetSyncStatus method.
 	GetSyncStatusFunc func() (*internal.SyncStatus, error)
 
+	// GetWorktreeCommitHistoryFunc mocks the GetWorktreeCommitHistory method.
+	GetWorktreeCommitHistoryFunc func(worktreePath string, limit int) ([]internal.CommitInfo, error)
+
 	// GetWorktreeMappingFunc mocks the GetWorktreeMapping method.
 	GetWorktreeMappingFunc func() (map[string]string, error)
 
+	// IsMainWorktreeFunc mocks the IsMainWorktree method.
+	IsMainWorktreeFunc func(name string) bool
+
 	// calls tracks calls to the methods.
 	calls struct {
 		// GetAllWorktrees holds details about calls to the GetAllWorktrees method.
@@ -62,14 +74,28 @@ type worktreeListerMock struct {
 		// GetSyncStatus holds details about calls to the GetSyncStatus method.
 		GetSyncStatus []struct {
 		}
+		// GetWorktreeCommitHistory holds details about calls to the GetWorktreeCommitHistory method.
+		GetWorktreeCommitHistory []struct {
+			// WorktreePath is the worktreePath argument value.
+			WorktreePath string
+			// Limit is the limit argument value.
+			Limit int
+		}
 		// GetWorktreeMapping holds details about calls to the GetWorktreeMapping method.
 		GetWorktreeMapping []struct {
 		}
+		// IsMainWorktree holds details about calls to the IsMainWorktree method.
+		IsMainWorktree []struct {
+			// Name is the name argument value.
+			Name string
+		}
 	}
-	lockGetAllWorktrees        sync.RWMutex
-	lockGetSortedWorktreeNames sync.RWMutex
-	lockGetSyncStatus          sync.RWMutex
-	lockGetWorktreeMapping     sync.RWMutex
+	lockGetAllWorktrees          sync.RWMutex
+	lockGetSortedWorktreeNames   sync.RWMutex
+	lockGetSyncStatus            sync.RWMutex
+	lockGetWorktreeCommitHistory sync.RWMutex
+	lockGetWorktreeMapping       sync.RWMutex
+	lockIsMainWorktree           sync.RWMutex
 }
 
 // GetAllWorktrees calls GetAllWorktreesFunc.
@@ -158,6 +184,42 @@ func (mock *worktreeListerMock) GetSyncStatusCalls() []struct {
 	return calls
 }
 
+// GetWorktreeCommitHistory calls GetWorktreeCommitHistoryFunc.
+func (mock *worktreeListerMock) GetWorktreeCommitHistory(worktreePath string, limit int) ([]internal.CommitInfo, error) {
+	if mock.GetWorktreeCommitHistoryFunc == nil {
+		panic("worktreeListerMock.GetWorktreeCommitHistoryFunc: method is nil but worktreeLister.GetWorktreeCommitHistory was just called")
+	}
+	callInfo := struct {
+		WorktreePath string
+		Limit        int
+	}{
+		WorktreePath: worktreePath,
+		Limit:        limit,
+	}
+	mock.lockGetWorktreeCommitHistory.Lock()
+	mock.calls.GetWorktreeCommitHistory = append(mock.calls.GetWorktreeCommitHistory, callInfo)
+	mock.lockGetWorktreeCommitHistory.Unlock()
+	return mock.GetWorktreeCommitHistoryFunc(worktreePath, limit)
+}
+
+// GetWorktreeCommitHistoryCalls gets all the calls that were made to GetWorktreeCommitHistory.
+// Check the length with:
+//
+//	len(mockedworktreeLister.GetWorktreeCommitHistoryCalls())
+func (mock *worktreeListerMock) GetWorktreeCommitHistoryCalls() []struct {
+	WorktreePath string
+	Limit        int
+} {
+	var calls []struct {
+		WorktreePath string
+		Limit        int
+	}
+	mock.lockGetWorktreeCommitHistory.RLock()
+	calls = mock.calls.GetWorktreeCommitHistory
+	mock.lockGetWorktreeCommitHistory.RUnlock()
+	return calls
+}
+
 // GetWorktreeMapping calls GetWorktreeMappingFunc.
 func (mock *worktreeListerMock) GetWorktreeMapping() (map[string]string, error) {
 	if mock.GetWorktreeMappingFunc == nil {
@@ -184,3 +246,35 @@ func (mock *worktreeListerMock) GetWorktreeMappingCalls() []struct {
 	mock.lockGetWorktreeMapping.RUnlock()
 	return calls
 }
+
+// IsMainWorktree calls IsMainWorktreeFunc.
+func (mock *worktreeListerMock) IsMainWorktree(name string) bool {
+	if mock.IsMainWorktreeFunc == nil {
+		panic("worktreeListerMock.IsMainWorktreeFunc: method is nil but worktreeLister.IsMainWorktree was just called")
+	}
+	callInfo := struct {
+		Name string
+	}{
+		Name: name,
+	}
+	mock.lockIsMainWorktree.Lock()
+	mock.calls.IsMainWorktree = append(mock.calls.IsMainWorktree, callInfo)
+	mock.lockIsMainWorktree.Unlock()
+	return mock.IsMainWorktreeFunc(name)
+}
+
+// IsMainWorktreeCalls gets all the calls that were made to IsMainWorktree.
+// Check the length with:
+//
+//	len(mockedworktreeLister.IsMainWorktreeCalls())
+func (mock *worktreeListerMock) IsMainWorktreeCalls() []struct {
+	Name string
+} {
+	var calls []struct {
+		Name string
+	}
+	mock.lockIsMainWorktree.RLock()
+	calls = mock.calls.IsMainWorktree
+	mock.lockIsMainWorktree.RUnlock()
+	return calls
+}
@@ -24,8 +24,8 @@ var _ worktreePuller = &worktreePullerMock{}
 //			IsInWorktreeFunc: func(currentPath string) (bool, string, error) {
 //				panic("mock out the IsInWorktree method")
 //			},
-//			PullAllWorktreesFunc: func() error {
-//				panic("mock out the PullAllWorktrees method")
+//			PullAllWorktreesWithResultsFunc: func(failFast bool) ([]internal.WorktreeResult, error) {
+//				panic("mock out the PullAllWorktreesWithResults method")
 //			},
 //			PullWorktreeFunc: func(worktreeName string) error {
 //				panic("mock out the PullWorktree method")
@@ -43,8 +43,8 @@ type worktreePullerMock struct {
 	// IsInWorktreeFunc mocks the IsInWorktree method.
 	IsInWorktreeFunc func(currentPath string) (bool, string, error)
 
-	// PullAllWorktreesFunc mocks the PullAllWorktrees method.
-	PullAllWorktreesFunc func() error
+	// PullAllWorktreesWithResultsFunc mocks the PullAllWorktreesWithResults method.
+	PullAllWorktreesWithResultsFunc func(failFast bool) ([]internal.WorktreeResult, error)
 
 	// PullWorktreeFunc mocks the PullWorktree method.
 	PullWorktreeFunc func(worktreeName string) error
@@ -59,8 +59,10 @@ type worktreePullerMock struct {
 			// CurrentPath is the currentPath argument value.
 			CurrentPath string
 		}
-		// PullAllWorktrees holds details about calls to the PullAllWorktrees method.
-		PullAllWorktrees []struct {
+		// PullAllWorktreesWithResults holds details about calls to the PullAllWorktreesWithResults method.
+		PullAllWorktreesWithResults []struct {
+			// FailFast is the failFast argument value.
+			FailFast bool
 		}
 		// PullWorktree holds details about calls to the PullWorktree method.
 		PullWorktree []struct {
@@ -68,10 +70,10 @@ type worktreePullerMock struct {
 			WorktreeName string
 		}
 	}
-	lockGetAllWorktrees  sync.RWMutex
-	lockIsInWorktree     sync.RWMutex
-	lockPullAllWorktrees sync.RWMutex
-	lockPullWorktree     sync.RWMutex
+	lockGetAllWorktrees             sync.RWMutex
+	lockIsInWorktree                sync.RWMutex
+	lockPullAllWorktreesWithResults sync.RWMutex
+	lockPullWorktree                sync.RWMutex
 }
 
 // GetAllWorktrees calls GetAllWorktreesFunc.
@@ -133,30 +135,35 @@ func (mock *worktreePullerMock) IsInWorktreeCalls() []struct {
 	return calls
 }
 
-// PullAllWorktrees calls PullAllWorktreesFunc.
-func (mock *worktreePullerMock) PullAllWorktrees() error {
-	if mock.PullAllWorktreesFunc == nil {
-		panic("worktreePullerMock.PullAllWorktreesFunc: method is nil but worktreePuller.PullAllWorktrees was just called")
+// PullAllWorktreesWithResults calls PullAllWorktreesWithResultsFunc.
+func (mock *worktreePullerMock) PullAllWorktreesWithResults(failFast bool) ([]internal.WorktreeResult, error) {
+	if mock.PullAllWorktreesWithResultsFunc == nil {
+		panic("worktreePullerMock.PullAllWorktreesWithResultsFunc: method is nil but worktreePuller.PullAllWorktreesWithResults was just called")
 	}
 	callInfo := struct {
-	}{}
-	mock.lockPullAllWorktrees.Lock()
-	mock.calls.PullAllWorktrees = append(mock.calls.PullAllWorktrees, callInfo)
-	mock.lockPullAllWorktrees.Unlock()
-	return mock.PullAllWorktreesFunc()
+		FailFast bool
+	}{
+		FailFast: failFast,
+	}
+	mock.lockPullAllWorktreesWithResults.Lock()
+	mock.calls.PullAllWorktreesWithResults = append(mock.calls.PullAllWorktreesWithResults, callInfo)
+	mock.lockPullAllWorktreesWithResults.Unlock()
+	return mock.PullAllWorktreesWithResultsFunc(failFast)
 }
 
-// PullAllWorktreesCalls gets all the calls that were made to PullAllWorktrees.
+// PullAllWorktreesWithResultsCalls gets all the calls that were made to PullAllWorktreesWithResults.
 // Check the length with:
 //
-//	len(mockedworktreePuller.PullAllWorktreesCalls())
-func (mock *worktreePullerMock) PullAllWorktreesCalls() []struct {
+//	len(mockedworktreePuller.PullAllWorktreesWithResultsCalls())
+func (mock *worktreePullerMock) PullAllWorktreesWithResultsCalls() []struct {
+	FailFast bool
 } {
 	var calls []struct {
+		FailFast bool
 	}
-	mock.lockPullAllWorktrees.RLock()
-	calls = mock.calls.PullAllWorktrees
-	mock.lockPullAllWorktrees.RUnlock()
+	mock.lockPullAllWorktreesWithResults.RLock()
+	calls = mock.calls.PullAllWorktreesWithResults
+	mock.lockPullAllWorktreesWithResults.RUnlock()
 	return calls
 }
 
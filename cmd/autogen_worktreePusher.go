@@ -24,11 +24,11 @@ var _ worktreePusher = &worktreePusherMock{}
 //			IsInWorktreeFunc: func(currentPath string) (bool, string, error) {
 //				panic("mock out the IsInWorktree method")
 //			},
-//			PushAllWorktreesFunc: func() error {
-//				panic("mock out the PushAllWorktrees method")
+//			PushAllWorktreesWithResultsFunc: func(opts internal.PushOptions, failFast bool) ([]internal.WorktreeResult, error) {
+//				panic("mock out the PushAllWorktreesWithResults method")
 //			},
-//			PushWorktreeFunc: func(worktreeName string) error {
-//				panic("mock out the PushWorktree method")
+//			PushWorktreeWithOptionsFunc: func(worktreeName string, opts internal.PushOptions) error {
+//				panic("mock out the PushWorktreeWithOptions method")
 //			},
 //		}
 //
@@ -43,11 +43,11 @@ type worktreePusherMock struct {
 	// IsInWorktreeFunc mocks the IsInWorktree method.
 	IsInWorktreeFunc func(currentPath string) (bool, string, error)
 
-	// PushAllWorktreesFunc mocks the PushAllWorktrees method.
-	PushAllWorktreesFunc func() error
+	// PushAllWorktreesWithResultsFunc mocks the PushAllWorktreesWithResults method.
+	PushAllWorktreesWithResultsFunc func(opts internal.PushOptions, failFast bool) ([]internal.WorktreeResult, error)
 
-	// PushWorktreeFunc mocks the PushWorktree method.
-	PushWorktreeFunc func(worktreeName string) error
+	// PushWorktreeWithOptionsFunc mocks the PushWorktreeWithOptions method.
+	PushWorktreeWithOptionsFunc func(worktreeName string, opts internal.PushOptions) error
 
 	// calls tracks calls to the methods.
 	calls struct {
@@ -59,19 +59,25 @@ type worktreePusherMock struct {
 			// CurrentPath is the currentPath argument value.
 			CurrentPath string
 		}
-		// PushAllWorktrees holds details about calls to the PushAllWorktrees method.
-		PushAllWorktrees []struct {
+		// PushAllWorktreesWithResults holds details about calls to the PushAllWorktreesWithResults method.
+		PushAllWorktreesWithResults []struct {
+			// Opts is the opts argument value.
+			Opts internal.PushOptions
+			// FailFast is the failFast argument value.
+			FailFast bool
 		}
-		// PushWorktree holds details about calls to the PushWorktree method.
-		PushWorktree []struct {
+		// PushWorktreeWithOptions holds details about calls to the PushWorktreeWithOptions method.
+		PushWorktreeWithOptions []struct {
 			// WorktreeName is the worktreeName argument value.
 			WorktreeName string
+			// Opts is the opts argument value.
+			Opts internal.PushOptions
 		}
 	}
-	lockGetAllWorktrees  sync.RWMutex
-	lockIsInWorktree     sync.RWMutex
-	lockPushAllWorktrees sync.RWMutex
-	lockPushWorktree     sync.RWMutex
+	lockGetAllWorktrees             sync.RWMutex
+	lockIsInWorktree                sync.RWMutex
+	lockPushAllWorktreesWithResults sync.RWMutex
+	lockPushWorktreeWithOptions     sync.RWMutex
 }
 
 // GetAllWorktrees calls GetAllWorktreesFunc.
@@ -133,61 +139,74 @@ func (mock *worktreePusherMock) IsInWorktreeCalls() []struct {
 	return calls
 }
 
-// PushAllWorktrees calls PushAllWorktreesFunc.
-func (mock *worktreePusherMock) PushAllWorktrees() error {
-	if mock.PushAllWorktreesFunc == nil {
-		panic("worktreePusherMock.PushAllWorktreesFunc: method is nil but worktreePusher.PushAllWorktrees was just called")
+// PushAllWorktreesWithResults calls PushAllWorktreesWithResultsFunc.
+func (mock *worktreePusherMock) PushAllWorktreesWithResults(opts internal.PushOptions, failFast bool) ([]internal.WorktreeResult, error) {
+	if mock.PushAllWorktreesWithResultsFunc == nil {
+		panic("worktreePusherMock.PushAllWorktreesWithResultsFunc: method is nil but worktreePusher.PushAllWorktreesWithResults was just called")
 	}
 	callInfo := struct {
-	}{}
-	mock.lockPushAllWorktrees.Lock()
-	mock.calls.PushAllWorktrees = append(mock.calls.PushAllWorktrees, callInfo)
-	mock.lockPushAllWorktrees.Unlock()
-	return mock.PushAllWorktreesFunc()
+		Opts     internal.PushOptions
+		FailFast bool
+	}{
+		Opts:     opts,
+		FailFast: failFast,
+	}
+	mock.lockPushAllWorktreesWithResults.Lock()
+	mock.calls.PushAllWorktreesWithResults = append(mock.calls.PushAllWorktreesWithResults, callInfo)
+	mock.lockPushAllWorktreesWithResults.Unlock()
+	return mock.PushAllWorktreesWithResultsFunc(opts, failFast)
 }
 
-// PushAllWorktreesCalls gets all the calls that were made to PushAllWorktrees.
+// PushAllWorktreesWithResultsCalls gets all the calls that were made to PushAllWorktreesWithResults.
 // Check the length with:
 //
-//	len(mockedworktreePusher.PushAllWorktreesCalls())
-func (mock *worktreePusherMock) PushAllWorktreesCalls() []struct {
+//	len(mockedworktreePusher.PushAllWorktreesWithResultsCalls())
+func (mock *worktreePusherMock) PushAllWorktreesWithResultsCalls() []struct {
+	Opts     internal.PushOptions
+	FailFast bool
 } {
 	var calls []struct {
+		Opts     internal.PushOptions
+		FailFast bool
 	}
-	mock.lockPushAllWorktrees.RLock()
-	calls = mock.calls.PushAllWorktrees
-	mock.lockPushAllWorktrees.RUnlock()
+	mock.lockPushAllWorktreesWithResults.RLock()
+	calls = mock.calls.PushAllWorktreesWithResults
+	mock.lockPushAllWorktreesWithResults.RUnlock()
 	return calls
 }
 
-// PushWorktree calls PushWorktreeFunc.
-func (mock *worktreePusherMock) PushWorktree(worktreeName string) error {
-	if mock.PushWorktreeFunc == nil {
-		panic("worktreePusherMock.PushWorktreeFunc: method is nil but worktreePusher.PushWorktree was just called")
+// PushWorktreeWithOptions calls PushWorktreeWithOptionsFunc.
+func (mock *worktreePusherMock) PushWorktreeWithOptions(worktreeName string, opts internal.PushOptions) error {
+	if mock.PushWorktreeWithOptionsFunc == nil {
+		panic("worktreePusherMock.PushWorktreeWithOptionsFunc: method is nil but worktreePusher.PushWorktreeWithOptions was just called")
 	}
 	callInfo := struct {
 		WorktreeName string
+		Opts         internal.PushOptions
 	}{
 		WorktreeName: worktreeName,
+		Opts:         opts,
 	}
-	mock.lockPushWorktree.Lock()
-	mock.calls.PushWorktree = append(mock.calls.PushWorktree, callInfo)
-	mock.lockPushWorktree.Unlock()
-	return mock.PushWorktreeFunc(worktreeName)
+	mock.lockPushWorktreeWithOptions.Lock()
+	mock.calls.PushWorktreeWithOptions = append(mock.calls.PushWorktreeWithOptions, callInfo)
+	mock.lockPushWorktreeWithOptions.Unlock()
+	return mock.PushWorktreeWithOptionsFunc(worktreeName, opts)
 }
 
-// PushWorktreeCalls gets all the calls that were made to PushWorktree.
+// PushWorktreeWithOptionsCalls gets all the calls that were made to PushWorktreeWithOptions.
 // Check the length with:
 //
-//	len(mockedworktreePusher.PushWorktreeCalls())
-func (mock *worktreePusherMock) PushWorktreeCalls() []struct {
+//	len(mockedworktreePusher.PushWorktreeWithOptionsCalls())
+func (mock *worktreePusherMock) PushWorktreeWithOptionsCalls() []struct {
 	WorktreeName string
+	Opts         internal.PushOptions
 } {
 	var calls []struct {
 		WorktreeName string
+		Opts         internal.PushOptions
 	}
-	mock.lockPushWorktree.RLock()
-	calls = mock.calls.PushWorktree
-	mock.lockPushWorktree.RUnlock()
+	mock.lockPushWorktreeWithOptions.RLock()
+	calls = mock.calls.PushWorktreeWithOptions
+	mock.lockPushWorktreeWithOptions.RUnlock()
 	return calls
 }
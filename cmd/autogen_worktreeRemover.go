@@ -18,18 +18,39 @@ var _ worktreeRemover = &worktreeRemoverMock{}
 //
 //		// make and configure a mocked worktreeRemover
 //		mockedworktreeRemover := &worktreeRemoverMock{
+//			ArchiveWorktreeFunc: func(worktreeName string, destDir string) error {
+//				panic("mock out the ArchiveWorktree method")
+//			},
+//			DeleteLocalBranchFunc: func(branchName string, force bool) error {
+//				panic("mock out the DeleteLocalBranch method")
+//			},
+//			DeleteRemoteBranchFunc: func(remoteName string, branchName string) error {
+//				panic("mock out the DeleteRemoteBranch method")
+//			},
 //			GetAllWorktreesFunc: func() (map[string]*internal.WorktreeListInfo, error) {
 //				panic("mock out the GetAllWorktrees method")
 //			},
+//			GetDefaultRemoteFunc: func() string {
+//				panic("mock out the GetDefaultRemote method")
+//			},
+//			GetWorktreeCurrentBranchFunc: func(worktreePath string) (string, error) {
+//				panic("mock out the GetWorktreeCurrentBranch method")
+//			},
 //			GetWorktreePathFunc: func(worktreeName string) (string, error) {
 //				panic("mock out the GetWorktreePath method")
 //			},
 //			GetWorktreeStatusFunc: func(worktreePath string) (*internal.GitStatus, error) {
 //				panic("mock out the GetWorktreeStatus method")
 //			},
+//			RemoveArchivedWorktreeFunc: func(worktreeName string) error {
+//				panic("mock out the RemoveArchivedWorktree method")
+//			},
 //			RemoveWorktreeFunc: func(worktreeName string) error {
 //				panic("mock out the RemoveWorktree method")
 //			},
+//			RemoveWorktreeWithoutForceFunc: func(worktreeName string) error {
+//				panic("mock out the RemoveWorktreeWithoutForce method")
+//			},
 //		}
 //
 //		// use mockedworktreeRemover in code that requires worktreeRemover
@@ -37,23 +58,73 @@ var _ worktreeRemover = &worktreeRemoverMock{}
 //
 //	}
 type worktreeRemoverMock struct {
+	// ArchiveWorktreeFunc mocks the ArchiveWorktree method.
+	ArchiveWorktreeFunc func(worktreeName string, destDir string) error
+
+	// DeleteLocalBranchFunc mocks the DeleteLocalBranch method.
+	DeleteLocalBranchFunc func(branchName string, force bool) error
+
+	// DeleteRemoteBranchFunc mocks the DeleteRemoteBranch method.
+	DeleteRemoteBranchFunc func(remoteName string, branchName string) error
+
 	// GetAllWorktreesFunc mocks the GetAllWorktrees method.
 	GetAllWorktreesFunc func() (map[string]*internal.WorktreeListInfo, error)
 
+	// GetDefaultRemoteFunc mocks the GetDefaultRemote method.
+	GetDefaultRemoteFunc func() string
+
+	// GetWorktreeCurrentBranchFunc mocks the GetWorktreeCurrentBranch method.
+	GetWorktreeCurrentBranchFunc func(worktreePath string) (string, error)
+
 	// GetWorktreePathFunc mocks the GetWorktreePath method.
 	GetWorktreePathFunc func(worktreeName string) (string, error)
 
 	// GetWorktreeStatusFunc mocks the GetWorktreeStatus method.
 	GetWorktreeStatusFunc func(worktreePath string) (*internal.GitStatus, error)
 
+	// RemoveArchivedWorktreeFunc mocks the RemoveArchivedWorktree method.
+	RemoveArchivedWorktreeFunc func(worktreeName string) error
+
 	// RemoveWorktreeFunc mocks the RemoveWorktree method.
 	RemoveWorktreeFunc func(worktreeName string) error
 
+	// RemoveWorktreeWithoutForceFunc mocks the RemoveWorktreeWithoutForce method.
+	RemoveWorktreeWithoutForceFunc func(worktreeName string) error
+
 	// calls tracks calls to the methods.
 	calls struct {
+		// ArchiveWorktree holds details about calls to the ArchiveWorktree method.
+		ArchiveWorktree []struct {
+			// WorktreeName is the worktreeName argument value.
+			WorktreeName string
+			// DestDir is the destDir argument value.
+			DestDir string
+		}
+		// DeleteLocalBranch holds details about calls to the DeleteLocalBranch method.
+		DeleteLocalBranch []struct {
+			// BranchName is the branchName argument value.
+			BranchName string
+			// Force is the force argument value.
+			Force bool
+		}
+		// DeleteRemoteBranch holds details about calls to the DeleteRemoteBranch method.
+		DeleteRemoteBranch []struct {
+			// RemoteName is the remoteName argument value.
+			RemoteName string
+			// BranchName is the branchName argument value.
+			BranchName string
+		}
 		// GetAllWorktrees holds details about calls to the GetAllWorktrees method.
 		GetAllWorktrees []struct {
 		}
+		// GetDefaultRemote holds details about calls to the GetDefaultRemote method.
+		GetDefaultRemote []struct {
+		}
+		// GetWorktreeCurrentBranch holds details about calls to the GetWorktreeCurrentBranch method.
+		GetWorktreeCurrentBranch []struct {
+			// WorktreePath is the worktreePath argument value.
+			WorktreePath string
+		}
 		// GetWorktreePath holds details about calls to the GetWorktreePath method.
 		GetWorktreePath []struct {
 			// WorktreeName is the worktreeName argument value.
@@ -64,16 +135,141 @@ type worktreeRemoverMock struct {
 			// WorktreePath is the worktreePath argument value.
 			WorktreePath string
 		}
+		// RemoveArchivedWorktree holds details about calls to the RemoveArchivedWorktree method.
+		RemoveArchivedWorktree []struct {
+			// WorktreeName is the worktreeName argument value.
+			WorktreeName string
+		}
 		// RemoveWorktree holds details about calls to the RemoveWorktree method.
 		RemoveWorktree []struct {
 			// WorktreeName is the worktreeName argument value.
 			WorktreeName string
 		}
+		// RemoveWorktreeWithoutForce holds details about calls to the RemoveWorktreeWithoutForce method.
+		RemoveWorktreeWithoutForce []struct {
+			// WorktreeName is the worktreeName argument value.
+			WorktreeName string
+		}
+	}
+	lockArchiveWorktree            sync.RWMutex
+	lockDeleteLocalBranch          sync.RWMutex
+	lockDeleteRemoteBranch         sync.RWMutex
+	lockGetAllWorktrees            sync.RWMutex
+	lockGetDefaultRemote           sync.RWMutex
+	lockGetWorktreeCurrentBranch   sync.RWMutex
+	lockGetWorktreePath            sync.RWMutex
+	lockGetWorktreeStatus          sync.RWMutex
+	lockRemoveArchivedWorktree     sync.RWMutex
+	lockRemoveWorktree             sync.RWMutex
+	lockRemoveWorktreeWithoutForce sync.RWMutex
+}
+
+// ArchiveWorktree calls ArchiveWorktreeFunc.
+func (mock *worktreeRemoverMock) ArchiveWorktree(worktreeName string, destDir string) error {
+	if mock.ArchiveWorktreeFunc == nil {
+		panic("worktreeRemoverMock.ArchiveWorktreeFunc: method is nil but worktreeRemover.ArchiveWorktree was just called")
+	}
+	callInfo := struct {
+		WorktreeName string
+		DestDir      string
+	}{
+		WorktreeName: worktreeName,
+		DestDir:      destDir,
+	}
+	mock.lockArchiveWorktree.Lock()
+	mock.calls.ArchiveWorktree = append(mock.calls.ArchiveWorktree, callInfo)
+	mock.lockArchiveWorktree.Unlock()
+	return mock.ArchiveWorktreeFunc(worktreeName, destDir)
+}
+
+// ArchiveWorktreeCalls gets all the calls that were made to ArchiveWorktree.
+// Check the length with:
+//
+//	len(mockedworktreeRemover.ArchiveWorktreeCalls())
+func (mock *worktreeRemoverMock) ArchiveWorktreeCalls() []struct {
+	WorktreeName string
+	DestDir      string
+} {
+	var calls []struct {
+		WorktreeName string
+		DestDir      string
+	}
+	mock.lockArchiveWorktree.RLock()
+	calls = mock.calls.ArchiveWorktree
+	mock.lockArchiveWorktree.RUnlock()
+	return calls
+}
+
+// DeleteLocalBranch calls DeleteLocalBranchFunc.
+func (mock *worktreeRemoverMock) DeleteLocalBranch(branchName string, force bool) error {
+	if mock.DeleteLocalBranchFunc == nil {
+		panic("worktreeRemoverMock.DeleteLocalBranchFunc: method is nil but worktreeRemover.DeleteLocalBranch was just called")
+	}
+	callInfo := struct {
+		BranchName string
+		Force      bool
+	}{
+		BranchName: branchName,
+		Force:      force,
+	}
+	mock.lockDeleteLocalBranch.Lock()
+	mock.calls.DeleteLocalBranch = append(mock.calls.DeleteLocalBranch, callInfo)
+	mock.lockDeleteLocalBranch.Unlock()
+	return mock.DeleteLocalBranchFunc(branchName, force)
+}
+
+// DeleteLocalBranchCalls gets all the calls that were made to DeleteLocalBranch.
+// Check the length with:
+//
+//	len(mockedworktreeRemover.DeleteLocalBranchCalls())
+func (mock *worktreeRemoverMock) DeleteLocalBranchCalls() []struct {
+	BranchName string
+	Force      bool
+} {
+	var calls []struct {
+		BranchName string
+		Force      bool
+	}
+	mock.lockDeleteLocalBranch.RLock()
+	calls = mock.calls.DeleteLocalBranch
+	mock.lockDeleteLocalBranch.RUnlock()
+	return calls
+}
+
+// DeleteRemoteBranch calls DeleteRemoteBranchFunc.
+func (mock *worktreeRemoverMock) DeleteRemoteBranch(remoteName string, branchName string) error {
+	if mock.DeleteRemoteBranchFunc == nil {
+		panic("worktreeRemoverMock.DeleteRemoteBranchFunc: method is nil but worktreeRemover.DeleteRemoteBranch was just called")
+	}
+	callInfo := struct {
+		RemoteName string
+		BranchName string
+	}{
+		RemoteName: remoteName,
+		BranchName: branchName,
+	}
+	mock.lockDeleteRemoteBranch.Lock()
+	mock.calls.DeleteRemoteBranch = append(mock.calls.DeleteRemoteBranch, callInfo)
+	mock.lockDeleteRemoteBranch.Unlock()
+	return mock.DeleteRemoteBranchFunc(remoteName, branchName)
+}
+
+// DeleteRemoteBranchCalls gets all the calls that were made to DeleteRemoteBranch.
+// Check the length with:
+//
+//	len(mockedworktreeRemover.DeleteRemoteBranchCalls())
+func (mock *worktreeRemoverMock) DeleteRemoteBranchCalls() []struct {
+	RemoteName string
+	BranchName string
+} {
+	var calls []struct {
+		RemoteName string
+		BranchName string
 	}
-	lockGetAllWorktrees   sync.RWMutex
-	lockGetWorktreePath   sync.RWMutex
-	lockGetWorktreeStatus sync.RWMutex
-	lockRemoveWorktree    sync.RWMutex
+	mock.lockDeleteRemoteBranch.RLock()
+	calls = mock.calls.DeleteRemoteBranch
+	mock.lockDeleteRemoteBranch.RUnlock()
+	return calls
 }
 
 // GetAllWorktrees calls GetAllWorktreesFunc.
@@ -103,6 +299,65 @@ func (mock *worktreeRemoverMock) GetAllWorktreesCalls() []struct {
 	return calls
 }
 
+// GetDefaultRemote calls GetDefaultRemoteFunc.
+func (mock *worktreeRemoverMock) GetDefaultRemote() string {
+	if mock.GetDefaultRemoteFunc == nil {
+		panic("worktreeRemoverMock.GetDefaultRemoteFunc: method is nil but worktreeRemover.GetDefaultRemote was just called")
+	}
+	callInfo := struct {
+	}{}
+	mock.lockGetDefaultRemote.Lock()
+	mock.calls.GetDefaultRemote = append(mock.calls.GetDefaultRemote, callInfo)
+	mock.lockGetDefaultRemote.Unlock()
+	return mock.GetDefaultRemoteFunc()
+}
+
+// GetDefaultRemoteCalls gets all the calls that were made to GetDefaultRemote.
+// Check the length with:
+//
+//	len(mockedworktreeRemover.GetDefaultRemoteCalls())
+func (mock *worktreeRemoverMock) GetDefaultRemoteCalls() []struct {
+} {
+	var calls []struct {
+	}
+	mock.lockGetDefaultRemote.RLock()
+	calls = mock.calls.GetDefaultRemote
+	mock.lockGetDefaultRemote.RUnlock()
+	return calls
+}
+
+// GetWorktreeCurrentBranch calls GetWorktreeCurrentBranchFunc.
+func (mock *worktreeRemoverMock) GetWorktreeCurrentBranch(worktreePath string) (string, error) {
+	if mock.GetWorktreeCurrentBranchFunc == nil {
+		panic("worktreeRemoverMock.GetWorktreeCurrentBranchFunc: method is nil but worktreeRemover.GetWorktreeCurrentBranch was just called")
+	}
+	callInfo := struct {
+		WorktreePath string
+	}{
+		WorktreePath: worktreePath,
+	}
+	mock.lockGetWorktreeCurrentBranch.Lock()
+	mock.calls.GetWorktreeCurrentBranch = append(mock.calls.GetWorktreeCurrentBranch, callInfo)
+	mock.lockGetWorktreeCurrentBranch.Unlock()
+	return mock.GetWorktreeCurrentBranchFunc(worktreePath)
+}
+
+// GetWorktreeCurrentBranchCalls gets all the calls that were made to GetWorktreeCurrentBranch.
+// Check the length with:
+//
+//	len(mockedworktreeRemover.GetWorktreeCurrentBranchCalls())
+func (mock *worktreeRemoverMock) GetWorktreeCurrentBranchCalls() []struct {
+	WorktreePath string
+} {
+	var calls []struct {
+		WorktreePath string
+	}
+	mock.lockGetWorktreeCurrentBranch.RLock()
+	calls = mock.calls.GetWorktreeCurrentBranch
+	mock.lockGetWorktreeCurrentBranch.RUnlock()
+	return calls
+}
+
 // GetWorktreePath calls GetWorktreePathFunc.
 func (mock *worktreeRemoverMock) GetWorktreePath(worktreeName string) (string, error) {
 	if mock.GetWorktreePathFunc == nil {
@@ -167,6 +422,38 @@ func (mock *worktreeRemoverMock) GetWorktreeStatusCalls() []struct {
 	return calls
 }
 
+// RemoveArchivedWorktree calls RemoveArchivedWorktreeFunc.
+func (mock *worktreeRemoverMock) RemoveArchivedWorktree(worktreeName string) error {
+	if mock.RemoveArchivedWorktreeFunc == nil {
+		panic("worktreeRemoverMock.RemoveArchivedWorktreeFunc: method is nil but worktreeRemover.RemoveArchivedWorktree was just called")
+	}
+	callInfo := struct {
+		WorktreeName string
+	}{
+		WorktreeName: worktreeName,
+	}
+	mock.lockRemoveArchivedWorktree.Lock()
+	mock.calls.RemoveArchivedWorktree = append(mock.calls.RemoveArchivedWorktree, callInfo)
+	mock.lockRemoveArchivedWorktree.Unlock()
+	return mock.RemoveArchivedWorktreeFunc(worktreeName)
+}
+
+// RemoveArchivedWorktreeCalls gets all the calls that were made to RemoveArchivedWorktree.
+// Check the length with:
+//
+//	len(mockedworktreeRemover.RemoveArchivedWorktreeCalls())
+func (mock *worktreeRemoverMock) RemoveArchivedWorktreeCalls() []struct {
+	WorktreeName string
+} {
+	var calls []struct {
+		WorktreeName string
+	}
+	mock.lockRemoveArchivedWorktree.RLock()
+	calls = mock.calls.RemoveArchivedWorktree
+	mock.lockRemoveArchivedWorktree.RUnlock()
+	return calls
+}
+
 // RemoveWorktree calls RemoveWorktreeFunc.
 func (mock *worktreeRemoverMock) RemoveWorktree(worktreeName string) error {
 	if mock.RemoveWorktreeFunc == nil {
@@ -198,3 +485,35 @@ func (mock *worktreeRemoverMock) RemoveWorktreeCalls() []struct {
 	mock.lockRemoveWorktree.RUnlock()
 	return calls
 }
+
+// RemoveWorktreeWithoutForce calls RemoveWorktreeWithoutForceFunc.
+func (mock *worktreeRemoverMock) RemoveWorktreeWithoutForce(worktreeName string) error {
+	if mock.RemoveWorktreeWithoutForceFunc == nil {
+		panic("worktreeRemoverMock.RemoveWorktreeWithoutForceFunc: method is nil but worktreeRemover.RemoveWorktreeWithoutForce was just called")
+	}
+	callInfo := struct {
+		WorktreeName string
+	}{
+		WorktreeName: worktreeName,
+	}
+	mock.lockRemoveWorktreeWithoutForce.Lock()
+	mock.calls.RemoveWorktreeWithoutForce = append(mock.calls.RemoveWorktreeWithoutForce, callInfo)
+	mock.lockRemoveWorktreeWithoutForce.Unlock()
+	return mock.RemoveWorktreeWithoutForceFunc(worktreeName)
+}
+
+// RemoveWorktreeWithoutForceCalls gets all the calls that were made to RemoveWorktreeWithoutForce.
+// Check the length with:
+//
+//	len(mockedworktreeRemover.RemoveWorktreeWithoutForceCalls())
+func (mock *worktreeRemoverMock) RemoveWorktreeWithoutForceCalls() []struct {
+	WorktreeName string
+} {
+	var calls []struct {
+		WorktreeName string
+	}
+	mock.lockRemoveWorktreeWithoutForce.RLock()
+	calls = mock.calls.RemoveWorktreeWithoutForce
+	mock.lockRemoveWorktreeWithoutForce.RUnlock()
+	return calls
+}
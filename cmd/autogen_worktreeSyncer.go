@@ -21,7 +21,7 @@ var _ worktreeSyncer = &worktreeSyncerMock{}
 //			GetSyncStatusFunc: func() (*internal.SyncStatus, error) {
 //				panic("mock out the GetSyncStatus method")
 //			},
-//			SyncWithConfirmationFunc: func(dryRun bool, force bool, removeOrphans bool, confirmFunc internal.ConfirmationFunc) error {
+//			SyncWithConfirmationFunc: func(opts internal.SyncOptions, confirmFunc internal.ConfirmationFunc) error {
 //				panic("mock out the SyncWithConfirmation method")
 //			},
 //		}
@@ -35,7 +35,7 @@ type worktreeSyncerMock struct {
 	GetSyncStatusFunc func() (*internal.SyncStatus, error)
 
 	// SyncWithConfirmationFunc mocks the SyncWithConfirmation method.
-	SyncWithConfirmationFunc func(dryRun bool, force bool, removeOrphans bool, confirmFunc internal.ConfirmationFunc) error
+	SyncWithConfirmationFunc func(opts internal.SyncOptions, confirmFunc internal.ConfirmationFunc) error
 
 	// calls tracks calls to the methods.
 	calls struct {
@@ -44,12 +44,8 @@ type worktreeSyncerMock struct {
 		}
 		// SyncWithConfirmation holds details about calls to the SyncWithConfirmation method.
 		SyncWithConfirmation []struct {
-			// DryRun is the dryRun argument value.
-			DryRun bool
-			// Force is the force argument value.
-			Force bool
-			// RemoveOrphans is the removeOrphans argument value.
-			RemoveOrphans bool
+			// Opts is the opts argument value.
+			Opts internal.SyncOptions
 			// ConfirmFunc is the confirmFunc argument value.
 			ConfirmFunc internal.ConfirmationFunc
 		}
@@ -86,25 +82,21 @@ func (mock *worktreeSyncerMock) GetSyncStatusCalls() []struct {
 }
 
 // SyncWithConfirmation calls SyncWithConfirmationFunc.
-func (mock *worktreeSyncerMock) SyncWithConfirmation(dryRun bool, force bool, removeOrphans bool, confirmFunc internal.ConfirmationFunc) error {
+func (mock *worktreeSyncerMock) SyncWithConfirmation(opts internal.SyncOptions, confirmFunc internal.ConfirmationFunc) error {
 	if mock.SyncWithConfirmationFunc == nil {
 		panic("worktreeSyncerMock.SyncWithConfirmationFunc: method is nil but worktreeSyncer.SyncWithConfirmation was just called")
 	}
 	callInfo := struct {
-		DryRun        bool
-		Force         bool
-		RemoveOrphans bool
-		ConfirmFunc   internal.ConfirmationFunc
+		Opts        internal.SyncOptions
+		ConfirmFunc internal.ConfirmationFunc
 	}{
-		DryRun:        dryRun,
-		Force:         force,
-		RemoveOrphans: removeOrphans,
-		ConfirmFunc:   confirmFunc,
+		Opts:        opts,
+		ConfirmFunc: confirmFunc,
 	}
 	mock.lockSyncWithConfirmation.Lock()
 	mock.calls.SyncWithConfirmation = append(mock.calls.SyncWithConfirmation, callInfo)
 	mock.lockSyncWithConfirmation.Unlock()
-	return mock.SyncWithConfirmationFunc(dryRun, force, removeOrphans, confirmFunc)
+	return mock.SyncWithConfirmationFunc(opts, confirmFunc)
 }
 
 // SyncWithConfirmationCalls gets all the calls that were made to SyncWithConfirmation.
@@ -112,16 +104,12 @@ func (mock *worktreeSyncerMock) SyncWithConfirmation(dryRun bool, force bool, re
 //
 //	len(mockedworktreeSyncer.SyncWithConfirmationCalls())
 func (mock *worktreeSyncerMock) SyncWithConfirmationCalls() []struct {
-	DryRun        bool
-	Force         bool
-	RemoveOrphans bool
-	ConfirmFunc   internal.ConfirmationFunc
+	Opts        internal.SyncOptions
+	ConfirmFunc internal.ConfirmationFunc
 } {
 	var calls []struct {
-		DryRun        bool
-		Force         bool
-		RemoveOrphans bool
-		ConfirmFunc   internal.ConfirmationFunc
+		Opts        internal.SyncOptions
+		ConfirmFunc internal.ConfirmationFunc
 	}
 	mock.lockSyncWithConfirmation.RLock()
 	calls = mock.calls.SyncWithConfirmation
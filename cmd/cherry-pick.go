@@ -0,0 +1,171 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"gbm/internal"
+
+	"github.com/spf13/cobra"
+)
+
+//go:generate go run github.com/matryer/moq@latest -out ./autogen_worktreeCherryPicker.go . worktreeCherryPicker
+
+// worktreeCherryPicker interface abstracts the Manager operations needed to cherry-pick commits into a worktree
+type worktreeCherryPicker interface {
+	GetWorktreePath(worktreeName string) (string, error)
+	IsCherryPickInProgress(worktreePath string) (bool, error)
+	CherryPick(worktreePath string, commits []string) error
+	ContinueCherryPick(worktreePath string) error
+	CherryPickAbort(worktreePath string) error
+	ListConflictedFiles(worktreePath string) ([]string, error)
+}
+
+func newCherryPickCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cherry-pick <worktree-name> <commit>...",
+		Short: "Cherry-pick commits onto a worktree's branch",
+		Long: `Apply specific commits onto the branch checked out in a worktree.
+
+Use this to propagate selected commits, such as a hotfix that landed on production,
+when a full mergeback would bring along too much. Commits are applied in the order given.
+
+If a commit stops on conflicts, resolve them in the worktree, stage the files and run
+'gbm cherry-pick --continue <worktree>', or give up with 'gbm cherry-pick --abort <worktree>'.
+
+Examples:
+  gbm cherry-pick staging a1b2c3d               # Apply one commit to the staging worktree
+  gbm cherry-pick staging a1b2c3d e4f5a6b       # Apply several commits in order
+  gbm cherry-pick --continue staging            # Continue after resolving conflicts
+  gbm cherry-pick --abort staging               # Abandon the cherry-pick`,
+		Args: func(cmd *cobra.Command, args []string) error {
+			abort, _ := cmd.Flags().GetBool("abort")
+			cont, _ := cmd.Flags().GetBool("continue")
+			if abort || cont {
+				return cobra.ExactArgs(1)(cmd, args)
+			}
+			return cobra.MinimumNArgs(2)(cmd, args)
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			abort, _ := cmd.Flags().GetBool("abort")
+			cont, _ := cmd.Flags().GetBool("continue")
+
+			manager, err := createInitializedManager()
+			if err != nil {
+				return err
+			}
+
+			switch {
+			case abort:
+				return handleCherryPickAbort(manager, args[0])
+			case cont:
+				return handleCherryPickContinue(manager, args[0])
+			default:
+				return handleCherryPick(manager, args[0], args[1:])
+			}
+		},
+	}
+
+	cmd.Flags().Bool("abort", false, "abort the in-progress cherry-pick in the worktree")
+	cmd.Flags().Bool("continue", false, "continue the in-progress cherry-pick after resolving conflicts")
+	cmd.MarkFlagsMutuallyExclusive("abort", "continue")
+
+	cmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) != 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return getWorktreeCompletionsWithManager(), cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+// handleCherryPick applies commits onto the worktree's branch
+func handleCherryPick(picker worktreeCherryPicker, worktreeName string, commits []string) error {
+	worktreePath, err := picker.GetWorktreePath(worktreeName)
+	if err != nil {
+		return err
+	}
+
+	inProgress, err := picker.IsCherryPickInProgress(worktreePath)
+	if err != nil {
+		return err
+	}
+	if inProgress {
+		return fmt.Errorf("a cherry-pick is already in progress in worktree '%s'; use --continue or --abort", worktreeName)
+	}
+
+	PrintInfo("Cherry-picking %d commit(s) into worktree '%s'...", len(commits), worktreeName)
+	if err := picker.CherryPick(worktreePath, commits); err != nil {
+		return handleCherryPickError(picker, worktreeName, worktreePath, err)
+	}
+
+	PrintInfo("%s", internal.FormatSuccess(fmt.Sprintf("Cherry-picked %d commit(s) into worktree '%s'", len(commits), worktreeName)))
+	return nil
+}
+
+// handleCherryPickContinue resumes a cherry-pick stopped on conflicts
+func handleCherryPickContinue(picker worktreeCherryPicker, worktreeName string) error {
+	worktreePath, err := requireCherryPickInProgress(picker, worktreeName)
+	if err != nil {
+		return err
+	}
+
+	if err := picker.ContinueCherryPick(worktreePath); err != nil {
+		return handleCherryPickError(picker, worktreeName, worktreePath, err)
+	}
+
+	PrintInfo("%s", internal.FormatSuccess(fmt.Sprintf("Finished cherry-pick in worktree '%s'", worktreeName)))
+	return nil
+}
+
+// handleCherryPickAbort abandons the in-progress cherry-pick in a worktree
+func handleCherryPickAbort(picker worktreeCherryPicker, worktreeName string) error {
+	worktreePath, err := requireCherryPickInProgress(picker, worktreeName)
+	if err != nil {
+		return err
+	}
+
+	if err := picker.CherryPickAbort(worktreePath); err != nil {
+		return fmt.Errorf("failed to abort cherry-pick: %w", err)
+	}
+
+	PrintInfo("%s", internal.FormatSuccess(fmt.Sprintf("Aborted cherry-pick in worktree '%s'", worktreeName)))
+	return nil
+}
+
+func requireCherryPickInProgress(picker worktreeCherryPicker, worktreeName string) (string, error) {
+	worktreePath, err := picker.GetWorktreePath(worktreeName)
+	if err != nil {
+		return "", err
+	}
+
+	inProgress, err := picker.IsCherryPickInProgress(worktreePath)
+	if err != nil {
+		return "", err
+	}
+	if !inProgress {
+		return "", fmt.Errorf("no cherry-pick in progress in worktree '%s'", worktreeName)
+	}
+
+	return worktreePath, nil
+}
+
+// handleCherryPickError reports conflicted files when the cherry-pick stopped on conflicts; other errors are returned as is
+func handleCherryPickError(picker worktreeCherryPicker, worktreeName, worktreePath string, err error) error {
+	if !errors.Is(err, internal.ErrCherryPickConflict) {
+		return fmt.Errorf("failed to cherry-pick into worktree '%s': %w", worktreeName, err)
+	}
+
+	PrintInfo("Cherry-pick conflicts detected in worktree '%s'", worktreeName)
+	if files, err := picker.ListConflictedFiles(worktreePath); err != nil {
+		PrintVerbose("Could not list conflicted files: %v", err)
+	} else {
+		for _, file := range files {
+			PrintInfo("  • %s", file)
+		}
+	}
+	PrintInfo("Resolve the conflicts and stage the files, then run: gbm cherry-pick --continue %s", worktreeName)
+	PrintInfo("To give up and restore the branch, run: gbm cherry-pick --abort %s", worktreeName)
+	return nil
+}
@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"gbm/internal"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newCherryPickerMock(inProgress bool) *worktreeCherryPickerMock {
+	return &worktreeCherryPickerMock{
+		GetWorktreePathFunc: func(worktreeName string) (string, error) {
+			return "/repo/worktrees/" + worktreeName, nil
+		},
+		IsCherryPickInProgressFunc: func(worktreePath string) (bool, error) {
+			return inProgress, nil
+		},
+		CherryPickFunc: func(worktreePath string, commits []string) error {
+			return nil
+		},
+		ContinueCherryPickFunc: func(worktreePath string) error {
+			return nil
+		},
+		CherryPickAbortFunc: func(worktreePath string) error {
+			return nil
+		},
+		ListConflictedFilesFunc: func(worktreePath string) ([]string, error) {
+			return []string{"content.txt"}, nil
+		},
+	}
+}
+
+func TestHandleCherryPick(t *testing.T) {
+	tests := []struct {
+		name      string
+		mockSetup func() *worktreeCherryPickerMock
+		assertErr func(t *testing.T, err error)
+		assertRun func(t *testing.T, mock *worktreeCherryPickerMock)
+	}{
+		{
+			name: "applies the commits in the worktree",
+			mockSetup: func() *worktreeCherryPickerMock {
+				return newCherryPickerMock(false)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+			assertRun: func(t *testing.T, mock *worktreeCherryPickerMock) {
+				assert.Len(t, mock.CherryPickCalls(), 1)
+				assert.Equal(t, "/repo/worktrees/staging", mock.CherryPickCalls()[0].WorktreePath)
+				assert.Equal(t, []string{"a1b2c3d", "e4f5a6b"}, mock.CherryPickCalls()[0].Commits)
+			},
+		},
+		{
+			name: "unknown worktree",
+			mockSetup: func() *worktreeCherryPickerMock {
+				mock := newCherryPickerMock(false)
+				mock.GetWorktreePathFunc = func(worktreeName string) (string, error) {
+					return "", fmt.Errorf("worktree '%s' not found", worktreeName)
+				}
+				return mock
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "not found")
+			},
+			assertRun: func(t *testing.T, mock *worktreeCherryPickerMock) {
+				assert.Empty(t, mock.CherryPickCalls())
+			},
+		},
+		{
+			name: "cherry-pick already in progress",
+			mockSetup: func() *worktreeCherryPickerMock {
+				return newCherryPickerMock(true)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "already in progress")
+			},
+			assertRun: func(t *testing.T, mock *worktreeCherryPickerMock) {
+				assert.Empty(t, mock.CherryPickCalls())
+			},
+		},
+		{
+			name: "conflicts are reported and left for resolution",
+			mockSetup: func() *worktreeCherryPickerMock {
+				mock := newCherryPickerMock(false)
+				mock.CherryPickFunc = func(worktreePath string, commits []string) error {
+					return fmt.Errorf("%w: %s", internal.ErrCherryPickConflict, worktreePath)
+				}
+				return mock
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+			assertRun: func(t *testing.T, mock *worktreeCherryPickerMock) {
+				assert.Len(t, mock.ListConflictedFilesCalls(), 1)
+				assert.Empty(t, mock.CherryPickAbortCalls())
+			},
+		},
+		{
+			name: "other cherry-pick failures are returned",
+			mockSetup: func() *worktreeCherryPickerMock {
+				mock := newCherryPickerMock(false)
+				mock.CherryPickFunc = func(worktreePath string, commits []string) error {
+					return errors.New("git cherry-pick failed: fatal: bad revision 'nope'")
+				}
+				return mock
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "bad revision")
+			},
+			assertRun: func(t *testing.T, mock *worktreeCherryPickerMock) {
+				assert.Empty(t, mock.ListConflictedFilesCalls())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := tt.mockSetup()
+			tt.assertErr(t, handleCherryPick(mock, "staging", []string{"a1b2c3d", "e4f5a6b"}))
+			tt.assertRun(t, mock)
+		})
+	}
+}
+
+func TestHandleCherryPickContinueAndAbort(t *testing.T) {
+	t.Run("continue requires a cherry-pick in progress", func(t *testing.T) {
+		mock := newCherryPickerMock(false)
+		assert.ErrorContains(t, handleCherryPickContinue(mock, "staging"), "no cherry-pick in progress")
+		assert.Empty(t, mock.ContinueCherryPickCalls())
+	})
+
+	t.Run("continue resumes the cherry-pick", func(t *testing.T) {
+		mock := newCherryPickerMock(true)
+		assert.NoError(t, handleCherryPickContinue(mock, "staging"))
+		assert.Len(t, mock.ContinueCherryPickCalls(), 1)
+	})
+
+	t.Run("abort requires a cherry-pick in progress", func(t *testing.T) {
+		mock := newCherryPickerMock(false)
+		assert.ErrorContains(t, handleCherryPickAbort(mock, "staging"), "no cherry-pick in progress")
+		assert.Empty(t, mock.CherryPickAbortCalls())
+	})
+
+	t.Run("abort abandons the cherry-pick", func(t *testing.T) {
+		mock := newCherryPickerMock(true)
+		assert.NoError(t, handleCherryPickAbort(mock, "staging"))
+		assert.Len(t, mock.CherryPickAbortCalls(), 1)
+	})
+}
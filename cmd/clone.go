@@ -19,10 +19,18 @@ func newCloneCommand() *cobra.Command {
 		Short: "Clone a repository as a bare repo and create the main worktree",
 		Long: `Clone a repository as a bare repository and create the main worktree
 using the HEAD branch. This sets up the repository structure for
-worktree-based development.`,
+worktree-based development.
+
+If the default branch contains a gbm.branchconfig.yaml, every worktree it
+defines is created as well. Use --prefix to place worktrees somewhere other
+than "worktrees".`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			repoUrl := args[0]
+			prefix, _ := cmd.Flags().GetString("prefix")
+			if prefix == "" {
+				return fmt.Errorf("--prefix cannot be empty")
+			}
 
 			PrintInfo("Cloning repository using git-bare-clone.sh...")
 			if err := runGitBareClone(repoUrl); err != nil {
@@ -37,17 +45,17 @@ worktree-based development.`,
 			PrintInfo("Default branch: %s", defaultBranch)
 
 			PrintInfo("Creating main worktree...")
-			if err := createMainWorktree(defaultBranch); err != nil {
+			if err := createMainWorktree(defaultBranch, prefix); err != nil {
 				return fmt.Errorf("failed to create main worktree: %w", err)
 			}
 
 			PrintInfo("Setting up gbm.branchconfig.yaml configuration...")
-			if err := setupGBMConfig(defaultBranch); err != nil {
+			if err := setupGBMConfig(defaultBranch, prefix); err != nil {
 				return fmt.Errorf("failed to setup gbm.branchconfig.yaml: %w", err)
 			}
 
 			PrintInfo("Initializing worktree management...")
-			if err := initializeWorktreeManagement(); err != nil {
+			if err := initializeWorktreeManagement(prefix); err != nil {
 				return fmt.Errorf("failed to initialize worktree management: %w", err)
 			}
 
@@ -56,6 +64,8 @@ worktree-based development.`,
 		},
 	}
 
+	cmd.Flags().String("prefix", internal.DefaultWorktreeDirname, "directory to create worktrees in (sets settings.worktree_prefix)")
+
 	return cmd
 }
 
@@ -172,15 +182,15 @@ func getDefaultBranch() (string, error) {
 	return parts[len(parts)-1], nil
 }
 
-func createMainWorktree(defaultBranch string) error {
+func createMainWorktree(defaultBranch, prefix string) error {
 	// Create worktrees directory
-	if err := os.MkdirAll("worktrees", 0o755); err != nil {
+	if err := os.MkdirAll(prefix, 0o755); err != nil {
 		return fmt.Errorf("failed to create worktrees directory: %w", err)
 	}
 
 	// Create the main worktree using the default branch name as the worktree name
 	worktreeName := defaultBranch
-	cmd := exec.Command("git", "worktree", "add", filepath.Join("worktrees", worktreeName), defaultBranch)
+	cmd := exec.Command("git", "worktree", "add", filepath.Join(prefix, worktreeName), defaultBranch)
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 	if err := cmd.Run(); err != nil {
@@ -190,8 +200,8 @@ func createMainWorktree(defaultBranch string) error {
 	return nil
 }
 
-func setupGBMConfig(defaultBranch string) error {
-	worktreeConfigPath := filepath.Join("worktrees", defaultBranch, internal.DefaultBranchConfigFilename)
+func setupGBMConfig(defaultBranch, prefix string) error {
+	worktreeConfigPath := filepath.Join(prefix, defaultBranch, internal.DefaultBranchConfigFilename)
 	branchConfigPath := internal.DefaultBranchConfigFilename
 
 	// Check if gbm.branchconfig.yaml exists in the default branch worktree
@@ -252,7 +262,7 @@ worktrees:
 	return os.WriteFile(path, []byte(content), 0o644)
 }
 
-func initializeWorktreeManagement() error {
+func initializeWorktreeManagement(prefix string) error {
 	// Get current working directory (repository root)
 	wd, err := os.Getwd()
 	if err != nil {
@@ -266,10 +276,17 @@ func initializeWorktreeManagement() error {
 	}
 
 	// Save default config and state to create .gbm directory
+	manager.GetConfig().Settings.WorktreePrefix = prefix
 	if err := manager.SaveConfig(); err != nil {
 		return fmt.Errorf("failed to initialize .gbm/config.toml: %w", err)
 	}
 
+	// Reload so the git manager picks up the worktree prefix
+	manager, err = internal.NewManager(wd)
+	if err != nil {
+		return fmt.Errorf("failed to create manager: %w", err)
+	}
+
 	if err := manager.SaveState(); err != nil {
 		return fmt.Errorf("failed to initialize .gbm/state.toml: %w", err)
 	}
@@ -286,7 +303,7 @@ func initializeWorktreeManagement() error {
 	if err := manager.Sync(false, false); err != nil {
 		// For clone operations, we want to be more permissive and not fail
 		// if there are invalid branch references in the branch config file
-		PrintInfo("Warning: some branch references in %s may be invalid: %v", internal.DefaultBranchConfigFilename, err)
+		PrintWarning("some branch references in %s may be invalid: %v", internal.DefaultBranchConfigFilename, err)
 		PrintInfo("You can run 'gbm sync' later to resolve any issues")
 	}
 
@@ -114,6 +114,34 @@ func TestCloneCommand_WithExistingGBMConfig(t *testing.T) {
 	assert.Equal(t, expected.Worktrees, config.Worktrees)
 }
 
+func TestCloneCommand_WithPrefix(t *testing.T) {
+	sourceRepo := testutils.NewGBMConfigRepo(t, map[string]string{
+		"main": "main",
+		"dev":  "develop",
+	})
+
+	targetDir := t.TempDir()
+	originalDir, _ := os.Getwd()
+	t.Cleanup(func() { _ = os.Chdir(originalDir) })
+
+	_ = os.Chdir(targetDir)
+
+	cmd := newRootCommand()
+	cmd.SetArgs([]string{"clone", "--prefix", "wt", sourceRepo.GetRemotePath()})
+
+	err := cmd.Execute()
+	require.NoError(t, err)
+
+	repoPath := filepath.Join(targetDir, sourceRepo.GetRepoName())
+	assert.DirExists(t, filepath.Join(repoPath, "wt", "main"))
+	assert.DirExists(t, filepath.Join(repoPath, "wt", "dev"))
+	assert.NoDirExists(t, filepath.Join(repoPath, "worktrees"))
+
+	config, err := internal.LoadConfig(filepath.Join(repoPath, ".gbm"))
+	require.NoError(t, err)
+	assert.Equal(t, "wt", config.Settings.WorktreePrefix)
+}
+
 func TestCloneCommand_WithoutGBMConfig(t *testing.T) {
 	sourceRepo := testutils.NewBasicRepo(t)
 
@@ -0,0 +1,231 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gbm/internal"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+)
+
+//go:generate go run github.com/matryer/moq@latest -out ./autogen_configEditor.go . configEditor
+
+// configEditor interface abstracts the Manager operations needed for reading and updating .gbm/config.toml
+type configEditor interface {
+	GetConfig() *internal.Config
+	SaveConfig() error
+}
+
+//go:generate go run github.com/matryer/moq@latest -out ./autogen_worktreePrefixMigrator.go . worktreePrefixMigrator
+
+// worktreePrefixMigrator interface abstracts the Manager operations needed for moving worktrees after a worktree_prefix change
+type worktreePrefixMigrator interface {
+	CheckWorktreePrefixChange() (*internal.WorktreePrefixChange, error)
+	MigrateWorktreePrefix(oldPrefix, newPrefix string) error
+}
+
+func newConfigCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Read or update settings in .gbm/config.toml",
+		Long: `Read or update settings in .gbm/config.toml without editing TOML by hand.
+
+Keys are written as <section>.<name>, matching the layout of config.toml. Lists such as
+settings.candidate_branches are given comma-separated and durations use Go syntax (30m, 3h).
+
+Examples:
+  gbm config get settings.worktree_prefix
+  gbm config set settings.mergeback_prefix MB
+  gbm config set settings.candidate_branches main,develop
+  gbm config validate`,
+	}
+
+	cmd.AddCommand(&cobra.Command{
+		Use:               "get <key>",
+		Short:             "Print the value of a config key",
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeConfigKeys,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manager, err := createConfigManager()
+			if err != nil {
+				return err
+			}
+
+			return handleConfigGet(manager, args[0])
+		},
+	})
+
+	cmd.AddCommand(&cobra.Command{
+		Use:               "set <key> <value>",
+		Short:             "Update a config key and save config.toml",
+		Args:              cobra.ExactArgs(2),
+		ValidArgsFunction: completeConfigKeys,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manager, err := createConfigManager()
+			if err != nil {
+				return err
+			}
+
+			return handleConfigSet(manager, args[0], args[1])
+		},
+	})
+
+	cmd.AddCommand(&cobra.Command{
+		Use:   "validate",
+		Short: "Check config.toml and gbm.branchconfig.yaml together",
+		Long: `Check .gbm/config.toml and gbm.branchconfig.yaml together.
+
+Reports every problem at once with file and line where possible: TOML and YAML syntax, unknown
+keys, invalid values and prefixes, the merge_into tree, branches that don't exist, and file_copy
+rules naming unknown source worktrees. Exits non-zero if any problem is found, so it can run as a
+pre-commit hook.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			wd, err := os.Getwd()
+			if err != nil {
+				return fmt.Errorf("failed to get working directory: %w", err)
+			}
+
+			// Skip the manager: it refuses to load the very config this command is meant to diagnose
+			repoPath, err := internal.FindGitRoot(wd)
+			if err != nil {
+				return fmt.Errorf("failed to find git repository root: %w", err)
+			}
+
+			return handleConfigValidate(internal.ValidateConfigFiles(repoPath))
+		},
+	})
+
+	return cmd
+}
+
+// createConfigManager loads the manager without requiring a branch config file
+func createConfigManager() (*internal.Manager, error) {
+	manager, err := createInitializedManager()
+	if err != nil {
+		if !errors.Is(err, ErrLoadGBMConfig) {
+			return nil, err
+		}
+
+		PrintVerbose("%v", err)
+	}
+
+	return manager, nil
+}
+
+func completeConfigKeys(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	var keys []string
+	for _, key := range internal.ConfigKeys() {
+		if strings.HasPrefix(key, toComplete) {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys, cobra.ShellCompDirectiveNoFileComp
+}
+
+func handleConfigGet(editor configEditor, key string) error {
+	value, err := editor.GetConfig().GetValue(key)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println(value)
+	return nil
+}
+
+func handleConfigSet(editor configEditor, key, value string) error {
+	config := editor.GetConfig()
+
+	oldValue, err := config.GetValue(key)
+	if err != nil {
+		return err
+	}
+
+	if err := config.SetValue(key, value); err != nil {
+		return err
+	}
+
+	if err := editor.SaveConfig(); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	newValue, _ := config.GetValue(key)
+	PrintInfo("%s", internal.FormatSuccess(fmt.Sprintf("Set %s: %q -> %q", key, oldValue, newValue)))
+	return nil
+}
+
+func handleConfigValidate(problems []internal.ConfigProblem) error {
+	if len(problems) == 0 {
+		PrintInfo("%s", internal.FormatSuccess(fmt.Sprintf("%s and %s are valid",
+			filepath.Join(internal.DefaultConfigDirname, internal.DefaultConfigFilename), internal.DefaultBranchConfigFilename)))
+		return nil
+	}
+
+	for _, problem := range problems {
+		PrintError("%s", problem)
+	}
+
+	return fmt.Errorf("config validation failed: %d problem(s) found", len(problems))
+}
+
+// checkWorktreePrefixChange looks for worktrees left under a previous worktree_prefix, which would
+// otherwise silently disappear from gbm's view. Only sync offers to move them; other commands just
+// warn on stderr, so worktrees are never moved as a side effect of an unrelated command.
+func checkWorktreePrefixChange(cmd *cobra.Command, migrator worktreePrefixMigrator, offerMove bool) {
+	confirm := internal.NeverConfirm
+	if offerMove {
+		if assumeYes, _ := cmd.Flags().GetBool("yes"); assumeYes {
+			confirm = internal.AlwaysConfirm
+		} else if term.IsTerminal(int(os.Stdin.Fd())) {
+			// Prompt on stderr so stdout stays clean for scripts
+			confirm = internal.NewInteractiveConfirmation(os.Stdin, os.Stderr)
+		}
+	}
+
+	if err := handleWorktreePrefixChange(migrator, confirm, offerMove); err != nil {
+		PrintError("%v", err)
+	}
+}
+
+func handleWorktreePrefixChange(migrator worktreePrefixMigrator, confirm internal.ConfirmationFunc, offerMove bool) error {
+	change, err := migrator.CheckWorktreePrefixChange()
+	if err != nil {
+		return fmt.Errorf("failed to check worktree prefix: %w", err)
+	}
+	if change == nil {
+		return nil
+	}
+
+	PrintWarning("worktree_prefix changed from '%s' to '%s'; %d worktree(s) still live under '%s' and are hidden from gbm:",
+		change.OldPrefix, change.NewPrefix, len(change.Worktrees), change.OldPrefix)
+	for _, wt := range change.Worktrees {
+		PrintInfo("  • %s (%s)", wt.Name, wt.Path)
+	}
+
+	if !offerMove {
+		PrintInfo("Run 'gbm sync' to move them into '%s', or set settings.worktree_prefix back to '%s'.", change.NewPrefix, change.OldPrefix)
+		return nil
+	}
+
+	if !confirm(fmt.Sprintf("Move them into '%s'?", change.NewPrefix)) {
+		PrintInfo("Leaving worktrees in place. Set settings.worktree_prefix back to '%s' or re-run 'gbm sync' interactively to move them.", change.OldPrefix)
+		return nil
+	}
+
+	if err := migrator.MigrateWorktreePrefix(change.OldPrefix, change.NewPrefix); err != nil {
+		return fmt.Errorf("failed to migrate worktrees: %w", err)
+	}
+
+	PrintInfo("%s", internal.FormatSuccess(fmt.Sprintf("Moved %d worktree(s) into '%s'", len(change.Worktrees), change.NewPrefix)))
+	return nil
+}
@@ -0,0 +1,235 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	"gbm/internal"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleConfigSet(t *testing.T) {
+	tests := []struct {
+		name        string
+		key         string
+		value       string
+		mockSetup   func() *configEditorMock
+		assertMocks func(t *testing.T, mock *configEditorMock)
+		assertErr   func(t *testing.T, err error)
+	}{
+		{
+			name:  "success - updates config and saves",
+			key:   "settings.mergeback_prefix",
+			value: "MB",
+			mockSetup: func() *configEditorMock {
+				config := internal.DefaultConfig()
+				return &configEditorMock{
+					GetConfigFunc:  func() *internal.Config { return config },
+					SaveConfigFunc: func() error { return nil },
+				}
+			},
+			assertMocks: func(t *testing.T, mock *configEditorMock) {
+				assert.Len(t, mock.SaveConfigCalls(), 1)
+				assert.Equal(t, "MB", mock.GetConfig().Settings.MergebackPrefix)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:  "error - unknown key lists valid keys and does not save",
+			key:   "jira.project",
+			value: "GBM",
+			mockSetup: func() *configEditorMock {
+				config := internal.DefaultConfig()
+				return &configEditorMock{
+					GetConfigFunc: func() *internal.Config { return config },
+				}
+			},
+			assertMocks: func(t *testing.T, mock *configEditorMock) {
+				assert.Len(t, mock.SaveConfigCalls(), 0)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, `unknown config key "jira.project"`)
+				assert.ErrorContains(t, err, "jira.me")
+			},
+		},
+		{
+			name:  "error - invalid value does not save",
+			key:   "settings.fetch_retries",
+			value: "lots",
+			mockSetup: func() *configEditorMock {
+				config := internal.DefaultConfig()
+				return &configEditorMock{
+					GetConfigFunc: func() *internal.Config { return config },
+				}
+			},
+			assertMocks: func(t *testing.T, mock *configEditorMock) {
+				assert.Len(t, mock.SaveConfigCalls(), 0)
+				assert.Equal(t, internal.DefaultFetchRetries, mock.GetConfig().Settings.FetchRetries)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "is not an integer")
+			},
+		},
+		{
+			name:  "error - save fails",
+			key:   "settings.auto_fetch",
+			value: "false",
+			mockSetup: func() *configEditorMock {
+				config := internal.DefaultConfig()
+				return &configEditorMock{
+					GetConfigFunc:  func() *internal.Config { return config },
+					SaveConfigFunc: func() error { return errors.New("permission denied") },
+				}
+			},
+			assertMocks: func(t *testing.T, mock *configEditorMock) {
+				assert.Len(t, mock.SaveConfigCalls(), 1)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "failed to save config")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := tt.mockSetup()
+			err := handleConfigSet(mock, tt.key, tt.value)
+
+			tt.assertMocks(t, mock)
+			tt.assertErr(t, err)
+		})
+	}
+}
+
+func TestHandleConfigGet(t *testing.T) {
+	config := internal.DefaultConfig()
+	mock := &configEditorMock{
+		GetConfigFunc: func() *internal.Config { return config },
+	}
+
+	assert.NoError(t, handleConfigGet(mock, "settings.worktree_prefix"))
+	assert.ErrorContains(t, handleConfigGet(mock, "settings.bogus"), "unknown config key")
+	assert.Len(t, mock.SaveConfigCalls(), 0)
+}
+
+func TestHandleConfigValidate(t *testing.T) {
+	assert.NoError(t, handleConfigValidate(nil))
+
+	err := handleConfigValidate([]internal.ConfigProblem{
+		{File: internal.DefaultBranchConfigFilename, Line: 4, Message: "branch 'qa' for worktree 'qa' does not exist"},
+		{File: ".gbm/config.toml", Message: "unknown key \"settings.colour\""},
+	})
+	assert.ErrorContains(t, err, "2 problem(s) found")
+}
+
+func TestHandleWorktreePrefixChange(t *testing.T) {
+	change := &internal.WorktreePrefixChange{
+		OldPrefix: "worktrees",
+		NewPrefix: "wt",
+		Worktrees: []*internal.WorktreeInfo{{Name: "dev", Path: "/repo/worktrees/dev"}},
+	}
+
+	tests := []struct {
+		name        string
+		confirm     bool
+		warnOnly    bool
+		mockSetup   func() *worktreePrefixMigratorMock
+		assertMocks func(t *testing.T, mock *worktreePrefixMigratorMock)
+		assertErr   func(t *testing.T, err error)
+	}{
+		{
+			name: "success - no prefix change does nothing",
+			mockSetup: func() *worktreePrefixMigratorMock {
+				return &worktreePrefixMigratorMock{
+					CheckWorktreePrefixChangeFunc: func() (*internal.WorktreePrefixChange, error) { return nil, nil },
+				}
+			},
+			assertMocks: func(t *testing.T, mock *worktreePrefixMigratorMock) {
+				assert.Len(t, mock.MigrateWorktreePrefixCalls(), 0)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:    "success - confirmed change migrates worktrees",
+			confirm: true,
+			mockSetup: func() *worktreePrefixMigratorMock {
+				return &worktreePrefixMigratorMock{
+					CheckWorktreePrefixChangeFunc: func() (*internal.WorktreePrefixChange, error) { return change, nil },
+					MigrateWorktreePrefixFunc:     func(oldPrefix, newPrefix string) error { return nil },
+				}
+			},
+			assertMocks: func(t *testing.T, mock *worktreePrefixMigratorMock) {
+				calls := mock.MigrateWorktreePrefixCalls()
+				assert.Len(t, calls, 1)
+				assert.Equal(t, "worktrees", calls[0].OldPrefix)
+				assert.Equal(t, "wt", calls[0].NewPrefix)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "success - declined change leaves worktrees in place",
+			mockSetup: func() *worktreePrefixMigratorMock {
+				return &worktreePrefixMigratorMock{
+					CheckWorktreePrefixChangeFunc: func() (*internal.WorktreePrefixChange, error) { return change, nil },
+				}
+			},
+			assertMocks: func(t *testing.T, mock *worktreePrefixMigratorMock) {
+				assert.Len(t, mock.MigrateWorktreePrefixCalls(), 0)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:     "success - warn only never migrates, even when confirmed",
+			confirm:  true,
+			warnOnly: true,
+			mockSetup: func() *worktreePrefixMigratorMock {
+				return &worktreePrefixMigratorMock{
+					CheckWorktreePrefixChangeFunc: func() (*internal.WorktreePrefixChange, error) { return change, nil },
+				}
+			},
+			assertMocks: func(t *testing.T, mock *worktreePrefixMigratorMock) {
+				assert.Len(t, mock.MigrateWorktreePrefixCalls(), 0)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:    "error - migration fails",
+			confirm: true,
+			mockSetup: func() *worktreePrefixMigratorMock {
+				return &worktreePrefixMigratorMock{
+					CheckWorktreePrefixChangeFunc: func() (*internal.WorktreePrefixChange, error) { return change, nil },
+					MigrateWorktreePrefixFunc: func(oldPrefix, newPrefix string) error {
+						return errors.New("worktree 'dev' is locked")
+					},
+				}
+			},
+			assertMocks: func(t *testing.T, mock *worktreePrefixMigratorMock) {
+				assert.Len(t, mock.MigrateWorktreePrefixCalls(), 1)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "failed to migrate worktrees")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := tt.mockSetup()
+			err := handleWorktreePrefixChange(mock, func(string) bool { return tt.confirm }, !tt.warnOnly)
+
+			tt.assertMocks(t, mock)
+			tt.assertErr(t, err)
+		})
+	}
+}
@@ -0,0 +1,23 @@
+package cmd
+
+import (
+	"os"
+
+	"gbm/internal"
+
+	"github.com/spf13/cobra"
+)
+
+// newConfirmation returns how cmd should answer yes/no questions: always yes with the global
+// --yes flag, otherwise by prompting on stdin
+func newConfirmation(cmd *cobra.Command) internal.ConfirmationFunc {
+	if assumeYes, _ := cmd.Flags().GetBool("yes"); assumeYes {
+		return internal.AlwaysConfirm
+	}
+	return confirmPrompt
+}
+
+// confirmPrompt asks a yes/no question on stdin
+func confirmPrompt(message string) bool {
+	return internal.NewInteractiveConfirmation(os.Stdin, os.Stdout)(message)
+}
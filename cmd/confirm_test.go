@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewConfirmation_YesFlag(t *testing.T) {
+	newCommand := func() (*cobra.Command, *cobra.Command) {
+		root := &cobra.Command{Use: "gbm"}
+		root.PersistentFlags().BoolP("yes", "y", false, "")
+		sub := &cobra.Command{Use: "sub", RunE: func(*cobra.Command, []string) error { return nil }}
+		root.AddCommand(sub)
+		return root, sub
+	}
+
+	for _, args := range [][]string{{"sub", "--yes"}, {"sub", "-y"}, {"-y", "sub"}} {
+		root, sub := newCommand()
+		root.SetArgs(args)
+		require.NoError(t, root.Execute())
+
+		assert.True(t, newConfirmation(sub)("Remove orphaned worktree?"), "args %v", args)
+	}
+
+	// Without --yes the answer comes from stdin
+	root, sub := newCommand()
+	root.SetArgs([]string{"sub"})
+	require.NoError(t, root.Execute())
+
+	err := simulateUserInput("n", func() error {
+		assert.False(t, newConfirmation(sub)("Remove orphaned worktree?"))
+		return nil
+	})
+	require.NoError(t, err)
+
+	err = simulateUserInput("yes", func() error {
+		assert.True(t, newConfirmation(sub)("Remove orphaned worktree?"))
+		return nil
+	})
+	require.NoError(t, err)
+}
@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"gbm/internal"
+
+	"github.com/spf13/cobra"
+)
+
+//go:generate go run github.com/matryer/moq@latest -out ./autogen_worktreeFileCopier.go . worktreeFileCopier
+
+// worktreeFileCopier interface abstracts the Manager operations needed for re-running file copy rules
+type worktreeFileCopier interface {
+	CopyFilesToWorktree(worktreeName string, overwrite bool) (*internal.FileCopyResult, error)
+	GetAllWorktrees() (map[string]*internal.WorktreeListInfo, error)
+	GetSortedWorktreeNames(worktrees map[string]*internal.WorktreeListInfo) []string
+}
+
+func newCopyFilesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "copy-files [worktree-name]",
+		Short: "Re-run file copy rules against existing worktrees",
+		Long: `Re-run the [file_copy] rules from .gbm/config.toml against existing worktrees.
+
+Useful after editing an untracked file (such as .env) in a source worktree to push the
+change out without recreating worktrees. Files that already exist in the target are
+skipped unless --overwrite is given.
+
+Examples:
+  gbm copy-files feature-x              # Copy missing files into feature-x
+  gbm copy-files feature-x --overwrite  # Replace existing files with the source versions
+  gbm copy-files --all                  # Copy missing files into every worktree`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			all, _ := cmd.Flags().GetBool("all")
+			overwrite, _ := cmd.Flags().GetBool("overwrite")
+
+			if all == (len(args) == 1) {
+				return fmt.Errorf("specify either a worktree name or --all")
+			}
+
+			manager, err := createInitializedManager()
+			if err != nil {
+				if !errors.Is(err, ErrLoadGBMConfig) {
+					return err
+				}
+
+				PrintVerbose("%v", err)
+			}
+
+			var worktreeName string
+			if len(args) == 1 {
+				worktreeName = args[0]
+			}
+
+			return handleCopyFiles(manager, worktreeName, all, overwrite)
+		},
+	}
+
+	cmd.Flags().Bool("all", false, "copy files into every worktree")
+	cmd.Flags().Bool("overwrite", false, "replace files that already exist in the target worktree")
+
+	return cmd
+}
+
+func handleCopyFiles(copier worktreeFileCopier, worktreeName string, all, overwrite bool) error {
+	targets := []string{worktreeName}
+	if all {
+		worktrees, err := copier.GetAllWorktrees()
+		if err != nil {
+			return fmt.Errorf("failed to get worktrees: %w", err)
+		}
+		targets = copier.GetSortedWorktreeNames(worktrees)
+	}
+
+	copied, skipped := 0, 0
+	for _, target := range targets {
+		PrintVerbose("Running file copy rules for worktree %s (overwrite=%v)", target, overwrite)
+		result, err := copier.CopyFilesToWorktree(target, overwrite)
+		if err != nil {
+			return fmt.Errorf("failed to copy files to worktree '%s': %w", target, err)
+		}
+
+		if len(result.Copied) == 0 && len(result.Skipped) == 0 {
+			continue
+		}
+
+		PrintInfo("%s:", target)
+		for _, path := range result.Copied {
+			PrintInfo("  • Copied %s", path)
+		}
+		for _, path := range result.Skipped {
+			PrintInfo("  • Skipped %s (already exists, use --overwrite to replace)", path)
+		}
+		copied += len(result.Copied)
+		skipped += len(result.Skipped)
+	}
+
+	PrintInfo("%s", internal.FormatSuccess(fmt.Sprintf("File copy complete: %d file(s) copied, %d skipped", copied, skipped)))
+	return nil
+}
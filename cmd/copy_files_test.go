@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	"gbm/internal"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleCopyFiles(t *testing.T) {
+	tests := []struct {
+		name         string
+		worktreeName string
+		all          bool
+		overwrite    bool
+		mockSetup    func() *worktreeFileCopierMock
+		assertMocks  func(t *testing.T, mock *worktreeFileCopierMock)
+		assertErr    func(t *testing.T, err error)
+	}{
+		{
+			name:         "single worktree with overwrite",
+			worktreeName: "feature-x",
+			overwrite:    true,
+			mockSetup: func() *worktreeFileCopierMock {
+				return &worktreeFileCopierMock{
+					CopyFilesToWorktreeFunc: func(worktreeName string, overwrite bool) (*internal.FileCopyResult, error) {
+						return &internal.FileCopyResult{Copied: []string{".env"}}, nil
+					},
+				}
+			},
+			assertMocks: func(t *testing.T, mock *worktreeFileCopierMock) {
+				calls := mock.CopyFilesToWorktreeCalls()
+				assert.Len(t, calls, 1)
+				assert.Equal(t, "feature-x", calls[0].WorktreeName)
+				assert.True(t, calls[0].Overwrite)
+				assert.Len(t, mock.GetAllWorktreesCalls(), 0)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "all worktrees in sorted order",
+			all:  true,
+			mockSetup: func() *worktreeFileCopierMock {
+				return &worktreeFileCopierMock{
+					GetAllWorktreesFunc: func() (map[string]*internal.WorktreeListInfo, error) {
+						return map[string]*internal.WorktreeListInfo{"main": {}, "feat": {}}, nil
+					},
+					GetSortedWorktreeNamesFunc: func(worktrees map[string]*internal.WorktreeListInfo) []string {
+						return []string{"main", "feat"}
+					},
+					CopyFilesToWorktreeFunc: func(worktreeName string, overwrite bool) (*internal.FileCopyResult, error) {
+						return &internal.FileCopyResult{Skipped: []string{".env"}}, nil
+					},
+				}
+			},
+			assertMocks: func(t *testing.T, mock *worktreeFileCopierMock) {
+				calls := mock.CopyFilesToWorktreeCalls()
+				assert.Len(t, calls, 2)
+				assert.Equal(t, "main", calls[0].WorktreeName)
+				assert.Equal(t, "feat", calls[1].WorktreeName)
+				assert.False(t, calls[0].Overwrite)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:         "copy failure is returned",
+			worktreeName: "missing",
+			mockSetup: func() *worktreeFileCopierMock {
+				return &worktreeFileCopierMock{
+					CopyFilesToWorktreeFunc: func(worktreeName string, overwrite bool) (*internal.FileCopyResult, error) {
+						return nil, errors.New("worktree directory 'missing' does not exist")
+					},
+				}
+			},
+			assertMocks: func(t *testing.T, mock *worktreeFileCopierMock) {
+				assert.Len(t, mock.CopyFilesToWorktreeCalls(), 1)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "failed to copy files to worktree 'missing'")
+			},
+		},
+		{
+			name: "worktree listing failure is returned",
+			all:  true,
+			mockSetup: func() *worktreeFileCopierMock {
+				return &worktreeFileCopierMock{
+					GetAllWorktreesFunc: func() (map[string]*internal.WorktreeListInfo, error) {
+						return nil, errors.New("git worktree list failed")
+					},
+				}
+			},
+			assertMocks: func(t *testing.T, mock *worktreeFileCopierMock) {
+				assert.Len(t, mock.CopyFilesToWorktreeCalls(), 0)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "failed to get worktrees")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := tt.mockSetup()
+			err := handleCopyFiles(mock, tt.worktreeName, tt.all, tt.overwrite)
+
+			tt.assertMocks(t, mock)
+			tt.assertErr(t, err)
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"gbm/internal"
+
+	"github.com/spf13/cobra"
+)
+
+//go:generate go run github.com/matryer/moq@latest -out ./autogen_doctorChecker.go . doctorChecker
+
+// doctorChecker interface abstracts the Manager operations needed to run self-diagnostics
+type doctorChecker interface {
+	DoctorCheck() []internal.Diagnostic
+}
+
+func newDoctorCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "doctor",
+		Short: "Check the repository, gbm configuration and tooling for problems",
+		Long: `Run self-diagnostics and report anything that would stop gbm from working.
+
+Checks:
+  - git is installed and recent enough
+  - the default remote is configured
+  - gbm.branchconfig.yaml parses and every branch exists
+  - merge_into links form a tree without cycles
+  - every tracked worktree directory exists
+  - git has no orphaned worktree entries
+  - the jira CLI is available
+
+Exits with an error if any check fails; warnings are reported but do not fail.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manager, err := createInitializedManager()
+			if err != nil {
+				// A broken branch config is one of the problems doctor reports, so don't stop here
+				if !errors.Is(err, ErrLoadGBMConfig) {
+					return err
+				}
+
+				PrintVerbose("%v", err)
+			}
+
+			return handleDoctor(manager)
+		},
+	}
+}
+
+func handleDoctor(checker doctorChecker) error {
+	diagnostics := checker.DoctorCheck()
+
+	var warnings, failures int
+	for _, diagnostic := range diagnostics {
+		fmt.Println(formatDiagnostic(diagnostic))
+		if diagnostic.Hint != "" && diagnostic.Severity != internal.DiagnosticOK {
+			fmt.Printf("    %s\n", internal.FormatSubtle("→ "+diagnostic.Hint))
+		}
+
+		switch diagnostic.Severity {
+		case internal.DiagnosticWarning:
+			warnings++
+		case internal.DiagnosticError:
+			failures++
+		}
+	}
+
+	fmt.Println()
+	if failures > 0 {
+		return fmt.Errorf("doctor found %d problem(s) and %d warning(s)", failures, warnings)
+	}
+	if warnings > 0 {
+		PrintInfo("%s", internal.FormatWarning(fmt.Sprintf("No problems found, %d warning(s)", warnings)))
+		return nil
+	}
+
+	PrintInfo("%s", internal.FormatSuccess("No problems found"))
+	return nil
+}
+
+func formatDiagnostic(diagnostic internal.Diagnostic) string {
+	label := fmt.Sprintf("%-14s %s", diagnostic.Check, diagnostic.Message)
+	switch diagnostic.Severity {
+	case internal.DiagnosticError:
+		return internal.FormatError("✗ " + label)
+	case internal.DiagnosticWarning:
+		return internal.FormatWarning("! " + label)
+	default:
+		return internal.FormatSuccess("✓ " + label)
+	}
+}
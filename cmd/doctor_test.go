@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"testing"
+
+	"gbm/internal"
+	"gbm/internal/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleDoctor(t *testing.T) {
+	tests := []struct {
+		name        string
+		diagnostics []internal.Diagnostic
+		assertErr   func(t *testing.T, err error)
+	}{
+		{
+			name: "all checks pass",
+			diagnostics: []internal.Diagnostic{
+				{Severity: internal.DiagnosticOK, Check: "git", Message: "git 2.43.0"},
+				{Severity: internal.DiagnosticOK, Check: "remote", Message: "origin -> git@example.com:repo.git"},
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "warnings do not fail",
+			diagnostics: []internal.Diagnostic{
+				{Severity: internal.DiagnosticOK, Check: "git", Message: "git 2.43.0"},
+				{Severity: internal.DiagnosticWarning, Check: "jira", Message: "jira CLI not found", Hint: "install it"},
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "errors are counted in the returned error",
+			diagnostics: []internal.Diagnostic{
+				{Severity: internal.DiagnosticError, Check: "remote", Message: "remote 'origin' is not configured"},
+				{Severity: internal.DiagnosticError, Check: "tree", Message: "circular dependency detected"},
+				{Severity: internal.DiagnosticWarning, Check: "worktrees", Message: "missing worktree directories: dev"},
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.EqualError(t, err, "doctor found 2 problem(s) and 1 warning(s)")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &doctorCheckerMock{
+				DoctorCheckFunc: func() []internal.Diagnostic { return tt.diagnostics },
+			}
+			err := handleDoctor(mock)
+			assert.Len(t, mock.DoctorCheckCalls(), 1)
+			tt.assertErr(t, err)
+		})
+	}
+}
+
+func TestDoctorCommand_ReportsBrokenBranchConfig(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+	require.NoError(t, repo.CreateBranch("dev", "dev content"))
+	require.NoError(t, repo.SwitchToBranch("main"))
+	require.NoError(t, repo.CreateGBMConfig(map[string]testutils.WorktreeConfig{
+		"main": {Branch: "main", MergeInto: "dev"},
+		"dev":  {Branch: "dev", MergeInto: "main"},
+	}))
+	require.NoError(t, repo.CommitChanges("Add cyclic gbm config"))
+	t.Chdir(repo.GetLocalPath())
+
+	cmd := newDoctorCommand()
+	err := cmd.RunE(cmd, nil)
+
+	// The cycle is reported as a failed check rather than aborting before the checks run
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "doctor found")
+	assert.NotErrorIs(t, err, ErrLoadGBMConfig)
+}
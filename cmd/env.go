@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"fmt"
+
+	"gbm/internal"
+
+	"github.com/spf13/cobra"
+)
+
+//go:generate go run github.com/matryer/moq@latest -out ./autogen_worktreeEnvResolver.go . worktreeEnvResolver
+
+// worktreeEnvResolver interface abstracts the Manager operations needed to resolve env template values
+type worktreeEnvResolver interface {
+	GetEnvTemplateValues(worktreeName string) (*internal.EnvTemplateValues, error)
+}
+
+func newEnvCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "env <worktree-name>",
+		Short: "Print the env template values for a worktree",
+		Long: `Print the values available to the [env_template] template for a worktree.
+
+When .gbm/config.toml has an [env_template] table, gbm renders the template into every new
+worktree with Go template syntax, so each worktree gets its own ports and resource names:
+
+  [env_template]
+  template = "env.template"
+  destination = ".env"
+
+  # env.template
+  PORT={{.Port}}
+  DATABASE_NAME=app_{{.WorktreeName}}
+
+The port is derived from a hash of the worktree name, so it stays the same across re-creations.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manager, err := createInitializedManager()
+			if err != nil {
+				return err
+			}
+
+			return handleEnv(manager, args[0])
+		},
+	}
+
+	cmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) != 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return getWorktreeCompletionsWithManager(), cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+func handleEnv(resolver worktreeEnvResolver, worktreeName string) error {
+	values, err := resolver.GetEnvTemplateValues(worktreeName)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("WorktreeName=%s\n", values.WorktreeName)
+	fmt.Printf("WorktreePath=%s\n", values.WorktreePath)
+	fmt.Printf("Branch=%s\n", values.Branch)
+	fmt.Printf("Port=%d\n", values.Port)
+	return nil
+}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	"gbm/internal"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleEnv(t *testing.T) {
+	tests := []struct {
+		name         string
+		worktreeName string
+		mockSetup    func() *worktreeEnvResolverMock
+		assertErr    func(t *testing.T, err error)
+	}{
+		{
+			name:         "prints resolved values",
+			worktreeName: "dev",
+			mockSetup: func() *worktreeEnvResolverMock {
+				return &worktreeEnvResolverMock{
+					GetEnvTemplateValuesFunc: func(worktreeName string) (*internal.EnvTemplateValues, error) {
+						return &internal.EnvTemplateValues{
+							WorktreeName: worktreeName,
+							WorktreePath: "/repo/worktrees/" + worktreeName,
+							Branch:       "develop",
+							Port:         10042,
+						}, nil
+					},
+				}
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:         "unknown worktree",
+			worktreeName: "missing",
+			mockSetup: func() *worktreeEnvResolverMock {
+				return &worktreeEnvResolverMock{
+					GetEnvTemplateValuesFunc: func(worktreeName string) (*internal.EnvTemplateValues, error) {
+						return nil, errors.New("worktree directory 'missing' does not exist")
+					},
+				}
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "does not exist")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := tt.mockSetup()
+			tt.assertErr(t, handleEnv(mock, tt.worktreeName))
+			assert.Len(t, mock.GetEnvTemplateValuesCalls(), 1)
+		})
+	}
+}
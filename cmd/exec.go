@@ -0,0 +1,200 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"slices"
+	"sync"
+
+	"gbm/internal"
+
+	"github.com/spf13/cobra"
+)
+
+//go:generate go run github.com/matryer/moq@latest -out ./autogen_worktreeExecutor.go . worktreeExecutor
+
+// worktreeExecutor interface abstracts the Manager operations needed to run commands in worktrees
+type worktreeExecutor interface {
+	RunInWorktree(worktreeName string, command []string, stdin io.Reader, stdout, stderr io.Writer) error
+	RunInAllWorktrees(command []string, parallel bool, output func(worktreeName string) io.WriteCloser) ([]internal.WorktreeResult, error)
+}
+
+// execExitError carries the exit code of a command run by `gbm exec` so gbm exits with the same code
+type execExitError struct {
+	code int
+	err  error
+}
+
+func (e *execExitError) Error() string { return e.err.Error() }
+func (e *execExitError) Unwrap() error { return e.err }
+
+func newExecCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "exec (<worktree-name> | --all) [--] <command> [args...]",
+		Short: "Run a command in a worktree",
+		Long: `Run a command in a worktree, with its output streamed to the terminal.
+
+The command is run directly, not through a shell; use sh -c '...' for pipes or globs.
+GBM_WORKTREE_NAME, GBM_WORKTREE_PATH and GBM_BRANCH are set in its environment. gbm exits
+with the command's exit code.
+
+With --all, the command runs in every worktree one after another, each under a header, and
+a summary is printed at the end. --parallel runs up to four at once; the output of each
+worktree is shown when its command finishes. gbm exits non-zero if the command failed in
+any worktree.
+
+Flags for gbm must come before the worktree name; everything after it is the command.
+
+Examples:
+  gbm exec dev -- make test
+  gbm exec --all -- git gc
+  gbm exec --all --parallel --exclude-main -- go test ./...`,
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			all, _ := cmd.Flags().GetBool("all")
+			parallel, _ := cmd.Flags().GetBool("parallel")
+			excludeMain, _ := cmd.Flags().GetBool("exclude-main")
+			if (parallel || excludeMain) && !all {
+				return fmt.Errorf("--parallel and --exclude-main can only be used with --all")
+			}
+
+			worktreeName, command, err := splitExecArgs(args, cmd.ArgsLenAtDash(), all)
+			if err != nil {
+				return err
+			}
+
+			manager, err := createInitializedManager()
+			if err != nil {
+				if !errors.Is(err, ErrLoadGBMConfig) {
+					return err
+				}
+
+				PrintVerbose("%v", err)
+			}
+
+			if all {
+				manager.SetExcludeMain(excludeMain)
+				return handleExecAll(manager, cmd, command, parallel)
+			}
+
+			return handleExec(manager, cmd, worktreeName, command)
+		},
+	}
+
+	cmd.Flags().Bool("all", false, "Run the command in every worktree")
+	cmd.Flags().Bool("parallel", false, "With --all, run in several worktrees at once")
+	cmd.Flags().Bool("exclude-main", false, "With --all, skip the main worktree (root of the merge_into tree or on the default branch)")
+	// Everything after the worktree name belongs to the command, including its flags
+	cmd.Flags().SetInterspersed(false)
+
+	cmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if all, _ := cmd.Flags().GetBool("all"); all || len(args) != 0 {
+			return nil, cobra.ShellCompDirectiveDefault
+		}
+		return getWorktreeCompletionsWithManager(), cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+// splitExecArgs separates the worktree name from the command. dash is the number of arguments
+// before "--", or -1 when cobra didn't see one.
+func splitExecArgs(args []string, dash int, all bool) (string, []string, error) {
+	names := 1
+	if all {
+		names = 0
+	}
+	if dash == -1 {
+		dash = min(names, len(args))
+		// Flag parsing stops at the worktree name, so a "--" after it is left in args
+		if dash < len(args) && args[dash] == "--" {
+			args = slices.Delete(slices.Clone(args), dash, dash+1)
+		}
+	}
+
+	if dash != names {
+		if all {
+			return "", nil, fmt.Errorf("a worktree name cannot be combined with --all")
+		}
+		return "", nil, fmt.Errorf("expected a single worktree name before the command")
+	}
+	if len(args) == dash {
+		return "", nil, fmt.Errorf("no command given")
+	}
+
+	worktreeName := ""
+	if !all {
+		worktreeName = args[0]
+	}
+	return worktreeName, args[dash:], nil
+}
+
+func handleExec(executor worktreeExecutor, cmd *cobra.Command, worktreeName string, command []string) error {
+	err := executor.RunInWorktree(worktreeName, command, cmd.InOrStdin(), cmd.OutOrStdout(), cmd.ErrOrStderr())
+	if err == nil {
+		return nil
+	}
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return &execExitError{
+			code: exitErr.ExitCode(),
+			err:  fmt.Errorf("'%s' exited with status %d in worktree '%s'", command[0], exitErr.ExitCode(), worktreeName),
+		}
+	}
+
+	return fmt.Errorf("failed to run '%s' in worktree '%s': %w", command[0], worktreeName, err)
+}
+
+func handleExecAll(executor worktreeExecutor, cmd *cobra.Command, command []string, parallel bool) error {
+	out := cmd.OutOrStdout()
+	var mu sync.Mutex
+
+	results, err := executor.RunInAllWorktrees(command, parallel, func(worktreeName string) io.WriteCloser {
+		if parallel {
+			return &bufferedWorktreeOutput{worktreeName: worktreeName, out: out, mu: &mu}
+		}
+
+		printExecHeader(out, worktreeName)
+		return nopWriteCloser{out}
+	})
+	printWorktreeResults(results)
+	return err
+}
+
+func printExecHeader(out io.Writer, worktreeName string) {
+	_, _ = fmt.Fprintf(out, "==> %s <==\n", worktreeName)
+}
+
+// bufferedWorktreeOutput collects the output of a command run in parallel with others and prints it
+// under its worktree's header once the command finishes, so output from different worktrees doesn't
+// interleave
+type bufferedWorktreeOutput struct {
+	worktreeName string
+	out          io.Writer
+	mu           *sync.Mutex
+	buf          bytes.Buffer
+}
+
+func (b *bufferedWorktreeOutput) Write(p []byte) (int, error) {
+	return b.buf.Write(p)
+}
+
+func (b *bufferedWorktreeOutput) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	printExecHeader(b.out, b.worktreeName)
+	_, err := b.buf.WriteTo(b.out)
+	return err
+}
+
+// nopWriteCloser streams straight to the underlying writer
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
@@ -0,0 +1,247 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"gbm/internal"
+	"gbm/internal/testutils"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitExecArgs(t *testing.T) {
+	tests := []struct {
+		name         string
+		args         []string
+		dash         int
+		all          bool
+		expectedName string
+		expectedCmd  []string
+		expectErr    string
+	}{
+		{name: "name and command after dash", args: []string{"dev", "make", "test"}, dash: 1, expectedName: "dev", expectedCmd: []string{"make", "test"}},
+		{name: "name and command without dash", args: []string{"dev", "ls", "-la"}, dash: -1, expectedName: "dev", expectedCmd: []string{"ls", "-la"}},
+		{name: "dash left in args after the name", args: []string{"dev", "--", "ls", "--", "x"}, dash: -1, expectedName: "dev", expectedCmd: []string{"ls", "--", "x"}},
+		{name: "all with dash", args: []string{"git", "gc"}, dash: 0, all: true, expectedCmd: []string{"git", "gc"}},
+		{name: "all without dash", args: []string{"git", "gc"}, dash: -1, all: true, expectedCmd: []string{"git", "gc"}},
+		{name: "missing command", args: []string{"dev"}, dash: -1, expectErr: "no command given"},
+		{name: "several names before dash", args: []string{"dev", "feat", "ls"}, dash: 2, expectErr: "expected a single worktree name"},
+		{name: "name with all", args: []string{"dev", "ls"}, dash: 1, all: true, expectErr: "cannot be combined with --all"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, command, err := splitExecArgs(tt.args, tt.dash, tt.all)
+			if tt.expectErr != "" {
+				assert.ErrorContains(t, err, tt.expectErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedName, name)
+			assert.Equal(t, tt.expectedCmd, command)
+		})
+	}
+}
+
+func TestExecCommand_DashAfterWorktreeName(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t, testutils.WithDefaultBranch("main"))
+	defer repo.Cleanup()
+	_, err := internal.ExecGitCommand(repo.GetLocalPath(), "worktree", "add", "-b", "dev", filepath.Join("worktrees", "dev"))
+	require.NoError(t, err)
+	t.Chdir(repo.GetLocalPath())
+
+	for _, args := range [][]string{
+		{"exec", "dev", "--", "git", "rev-parse", "--abbrev-ref", "HEAD"},
+		{"exec", "dev", "git", "rev-parse", "--abbrev-ref", "HEAD"},
+	} {
+		var out bytes.Buffer
+		cmd := newRootCommand()
+		cmd.SetOut(&out)
+		cmd.SetArgs(args)
+
+		require.NoError(t, cmd.Execute(), args)
+		assert.Equal(t, "dev\n", out.String(), args)
+	}
+}
+
+func TestHandleExec(t *testing.T) {
+	tests := []struct {
+		name         string
+		mockSetup    func() *worktreeExecutorMock
+		assertErr    func(t *testing.T, err error)
+		assertOutput func(t *testing.T, output string)
+	}{
+		{
+			name: "success - output is streamed",
+			mockSetup: func() *worktreeExecutorMock {
+				return &worktreeExecutorMock{
+					RunInWorktreeFunc: func(worktreeName string, command []string, stdin io.Reader, stdout, stderr io.Writer) error {
+						_, _ = fmt.Fprintf(stdout, "%s: %v\n", worktreeName, command)
+						return nil
+					},
+				}
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+			assertOutput: func(t *testing.T, output string) {
+				assert.Equal(t, "dev: [make test]\n", output)
+			},
+		},
+		{
+			name: "error - command exit code is kept",
+			mockSetup: func() *worktreeExecutorMock {
+				return &worktreeExecutorMock{
+					RunInWorktreeFunc: func(worktreeName string, command []string, stdin io.Reader, stdout, stderr io.Writer) error {
+						return exec.Command("sh", "-c", "exit 3").Run()
+					},
+				}
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "'make' exited with status 3 in worktree 'dev'")
+				assert.Equal(t, 3, ExitCode(err))
+			},
+			assertOutput: func(t *testing.T, output string) {
+				assert.Empty(t, output)
+			},
+		},
+		{
+			name: "error - worktree not found",
+			mockSetup: func() *worktreeExecutorMock {
+				return &worktreeExecutorMock{
+					RunInWorktreeFunc: func(worktreeName string, command []string, stdin io.Reader, stdout, stderr io.Writer) error {
+						return errors.New("worktree 'dev' not found")
+					},
+				}
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "failed to run 'make' in worktree 'dev'")
+				assert.Equal(t, ExitCodeError, ExitCode(err))
+			},
+			assertOutput: func(t *testing.T, output string) {
+				assert.Empty(t, output)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			cmd := &cobra.Command{}
+			cmd.SetOut(&out)
+
+			err := handleExec(tt.mockSetup(), cmd, "dev", []string{"make", "test"})
+
+			tt.assertErr(t, err)
+			tt.assertOutput(t, out.String())
+		})
+	}
+}
+
+func TestHandleExecAll(t *testing.T) {
+	runAll := func(failIn string) func(command []string, parallel bool, output func(worktreeName string) io.WriteCloser) ([]internal.WorktreeResult, error) {
+		return func(command []string, parallel bool, output func(worktreeName string) io.WriteCloser) ([]internal.WorktreeResult, error) {
+			var results []internal.WorktreeResult
+			var failed error
+			for _, name := range []string{"dev", "feat"} {
+				out := output(name)
+				_, _ = fmt.Fprintf(out, "ran in %s\n", name)
+				_ = out.Close()
+
+				var err error
+				if name == failIn {
+					err = errors.New("exit status 1")
+					failed = fmt.Errorf("exec failed for 1 of 2 worktrees: %w", err)
+				}
+				results = append(results, internal.WorktreeResult{Name: name, Err: err})
+			}
+			return results, failed
+		}
+	}
+
+	tests := []struct {
+		name         string
+		parallel     bool
+		mockSetup    func() *worktreeExecutorMock
+		assertMocks  func(t *testing.T, mock *worktreeExecutorMock)
+		assertErr    func(t *testing.T, err error)
+		assertOutput func(t *testing.T, output string)
+	}{
+		{
+			name: "success - output of each worktree under a header",
+			mockSetup: func() *worktreeExecutorMock {
+				return &worktreeExecutorMock{RunInAllWorktreesFunc: runAll("")}
+			},
+			assertMocks: func(t *testing.T, mock *worktreeExecutorMock) {
+				calls := mock.RunInAllWorktreesCalls()
+				require.Len(t, calls, 1)
+				assert.Equal(t, []string{"git", "gc"}, calls[0].Command)
+				assert.False(t, calls[0].Parallel)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+			assertOutput: func(t *testing.T, output string) {
+				assert.Equal(t, "==> dev <==\nran in dev\n==> feat <==\nran in feat\n", output)
+			},
+		},
+		{
+			name:     "success - parallel output is printed per worktree",
+			parallel: true,
+			mockSetup: func() *worktreeExecutorMock {
+				return &worktreeExecutorMock{RunInAllWorktreesFunc: runAll("")}
+			},
+			assertMocks: func(t *testing.T, mock *worktreeExecutorMock) {
+				calls := mock.RunInAllWorktreesCalls()
+				require.Len(t, calls, 1)
+				assert.True(t, calls[0].Parallel)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+			assertOutput: func(t *testing.T, output string) {
+				assert.Equal(t, "==> dev <==\nran in dev\n==> feat <==\nran in feat\n", output)
+			},
+		},
+		{
+			name: "error - failure in one worktree is aggregated",
+			mockSetup: func() *worktreeExecutorMock {
+				return &worktreeExecutorMock{RunInAllWorktreesFunc: runAll("feat")}
+			},
+			assertMocks: func(t *testing.T, mock *worktreeExecutorMock) {
+				assert.Len(t, mock.RunInAllWorktreesCalls(), 1)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "exec failed for 1 of 2 worktrees")
+				assert.Equal(t, ExitCodeError, ExitCode(err))
+			},
+			assertOutput: func(t *testing.T, output string) {
+				assert.Contains(t, output, "ran in feat")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			cmd := &cobra.Command{}
+			cmd.SetOut(&out)
+
+			mock := tt.mockSetup()
+			err := handleExecAll(mock, cmd, []string{"git", "gc"}, tt.parallel)
+
+			tt.assertMocks(t, mock)
+			tt.assertErr(t, err)
+			tt.assertOutput(t, out.String())
+		})
+	}
+}
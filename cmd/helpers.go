@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
@@ -110,3 +111,25 @@ func createBranchNameGeneratorWithCreator(prefix string, creator hotfixCreator)
 		return fmt.Sprintf("%s/%s", prefix, jiraBranchName), nil
 	}
 }
+
+// printWorktreeResults prints the per-worktree outcome of a bulk push or pull
+func printWorktreeResults(results []internal.WorktreeResult) {
+	if len(results) == 0 {
+		return
+	}
+
+	table := internal.NewTable([]string{"WORKTREE", "RESULT"})
+	for _, result := range results {
+		switch {
+		case result.Err == nil:
+			table.AddRow([]string{result.Name, internal.FormatSuccess("OK")})
+		case errors.Is(result.Err, internal.ErrSkipped):
+			table.AddRow([]string{result.Name, internal.FormatWarning("SKIPPED")})
+		default:
+			table.AddRow([]string{result.Name, internal.FormatError(result.Err.Error())})
+		}
+	}
+
+	fmt.Println()
+	table.Print()
+}
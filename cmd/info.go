@@ -4,8 +4,9 @@ import (
 	"errors"
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"gbm/internal"
@@ -19,43 +20,108 @@ import (
 type worktreeInfoProvider interface {
 	// Core worktree operations
 	GetWorktrees() ([]*internal.WorktreeInfo, error)
+	GetAllWorktrees() (map[string]*internal.WorktreeListInfo, error)
+	GetSortedWorktreeNames(worktrees map[string]*internal.WorktreeListInfo) []string
 	GetWorktreeStatus(worktreePath string) (*internal.GitStatus, error)
 
 	// Configuration and state access
 	GetConfig() *internal.Config
 	GetState() *internal.State
+	GetGBMConfig() *internal.GBMConfig
 
 	// Wrapper methods for GitManager operations
 	GetWorktreeCommitHistory(worktreePath string, limit int) ([]internal.CommitInfo, error)
 	GetWorktreeFileChanges(worktreePath string) ([]internal.FileChange, error)
 	GetWorktreeCurrentBranch(worktreePath string) (string, error)
 	GetWorktreeUpstreamBranch(worktreePath string) (string, error)
-	GetWorktreeAheadBehindCount(worktreePath string) (int, int, error)
+	GetWorktreeAheadBehindAgainst(worktreePath, ref string) (int, int, error)
+	GetWorktreeMergeBase(worktreePath, baseBranch string) (string, time.Time, error)
 	VerifyWorktreeRef(ref string, worktreePath string) (bool, error)
+	GetWorktreeStashes(worktreePath string) ([]internal.StashEntry, error)
 
 	// JIRA integration
 	GetJiraTicketDetails(jiraKey string) (*internal.JiraTicketDetails, error)
+	BuildJiraURL(key string) string
 }
 
+// infoJiraConcurrency bounds how many JIRA CLI lookups `gbm info --all` runs at once
+const infoJiraConcurrency = 4
+
+// defaultInfoCommits is how many recent commits `gbm info` shows without --commits
+const defaultInfoCommits = 5
+
 func newInfoCommand() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "info <worktree-name>",
+		Use:   "info [worktree-name]",
 		Short: "Display detailed information about a worktree",
 		Long: `Display comprehensive information about a specific worktree including:
 - Worktree metadata (name, path, branch, creation date)
 - Git status and branch information
 - JIRA ticket details (if the worktree name matches a JIRA key)
-- Recent commits and modified files`,
-		Args: cobra.ExactArgs(1),
+- Recent commits and modified files
+
+Use --all to show the same information for every managed worktree. JIRA details are
+cached in .gbm/jira-cache for jira.cache_ttl (default 15m); use --no-jira to skip
+them entirely and show only git information.
+
+Use --commits <n> to show more (or fewer) recent commits; --commits 0 shows the whole history.`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runInfoCommand(args[0])
+			all, _ := cmd.Flags().GetBool("all")
+			noJira, _ := cmd.Flags().GetBool("no-jira")
+			commits, _ := cmd.Flags().GetInt("commits")
+			if all == (len(args) == 1) {
+				return fmt.Errorf("specify either a worktree name or --all")
+			}
+			if commits < 0 {
+				return fmt.Errorf("--commits must not be negative, got %d", commits)
+			}
+
+			if all {
+				return runInfoAllCommand(noJira, commits)
+			}
+			return runInfoCommand(args[0], noJira, commits)
 		},
 	}
 
+	cmd.Flags().Bool("all", false, "show info for every managed worktree")
+	cmd.Flags().Bool("no-jira", false, "skip JIRA ticket details")
+	cmd.Flags().Int("commits", defaultInfoCommits, "number of recent commits to show; 0 shows all")
+
 	return cmd
 }
 
-func runInfoCommand(worktreeName string) error {
+func runInfoAllCommand(noJira bool, commitLimit int) error {
+	manager, err := createInitializedManager()
+	if err != nil {
+		if !errors.Is(err, ErrLoadGBMConfig) {
+			return err
+		}
+
+		PrintVerbose("%v", err)
+	}
+
+	infos, err := getAllWorktreeInfo(manager, noJira, commitLimit)
+	if err != nil {
+		return fmt.Errorf("failed to get worktree info: %w", err)
+	}
+
+	if len(infos) == 0 {
+		PrintInfo("No worktrees found")
+		return nil
+	}
+
+	for i, info := range infos {
+		if i > 0 {
+			fmt.Println()
+		}
+		displayWorktreeInfo(info, manager.GetConfig())
+	}
+
+	return nil
+}
+
+func runInfoCommand(worktreeName string, noJira bool, commitLimit int) error {
 	// Handle current directory reference
 	if worktreeName == "." {
 		currentPath, err := os.Getwd()
@@ -75,7 +141,7 @@ func runInfoCommand(worktreeName string) error {
 	}
 
 	// Get worktree information
-	worktreeInfo, err := getWorktreeInfo(manager, worktreeName)
+	worktreeInfo, err := getWorktreeInfo(manager, worktreeName, noJira, commitLimit)
 	if err != nil {
 		return fmt.Errorf("failed to get worktree info: %w", err)
 	}
@@ -86,7 +152,9 @@ func runInfoCommand(worktreeName string) error {
 	return nil
 }
 
-func getWorktreeInfo(provider worktreeInfoProvider, worktreeName string) (*internal.WorktreeInfoData, error) {
+// getWorktreeInfo gathers the info shown for one worktree, with up to commitLimit recent commits
+// (0 for all). With noJira, the JIRA CLI is not consulted.
+func getWorktreeInfo(provider worktreeInfoProvider, worktreeName string, noJira bool, commitLimit int) (*internal.WorktreeInfoData, error) {
 	// Get all worktrees
 	worktrees, err := provider.GetWorktrees()
 	if err != nil {
@@ -106,6 +174,75 @@ func getWorktreeInfo(provider worktreeInfoProvider, worktreeName string) (*inter
 		return nil, fmt.Errorf("worktree '%s' not found", worktreeName)
 	}
 
+	info := collectWorktreeInfo(provider, targetWorktree, commitLimit)
+	if !noJira {
+		info.JiraTicket, _ = lookupJiraTicket(provider, worktreeName)
+	}
+
+	return info, nil
+}
+
+// getAllWorktreeInfo gathers info for every managed worktree in display order. JIRA lookups
+// shell out to the jira CLI, so they run concurrently on a bounded pool; noJira skips them.
+func getAllWorktreeInfo(provider worktreeInfoProvider, noJira bool, commitLimit int) ([]*internal.WorktreeInfoData, error) {
+	worktrees, err := provider.GetAllWorktrees()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get worktrees: %w", err)
+	}
+
+	var infos []*internal.WorktreeInfoData
+	for _, name := range provider.GetSortedWorktreeNames(worktrees) {
+		wt := worktrees[name]
+		infos = append(infos, collectWorktreeInfo(provider, &internal.WorktreeInfo{
+			Name:   name,
+			Path:   wt.Path,
+			Branch: wt.CurrentBranch,
+		}, commitLimit))
+	}
+
+	if noJira {
+		return infos, nil
+	}
+
+	jobs := make(chan *internal.WorktreeInfoData)
+	var jiraUnavailable atomic.Bool
+	var wg sync.WaitGroup
+
+	for range min(infoJiraConcurrency, len(infos)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for info := range jobs {
+				if jiraUnavailable.Load() {
+					info.JiraTicket = jiraTicketLink(provider, internal.ExtractJiraKey(info.Name))
+					continue
+				}
+
+				ticket, err := lookupJiraTicket(provider, info.Name)
+				if errors.Is(err, internal.ErrJiraCliNotFound) {
+					jiraUnavailable.Store(true)
+				}
+				info.JiraTicket = ticket
+			}
+		}()
+	}
+
+	for _, info := range infos {
+		if internal.ExtractJiraKey(info.Name) != "" {
+			jobs <- info
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	return infos, nil
+}
+
+// collectWorktreeInfo gathers everything shown by `gbm info` except JIRA details.
+// Individual lookup failures are logged and leave the corresponding field empty.
+func collectWorktreeInfo(provider worktreeInfoProvider, targetWorktree *internal.WorktreeInfo, commitLimit int) *internal.WorktreeInfoData {
+	worktreeName := targetWorktree.Name
+
 	// Get git status for the worktree
 	gitStatus, err := provider.GetWorktreeStatus(targetWorktree.Path)
 	if err != nil {
@@ -120,7 +257,7 @@ func getWorktreeInfo(provider worktreeInfoProvider, worktreeName string) (*inter
 	}
 
 	// Get recent commits
-	commits, err := provider.GetWorktreeCommitHistory(targetWorktree.Path, 5)
+	commits, err := provider.GetWorktreeCommitHistory(targetWorktree.Path, commitLimit)
 	if err != nil {
 		PrintVerbose("Failed to get recent commits for worktree %s: %v", worktreeName, err)
 	}
@@ -131,26 +268,18 @@ func getWorktreeInfo(provider worktreeInfoProvider, worktreeName string) (*inter
 		PrintVerbose("Failed to get modified files for worktree %s: %v", worktreeName, err)
 	}
 
+	// Get stashes made on the worktree's branch
+	stashes, err := provider.GetWorktreeStashes(targetWorktree.Path)
+	if err != nil {
+		PrintVerbose("Failed to get stashes for worktree %s: %v", worktreeName, err)
+	}
+
 	// Get base branch info
 	baseInfo, err := getBaseBranchInfo(targetWorktree.Path, worktreeName, provider)
 	if err != nil {
 		PrintVerbose("Failed to get base branch info for worktree %s: %v", worktreeName, err)
 	}
 
-	// Try to get JIRA ticket details if the worktree name contains a JIRA key
-	var jiraTicket *internal.JiraTicketDetails
-	jiraKey := internal.ExtractJiraKey(worktreeName)
-	if jiraKey != "" {
-		jiraTicket, err = provider.GetJiraTicketDetails(jiraKey)
-		if err != nil {
-			if errors.Is(err, internal.ErrJiraCliNotFound) {
-				PrintVerbose("JIRA CLI not available, skipping ticket details for %s", jiraKey)
-			} else {
-				PrintVerbose("Failed to get JIRA ticket details for %s: %v", jiraKey, err)
-			}
-		}
-	}
-
 	return &internal.WorktreeInfoData{
 		Name:          worktreeName,
 		Path:          targetWorktree.Path,
@@ -160,8 +289,41 @@ func getWorktreeInfo(provider worktreeInfoProvider, worktreeName string) (*inter
 		BaseInfo:      baseInfo,
 		Commits:       commits,
 		ModifiedFiles: modifiedFiles,
-		JiraTicket:    jiraTicket,
-	}, nil
+		Stashes:       stashes,
+	}
+}
+
+// lookupJiraTicket fetches ticket details when the worktree name contains a JIRA key.
+// Failures are logged and yield a ticket holding only the link built from jira.base_url, or
+// nil when that isn't configured; the error is returned so callers can tell when the JIRA CLI
+// is missing altogether.
+func lookupJiraTicket(provider worktreeInfoProvider, worktreeName string) (*internal.JiraTicketDetails, error) {
+	jiraKey := internal.ExtractJiraKey(worktreeName)
+	if jiraKey == "" {
+		return nil, nil
+	}
+
+	jiraTicket, err := provider.GetJiraTicketDetails(jiraKey)
+	if err != nil {
+		if errors.Is(err, internal.ErrJiraCliNotFound) {
+			PrintVerbose("JIRA CLI not available, skipping ticket details for %s", jiraKey)
+		} else {
+			PrintVerbose("Failed to get JIRA ticket details for %s: %v", jiraKey, err)
+		}
+		return jiraTicketLink(provider, jiraKey), err
+	}
+
+	return jiraTicket, nil
+}
+
+// jiraTicketLink returns a ticket with just the key and its jira.base_url link, or nil when
+// jira.base_url is not configured
+func jiraTicketLink(provider worktreeInfoProvider, jiraKey string) *internal.JiraTicketDetails {
+	url := provider.BuildJiraURL(jiraKey)
+	if url == "" {
+		return nil
+	}
+	return &internal.JiraTicketDetails{Key: jiraKey, URL: url}
 }
 
 func displayWorktreeInfo(data *internal.WorktreeInfoData, config *internal.Config) {
@@ -195,50 +357,93 @@ func getBaseBranchInfo(worktreePath, worktreeName string, provider worktreeInfoP
 		return nil, fmt.Errorf("failed to get upstream branch: %w", err)
 	}
 
-	// Get ahead/behind count
-	aheadBy, behindBy, err := provider.GetWorktreeAheadBehindCount(worktreePath)
-	if err != nil {
-		// Maintain backward compatibility - use 0,0 if error occurs
-		aheadBy, behindBy = 0, 0
+	baseBranch := resolveWorktreeBaseBranch(worktreeName, worktreePath, provider)
+
+	branchInfo := &internal.BranchInfo{
+		Name:     baseBranch,
+		Upstream: upstream,
 	}
 
-	// Try to determine actual base branch - first check stored information
-	baseBranch := ""
-	if storedBaseBranch, exists := provider.GetState().GetWorktreeBaseBranch(worktreeName); exists {
-		baseBranch = storedBaseBranch
+	// Count commits relative to the base branch rather than the upstream, which push/pull status covers
+	if baseBranch != "" {
+		aheadBy, behindBy, err := provider.GetWorktreeAheadBehindAgainst(worktreePath, baseBranch)
+		if err != nil {
+			PrintVerbose("Failed to compare with %s: %v", baseBranch, err)
+		} else {
+			branchInfo.AheadBy, branchInfo.BehindBy = aheadBy, behindBy
+		}
 	}
 
-	// If no stored information, fall back to git merge-base detection
-	if baseBranch == "" {
-		// Try configured candidate branches in order of preference
-		candidateBranches := provider.GetConfig().Settings.CandidateBranches
-		if len(candidateBranches) == 0 {
-			// Fallback to default if not configured
-			candidateBranches = []string{"main", "master", "develop", "dev"}
+	// Find where the worktree diverged from its base; left empty when there's no common ancestor
+	if baseBranch != "" {
+		mergeBase, divergedAt, err := provider.GetWorktreeMergeBase(worktreePath, baseBranch)
+		if err != nil {
+			PrintVerbose("Failed to get merge-base with %s: %v", baseBranch, err)
+		} else if mergeBase != "" {
+			branchInfo.DivergedAt = mergeBase[:min(7, len(mergeBase))]
+			branchInfo.DaysAgo = int(time.Since(divergedAt).Hours() / 24)
 		}
-		for _, candidate := range candidateBranches {
-			exists, err := provider.VerifyWorktreeRef(candidate, worktreePath)
-			if err != nil {
-				continue // Skip candidates that cause git errors
-			}
-			if exists {
-				// Branch exists, check if it's actually a base
-				cmd := exec.Command("git", "merge-base", "--is-ancestor", candidate, "HEAD")
-				cmd.Dir = worktreePath
-				if err := cmd.Run(); err == nil {
-					baseBranch = candidate
-					break
-				}
-			}
+	}
+
+	return branchInfo, nil
+}
+
+// baseBranchDetector is the subset of Manager operations needed to work out a worktree's base branch
+type baseBranchDetector interface {
+	GetConfig() *internal.Config
+	GetState() *internal.State
+	GetGBMConfig() *internal.GBMConfig
+	VerifyWorktreeRef(ref string, worktreePath string) (bool, error)
+	GetWorktreeMergeBase(worktreePath, baseBranch string) (string, time.Time, error)
+}
+
+// resolveWorktreeBaseBranch returns the base_branch declared for a tracked worktree in
+// gbm.branchconfig.yaml, then the base branch recorded when the worktree was created, falling back
+// to git merge-base detection. Returns "" when no base branch can be determined.
+func resolveWorktreeBaseBranch(worktreeName, worktreePath string, detector baseBranchDetector) string {
+	if gbmConfig := detector.GetGBMConfig(); gbmConfig != nil {
+		if worktreeConfig, tracked := gbmConfig.Worktrees[worktreeName]; tracked && worktreeConfig.BaseBranch != "" {
+			return worktreeConfig.BaseBranch
 		}
 	}
 
-	return &internal.BranchInfo{
-		Name:     baseBranch,
-		Upstream: upstream,
-		AheadBy:  aheadBy,
-		BehindBy: behindBy,
-	}, nil
+	if storedBaseBranch, exists := detector.GetState().GetWorktreeBaseBranch(worktreeName); exists && storedBaseBranch != "" {
+		return storedBaseBranch
+	}
+
+	return detectClosestBaseBranch(worktreePath, detector)
+}
+
+// detectClosestBaseBranch picks the configured candidate branch whose merge-base with the worktree's
+// HEAD is the most recent commit, i.e. the branch the worktree most likely forked from. Ties go to
+// the candidate listed first in settings.candidate_branches.
+func detectClosestBaseBranch(worktreePath string, provider baseBranchDetector) string {
+	candidateBranches := provider.GetConfig().Settings.CandidateBranches
+	if len(candidateBranches) == 0 {
+		// Fallback to default if not configured
+		candidateBranches = []string{"main", "master", "develop", "dev"}
+	}
+
+	var closest string
+	var closestAt time.Time
+	for _, candidate := range candidateBranches {
+		exists, err := provider.VerifyWorktreeRef(candidate, worktreePath)
+		if err != nil || !exists {
+			continue // Skip candidates that don't exist or cause git errors
+		}
+
+		mergeBase, mergeBaseAt, err := provider.GetWorktreeMergeBase(worktreePath, candidate)
+		if err != nil || mergeBase == "" {
+			PrintVerbose("Skipping base branch candidate %s: no common ancestor", candidate)
+			continue
+		}
+
+		if closest == "" || mergeBaseAt.After(closestAt) {
+			closest, closestAt = candidate, mergeBaseAt
+		}
+	}
+
+	return closest
 }
 
 // JSON structs for parsing jira --raw output
@@ -101,6 +101,9 @@ func TestGetWorktreeInfo(t *testing.T) {
 						assert.Equal(t, sampleWorktree.Path, worktreePath)
 						return sampleFileChanges, nil
 					},
+					GetWorktreeStashesFunc: func(worktreePath string) ([]internal.StashEntry, error) {
+						return []internal.StashEntry{{Index: 2, Branch: sampleWorktree.Branch, Message: "half-done refactor"}}, nil
+					},
 					GetJiraTicketDetailsFunc: func(jiraKey string) (*internal.JiraTicketDetails, error) {
 						assert.Equal(t, "INGSVC-5739", jiraKey)
 						return sampleJiraTicket, nil
@@ -112,9 +115,15 @@ func TestGetWorktreeInfo(t *testing.T) {
 					GetWorktreeUpstreamBranchFunc: func(worktreePath string) (string, error) {
 						return "origin/bug/INGSVC-5739_New_Integration_Refinitiv_LSEG_Messenger_API", nil
 					},
-					GetWorktreeAheadBehindCountFunc: func(worktreePath string) (int, int, error) {
+					GetWorktreeAheadBehindAgainstFunc: func(worktreePath, ref string) (int, int, error) {
 						return 1, 0, nil
 					},
+					GetWorktreeMergeBaseFunc: func(worktreePath, baseBranch string) (string, time.Time, error) {
+						return "", time.Time{}, nil
+					},
+					GetGBMConfigFunc: func() *internal.GBMConfig {
+						return nil
+					},
 					GetStateFunc: func() *internal.State {
 						state := &internal.State{}
 						state.WorktreeBaseBranch = map[string]string{
@@ -146,6 +155,7 @@ func TestGetWorktreeInfo(t *testing.T) {
 				assert.Equal(t, sampleGitStatus, data.GitStatus)
 				assert.Equal(t, sampleCommits, data.Commits)
 				assert.Equal(t, sampleFileChanges, data.ModifiedFiles)
+				assert.Equal(t, []internal.StashEntry{{Index: 2, Branch: sampleWorktree.Branch, Message: "half-done refactor"}}, data.Stashes)
 				assert.Equal(t, sampleJiraTicket, data.JiraTicket)
 			},
 		},
@@ -171,6 +181,9 @@ func TestGetWorktreeInfo(t *testing.T) {
 					GetWorktreeFileChangesFunc: func(worktreePath string) ([]internal.FileChange, error) {
 						return sampleFileChanges, nil
 					},
+					GetWorktreeStashesFunc: func(worktreePath string) ([]internal.StashEntry, error) {
+						return nil, nil
+					},
 					// Add missing methods for getBaseBranchInfo
 					GetWorktreeCurrentBranchFunc: func(worktreePath string) (string, error) {
 						return "feature/some-feature", nil
@@ -178,9 +191,15 @@ func TestGetWorktreeInfo(t *testing.T) {
 					GetWorktreeUpstreamBranchFunc: func(worktreePath string) (string, error) {
 						return "origin/feature/some-feature", nil
 					},
-					GetWorktreeAheadBehindCountFunc: func(worktreePath string) (int, int, error) {
+					GetWorktreeAheadBehindAgainstFunc: func(worktreePath, ref string) (int, int, error) {
 						return 0, 0, nil
 					},
+					GetWorktreeMergeBaseFunc: func(worktreePath, baseBranch string) (string, time.Time, error) {
+						return "", time.Time{}, nil
+					},
+					GetGBMConfigFunc: func() *internal.GBMConfig {
+						return nil
+					},
 					GetStateFunc: func() *internal.State {
 						return &internal.State{}
 					},
@@ -223,10 +242,16 @@ func TestGetWorktreeInfo(t *testing.T) {
 					GetWorktreeFileChangesFunc: func(worktreePath string) ([]internal.FileChange, error) {
 						return sampleFileChanges, nil
 					},
+					GetWorktreeStashesFunc: func(worktreePath string) ([]internal.StashEntry, error) {
+						return nil, nil
+					},
 					GetJiraTicketDetailsFunc: func(jiraKey string) (*internal.JiraTicketDetails, error) {
 						assert.Equal(t, "INGSVC-5739", jiraKey)
 						return nil, internal.ErrJiraCliNotFound // JIRA CLI not available
 					},
+					BuildJiraURLFunc: func(key string) string {
+						return "" // jira.base_url not configured
+					},
 					// Add missing methods for getBaseBranchInfo
 					GetWorktreeCurrentBranchFunc: func(worktreePath string) (string, error) {
 						return "bug/INGSVC-5739_New_Integration_Refinitiv_LSEG_Messenger_API", nil
@@ -234,9 +259,15 @@ func TestGetWorktreeInfo(t *testing.T) {
 					GetWorktreeUpstreamBranchFunc: func(worktreePath string) (string, error) {
 						return "origin/bug/INGSVC-5739_New_Integration_Refinitiv_LSEG_Messenger_API", nil
 					},
-					GetWorktreeAheadBehindCountFunc: func(worktreePath string) (int, int, error) {
+					GetWorktreeAheadBehindAgainstFunc: func(worktreePath, ref string) (int, int, error) {
 						return 1, 0, nil
 					},
+					GetWorktreeMergeBaseFunc: func(worktreePath, baseBranch string) (string, time.Time, error) {
+						return "", time.Time{}, nil
+					},
+					GetGBMConfigFunc: func() *internal.GBMConfig {
+						return nil
+					},
 					GetStateFunc: func() *internal.State {
 						state := &internal.State{}
 						state.WorktreeBaseBranch = map[string]string{
@@ -319,10 +350,16 @@ func TestGetWorktreeInfo(t *testing.T) {
 					GetWorktreeFileChangesFunc: func(worktreePath string) ([]internal.FileChange, error) {
 						return sampleFileChanges, nil
 					},
+					GetWorktreeStashesFunc: func(worktreePath string) ([]internal.StashEntry, error) {
+						return nil, nil
+					},
 					GetJiraTicketDetailsFunc: func(jiraKey string) (*internal.JiraTicketDetails, error) {
 						assert.Equal(t, "INGSVC-5739", jiraKey)
 						return nil, internal.ErrJiraCliNotFound // Simulate JIRA CLI not available
 					},
+					BuildJiraURLFunc: func(key string) string {
+						return "https://acme.atlassian.net/browse/" + key
+					},
 					// Add missing methods for getBaseBranchInfo
 					GetWorktreeCurrentBranchFunc: func(worktreePath string) (string, error) {
 						return "bug/INGSVC-5739_New_Integration_Refinitiv_LSEG_Messenger_API", nil
@@ -330,9 +367,15 @@ func TestGetWorktreeInfo(t *testing.T) {
 					GetWorktreeUpstreamBranchFunc: func(worktreePath string) (string, error) {
 						return "origin/bug/INGSVC-5739_New_Integration_Refinitiv_LSEG_Messenger_API", nil
 					},
-					GetWorktreeAheadBehindCountFunc: func(worktreePath string) (int, int, error) {
+					GetWorktreeAheadBehindAgainstFunc: func(worktreePath, ref string) (int, int, error) {
 						return 1, 0, nil
 					},
+					GetWorktreeMergeBaseFunc: func(worktreePath, baseBranch string) (string, time.Time, error) {
+						return "", time.Time{}, nil
+					},
+					GetGBMConfigFunc: func() *internal.GBMConfig {
+						return nil
+					},
 					GetStateFunc: func() *internal.State {
 						state := &internal.State{}
 						state.WorktreeBaseBranch = map[string]string{
@@ -361,6 +404,9 @@ func TestGetWorktreeInfo(t *testing.T) {
 				assert.Equal(t, "INGSVC-5739", data.Name)
 				assert.Nil(t, data.GitStatus)  // Should be nil due to error
 				assert.NotNil(t, data.Commits) // Other data should still be present
+				// Without the JIRA CLI the ticket still links to jira.base_url
+				assert.Equal(t, "INGSVC-5739", data.JiraTicket.Key)
+				assert.Equal(t, "https://acme.atlassian.net/browse/INGSVC-5739", data.JiraTicket.URL)
 			},
 		},
 	}
@@ -369,7 +415,7 @@ func TestGetWorktreeInfo(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			provider := tt.mockSetup()
 
-			data, err := getWorktreeInfo(provider, tt.worktreeName)
+			data, err := getWorktreeInfo(provider, tt.worktreeName, false, defaultInfoCommits)
 
 			tt.expectErr(t, err)
 			tt.expectData(t, data)
@@ -377,6 +423,120 @@ func TestGetWorktreeInfo(t *testing.T) {
 	}
 }
 
+func TestGetAllWorktreeInfo(t *testing.T) {
+	newProvider := func(jiraErr error, jiraBaseURL string) *worktreeInfoProviderMock {
+		return &worktreeInfoProviderMock{
+			GetAllWorktreesFunc: func() (map[string]*internal.WorktreeListInfo, error) {
+				return map[string]*internal.WorktreeListInfo{
+					"main":       {Path: "/repo/worktrees/main", CurrentBranch: "main"},
+					"INGSVC-101": {Path: "/repo/worktrees/INGSVC-101", CurrentBranch: "feature/INGSVC-101"},
+					"INGSVC-102": {Path: "/repo/worktrees/INGSVC-102", CurrentBranch: "feature/INGSVC-102"},
+				}, nil
+			},
+			GetSortedWorktreeNamesFunc: func(worktrees map[string]*internal.WorktreeListInfo) []string {
+				return []string{"main", "INGSVC-101", "INGSVC-102"}
+			},
+			GetWorktreeStatusFunc: func(worktreePath string) (*internal.GitStatus, error) {
+				return &internal.GitStatus{}, nil
+			},
+			GetWorktreeCommitHistoryFunc: func(worktreePath string, limit int) ([]internal.CommitInfo, error) {
+				return nil, nil
+			},
+			GetWorktreeFileChangesFunc: func(worktreePath string) ([]internal.FileChange, error) {
+				return nil, nil
+			},
+			GetWorktreeStashesFunc: func(worktreePath string) ([]internal.StashEntry, error) {
+				return nil, nil
+			},
+			GetWorktreeCurrentBranchFunc: func(worktreePath string) (string, error) {
+				return "", errors.New("not a worktree")
+			},
+			GetJiraTicketDetailsFunc: func(jiraKey string) (*internal.JiraTicketDetails, error) {
+				if jiraErr != nil {
+					return nil, jiraErr
+				}
+				return &internal.JiraTicketDetails{Key: jiraKey}, nil
+			},
+			BuildJiraURLFunc: func(key string) string {
+				if jiraBaseURL == "" {
+					return ""
+				}
+				return jiraBaseURL + "/browse/" + key
+			},
+		}
+	}
+
+	t.Run("passes the commit limit through, 0 meaning all", func(t *testing.T) {
+		provider := newProvider(nil, "")
+
+		_, err := getAllWorktreeInfo(provider, true, 0)
+		assert.NoError(t, err)
+		for _, call := range provider.GetWorktreeCommitHistoryCalls() {
+			assert.Equal(t, 0, call.Limit)
+		}
+		assert.Len(t, provider.GetWorktreeCommitHistoryCalls(), 3)
+	})
+
+	t.Run("gathers info in display order with JIRA details", func(t *testing.T) {
+		provider := newProvider(nil, "")
+
+		infos, err := getAllWorktreeInfo(provider, false, defaultInfoCommits)
+		assert.NoError(t, err)
+		assert.Len(t, infos, 3)
+		assert.Equal(t, "main", infos[0].Name)
+		assert.Nil(t, infos[0].JiraTicket)
+		assert.Equal(t, "feature/INGSVC-101", infos[1].Branch)
+		assert.Equal(t, "INGSVC-101", infos[1].JiraTicket.Key)
+		assert.Equal(t, "INGSVC-102", infos[2].JiraTicket.Key)
+		assert.Len(t, provider.GetJiraTicketDetailsCalls(), 2, "only worktrees named after JIRA keys are looked up")
+	})
+
+	t.Run("missing JIRA CLI leaves tickets empty", func(t *testing.T) {
+		provider := newProvider(internal.ErrJiraCliNotFound, "")
+
+		infos, err := getAllWorktreeInfo(provider, false, defaultInfoCommits)
+		assert.NoError(t, err)
+		assert.Len(t, infos, 3)
+		for _, info := range infos {
+			assert.Nil(t, info.JiraTicket)
+			assert.NotNil(t, info.GitStatus)
+		}
+	})
+
+	t.Run("missing JIRA CLI still links tickets to jira.base_url", func(t *testing.T) {
+		provider := newProvider(internal.ErrJiraCliNotFound, "https://acme.atlassian.net")
+
+		infos, err := getAllWorktreeInfo(provider, false, defaultInfoCommits)
+		assert.NoError(t, err)
+		assert.Nil(t, infos[0].JiraTicket)
+		assert.Equal(t, "https://acme.atlassian.net/browse/INGSVC-101", infos[1].JiraTicket.URL)
+		assert.Equal(t, "https://acme.atlassian.net/browse/INGSVC-102", infos[2].JiraTicket.URL)
+	})
+
+	t.Run("no-jira skips JIRA lookups", func(t *testing.T) {
+		provider := newProvider(nil, "https://acme.atlassian.net")
+
+		infos, err := getAllWorktreeInfo(provider, true, defaultInfoCommits)
+		assert.NoError(t, err)
+		assert.Len(t, infos, 3)
+		for _, info := range infos {
+			assert.Nil(t, info.JiraTicket)
+		}
+		assert.Empty(t, provider.GetJiraTicketDetailsCalls())
+	})
+
+	t.Run("error getting worktrees", func(t *testing.T) {
+		provider := &worktreeInfoProviderMock{
+			GetAllWorktreesFunc: func() (map[string]*internal.WorktreeListInfo, error) {
+				return nil, errors.New("git failed")
+			},
+		}
+
+		_, err := getAllWorktreeInfo(provider, false, defaultInfoCommits)
+		assert.ErrorContains(t, err, "failed to get worktrees")
+	})
+}
+
 func TestGetBaseBranchInfo(t *testing.T) {
 	sampleConfig := &internal.Config{
 		Settings: internal.ConfigSettings{
@@ -410,9 +570,16 @@ func TestGetBaseBranchInfo(t *testing.T) {
 					GetWorktreeUpstreamBranchFunc: func(worktreePath string) (string, error) {
 						return "origin/bug/INGSVC-5739_New_Integration_Refinitiv_LSEG_Messenger_API", nil
 					},
-					GetWorktreeAheadBehindCountFunc: func(worktreePath string) (int, int, error) {
+					GetWorktreeAheadBehindAgainstFunc: func(worktreePath, ref string) (int, int, error) {
 						return 1, 0, nil
 					},
+					GetWorktreeMergeBaseFunc: func(worktreePath, baseBranch string) (string, time.Time, error) {
+						assert.Equal(t, "master", baseBranch)
+						return "3f2a9c1d8e7b6a5f4e3d2c1b0a9f8e7d6c5b4a39", time.Now().Add(-12*24*time.Hour - time.Hour), nil
+					},
+					GetGBMConfigFunc: func() *internal.GBMConfig {
+						return nil
+					},
 					GetStateFunc: func() *internal.State {
 						return sampleState
 					},
@@ -429,6 +596,88 @@ func TestGetBaseBranchInfo(t *testing.T) {
 				assert.Equal(t, "origin/bug/INGSVC-5739_New_Integration_Refinitiv_LSEG_Messenger_API", data.Upstream)
 				assert.Equal(t, 1, data.AheadBy)
 				assert.Equal(t, 0, data.BehindBy)
+				assert.Equal(t, "master", data.Name)
+				assert.Equal(t, "3f2a9c1", data.DivergedAt)
+				assert.Equal(t, 12, data.DaysAgo)
+			},
+		},
+		{
+			name:         "success - base_branch of a tracked worktree wins over stored and detected bases",
+			worktreePath: "/Users/test/worktrees/INGSVC-5739",
+			worktreeName: "INGSVC-5739",
+			mockSetup: func() *worktreeInfoProviderMock {
+				return &worktreeInfoProviderMock{
+					GetWorktreeCurrentBranchFunc: func(worktreePath string) (string, error) {
+						return "release/2.0", nil
+					},
+					GetWorktreeUpstreamBranchFunc: func(worktreePath string) (string, error) {
+						return "origin/release/2.0", nil
+					},
+					GetWorktreeAheadBehindAgainstFunc: func(worktreePath, ref string) (int, int, error) {
+						return 0, 0, nil
+					},
+					GetWorktreeMergeBaseFunc: func(worktreePath, baseBranch string) (string, time.Time, error) {
+						assert.Equal(t, "develop", baseBranch)
+						return "abc1234def", time.Now(), nil
+					},
+					GetGBMConfigFunc: func() *internal.GBMConfig {
+						return &internal.GBMConfig{Worktrees: map[string]internal.WorktreeConfig{
+							"INGSVC-5739": {Branch: "release/2.0", BaseBranch: "develop"},
+						}}
+					},
+					GetStateFunc: func() *internal.State {
+						return sampleState
+					},
+					GetConfigFunc: func() *internal.Config {
+						return sampleConfig
+					},
+				}
+			},
+			expectErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+			expectData: func(t *testing.T, data *internal.BranchInfo) {
+				assert.Equal(t, "develop", data.Name)
+				assert.Equal(t, "abc1234", data.DivergedAt)
+			},
+		},
+		{
+			name:         "success - no common ancestor leaves divergence empty",
+			worktreePath: "/Users/test/worktrees/INGSVC-5739",
+			worktreeName: "INGSVC-5739",
+			mockSetup: func() *worktreeInfoProviderMock {
+				return &worktreeInfoProviderMock{
+					GetWorktreeCurrentBranchFunc: func(worktreePath string) (string, error) {
+						return "orphan-branch", nil
+					},
+					GetWorktreeUpstreamBranchFunc: func(worktreePath string) (string, error) {
+						return "", nil
+					},
+					GetWorktreeAheadBehindAgainstFunc: func(worktreePath, ref string) (int, int, error) {
+						return 0, 0, nil
+					},
+					GetWorktreeMergeBaseFunc: func(worktreePath, baseBranch string) (string, time.Time, error) {
+						return "", time.Time{}, nil
+					},
+					GetGBMConfigFunc: func() *internal.GBMConfig {
+						return nil
+					},
+					GetStateFunc: func() *internal.State {
+						return sampleState
+					},
+					GetConfigFunc: func() *internal.Config {
+						return sampleConfig
+					},
+				}
+			},
+			expectErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+			expectData: func(t *testing.T, data *internal.BranchInfo) {
+				assert.NotNil(t, data)
+				assert.Equal(t, "master", data.Name)
+				assert.Empty(t, data.DivergedAt)
+				assert.Equal(t, 0, data.DaysAgo)
 			},
 		},
 		{
@@ -443,9 +692,19 @@ func TestGetBaseBranchInfo(t *testing.T) {
 					GetWorktreeUpstreamBranchFunc: func(worktreePath string) (string, error) {
 						return "origin/feature/some-feature", nil
 					},
-					GetWorktreeAheadBehindCountFunc: func(worktreePath string) (int, int, error) {
+					GetWorktreeAheadBehindAgainstFunc: func(worktreePath, ref string) (int, int, error) {
+						assert.Equal(t, "main", ref, "ahead/behind is relative to the base branch")
 						return 2, 1, nil
 					},
+					GetWorktreeMergeBaseFunc: func(worktreePath, baseBranch string) (string, time.Time, error) {
+						if baseBranch != "main" {
+							return "", time.Time{}, nil
+						}
+						return "abc1234def", time.Now(), nil
+					},
+					GetGBMConfigFunc: func() *internal.GBMConfig {
+						return nil
+					},
 					GetStateFunc: func() *internal.State {
 						return &internal.State{} // No stored base branches
 					},
@@ -467,10 +726,96 @@ func TestGetBaseBranchInfo(t *testing.T) {
 			expectData: func(t *testing.T, data *internal.BranchInfo) {
 				assert.NotNil(t, data)
 				assert.Equal(t, "origin/feature/some-feature", data.Upstream)
+				assert.Equal(t, "main", data.Name)
 				assert.Equal(t, 2, data.AheadBy)
 				assert.Equal(t, 1, data.BehindBy)
 			},
 		},
+		{
+			name:         "success - picks the candidate with the most recent merge-base",
+			worktreePath: "/Users/test/worktrees/feature-branch",
+			worktreeName: "feature-branch",
+			mockSetup: func() *worktreeInfoProviderMock {
+				mergeBases := map[string]time.Time{
+					"main":    time.Now().Add(-30 * 24 * time.Hour),
+					"develop": time.Now().Add(-2 * 24 * time.Hour),
+				}
+				return &worktreeInfoProviderMock{
+					GetWorktreeCurrentBranchFunc: func(worktreePath string) (string, error) {
+						return "feature/some-feature", nil
+					},
+					GetWorktreeUpstreamBranchFunc: func(worktreePath string) (string, error) {
+						return "", nil
+					},
+					GetWorktreeAheadBehindAgainstFunc: func(worktreePath, ref string) (int, int, error) {
+						return 0, 0, nil
+					},
+					GetWorktreeMergeBaseFunc: func(worktreePath, baseBranch string) (string, time.Time, error) {
+						at, exists := mergeBases[baseBranch]
+						if !exists {
+							return "", time.Time{}, nil
+						}
+						return "abcdef1234567890" + baseBranch, at, nil
+					},
+					GetGBMConfigFunc: func() *internal.GBMConfig {
+						return nil
+					},
+					GetStateFunc: func() *internal.State {
+						return &internal.State{}
+					},
+					GetConfigFunc: func() *internal.Config {
+						return sampleConfig
+					},
+					VerifyWorktreeRefFunc: func(ref string, worktreePath string) (bool, error) {
+						return ref == "main" || ref == "develop", nil
+					},
+				}
+			},
+			expectErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+			expectData: func(t *testing.T, data *internal.BranchInfo) {
+				assert.NotNil(t, data)
+				assert.Equal(t, "develop", data.Name, "develop forked more recently than main")
+				assert.Equal(t, 2, data.DaysAgo)
+			},
+		},
+		{
+			name:         "success - no base branch leaves ahead/behind empty",
+			worktreePath: "/Users/test/worktrees/feature-branch",
+			worktreeName: "feature-branch",
+			mockSetup: func() *worktreeInfoProviderMock {
+				return &worktreeInfoProviderMock{
+					GetWorktreeCurrentBranchFunc: func(worktreePath string) (string, error) {
+						return "feature/some-feature", nil
+					},
+					GetWorktreeUpstreamBranchFunc: func(worktreePath string) (string, error) {
+						return "origin/feature/some-feature", nil
+					},
+					GetGBMConfigFunc: func() *internal.GBMConfig {
+						return nil
+					},
+					GetStateFunc: func() *internal.State {
+						return &internal.State{}
+					},
+					GetConfigFunc: func() *internal.Config {
+						return sampleConfig
+					},
+					VerifyWorktreeRefFunc: func(ref string, worktreePath string) (bool, error) {
+						return false, nil
+					},
+				}
+			},
+			expectErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+			expectData: func(t *testing.T, data *internal.BranchInfo) {
+				assert.NotNil(t, data)
+				assert.Empty(t, data.Name)
+				assert.Equal(t, 0, data.AheadBy)
+				assert.Equal(t, 0, data.BehindBy)
+			},
+		},
 		{
 			name:         "error - get current branch fails",
 			worktreePath: "/Users/test/worktrees/INGSVC-5739",
@@ -524,9 +869,15 @@ func TestGetBaseBranchInfo(t *testing.T) {
 					GetWorktreeUpstreamBranchFunc: func(worktreePath string) (string, error) {
 						return "origin/bug/INGSVC-5739_New_Integration_Refinitiv_LSEG_Messenger_API", nil
 					},
-					GetWorktreeAheadBehindCountFunc: func(worktreePath string) (int, int, error) {
+					GetWorktreeAheadBehindAgainstFunc: func(worktreePath, ref string) (int, int, error) {
 						return 0, 0, errors.New("ahead/behind count failed")
 					},
+					GetWorktreeMergeBaseFunc: func(worktreePath, baseBranch string) (string, time.Time, error) {
+						return "", time.Time{}, nil
+					},
+					GetGBMConfigFunc: func() *internal.GBMConfig {
+						return nil
+					},
 					GetStateFunc: func() *internal.State {
 						return sampleState
 					},
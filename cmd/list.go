@@ -1,9 +1,16 @@
 package cmd
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 	"slices"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+	"time"
 
 	"gbm/internal"
 
@@ -18,6 +25,136 @@ type worktreeLister interface {
 	GetAllWorktrees() (map[string]*internal.WorktreeListInfo, error)
 	GetSortedWorktreeNames(worktrees map[string]*internal.WorktreeListInfo) []string
 	GetWorktreeMapping() (map[string]string, error)
+	IsMainWorktree(name string) bool
+	GetWorktreeCommitHistory(worktreePath string, limit int) ([]internal.CommitInfo, error)
+}
+
+//go:generate go run github.com/matryer/moq@latest -out ./autogen_remoteBranchLister.go . remoteBranchLister
+
+// remoteBranchLister interface abstracts the Manager operations needed for listing remote branches
+type remoteBranchLister interface {
+	GetRemoteBranches() ([]string, error)
+	GetWorktrees() ([]*internal.WorktreeInfo, error)
+	GetDefaultBranch() (string, error)
+	GetDefaultRemote() string
+	GetAheadBehindRefs(ref, baseRef string) (int, int, error)
+}
+
+// remoteBranchJSON is a single entry of `gbm list --remote --json` output
+type remoteBranchJSON struct {
+	Branch string `json:"branch"`
+	Ref    string `json:"ref"`
+	Ahead  int    `json:"ahead"`
+	Behind int    `json:"behind"`
+}
+
+// worktreeStatusFilter narrows list output by each worktree's uncommitted changes
+type worktreeStatusFilter struct {
+	dirty          bool
+	clean          bool
+	includeUnknown bool
+}
+
+// statusFilterFromFlags reads --dirty, --clean and --include-unknown; commands without those flags get no filtering
+func statusFilterFromFlags(cmd *cobra.Command) worktreeStatusFilter {
+	dirty, _ := cmd.Flags().GetBool("dirty")
+	clean, _ := cmd.Flags().GetBool("clean")
+	includeUnknown, _ := cmd.Flags().GetBool("include-unknown")
+	return worktreeStatusFilter{dirty: dirty, clean: clean, includeUnknown: includeUnknown}
+}
+
+// apply returns the worktrees matching the filter. Worktrees whose git status could not be read
+// are unknown: they are only kept by --dirty together with --include-unknown.
+func (f worktreeStatusFilter) apply(worktrees map[string]*internal.WorktreeListInfo) map[string]*internal.WorktreeListInfo {
+	if !f.dirty && !f.clean {
+		return worktrees
+	}
+
+	filtered := make(map[string]*internal.WorktreeListInfo)
+	for name, info := range worktrees {
+		var keep bool
+		switch {
+		case info.GitStatus == nil:
+			keep = f.dirty && f.includeUnknown
+		case f.dirty:
+			keep = info.GitStatus.HasChanges()
+		default:
+			keep = !info.GitStatus.HasChanges()
+		}
+
+		if keep {
+			filtered[name] = info
+		}
+	}
+
+	return filtered
+}
+
+// withoutMainWorktree drops the main worktree when --exclude-main is given
+func withoutMainWorktree(lister worktreeLister, cmd *cobra.Command, worktrees map[string]*internal.WorktreeListInfo) map[string]*internal.WorktreeListInfo {
+	if excludeMain, _ := cmd.Flags().GetBool("exclude-main"); !excludeMain {
+		return worktrees
+	}
+
+	filtered := make(map[string]*internal.WorktreeListInfo, len(worktrees))
+	for name, info := range worktrees {
+		if !lister.IsMainWorktree(name) {
+			filtered[name] = info
+		}
+	}
+
+	return filtered
+}
+
+// parseStaleDuration parses the --stale value: a number of days or weeks ("30d", "2w") or a Go
+// duration ("36h")
+func parseStaleDuration(value string) (time.Duration, error) {
+	units := map[string]time.Duration{"d": 24 * time.Hour, "w": 7 * 24 * time.Hour}
+	for suffix, unit := range units {
+		if count, ok := strings.CutSuffix(value, suffix); ok {
+			n, err := strconv.Atoi(count)
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("invalid --stale duration %q (e.g. 30d, 2w, 36h)", value)
+			}
+			return time.Duration(n) * unit, nil
+		}
+	}
+
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid --stale duration %q (e.g. 30d, 2w, 36h)", value)
+	}
+	return d, nil
+}
+
+// withoutRecentWorktrees keeps only the worktrees with no commit newer than --stale, returning them
+// with their last commit times. Worktrees whose last commit could not be read are left out. Without
+// --stale, worktrees are returned unchanged and the times are nil.
+func withoutRecentWorktrees(lister worktreeLister, cmd *cobra.Command, worktrees map[string]*internal.WorktreeListInfo) (map[string]*internal.WorktreeListInfo, map[string]time.Time) {
+	stale, _ := cmd.Flags().GetString("stale")
+	if stale == "" {
+		return worktrees, nil
+	}
+	// The value was validated before the manager was created
+	staleFor, _ := parseStaleDuration(stale)
+	cutoff := time.Now().Add(-staleFor)
+
+	filtered := make(map[string]*internal.WorktreeListInfo)
+	lastCommits := make(map[string]time.Time)
+	for name, info := range worktrees {
+		commits, err := lister.GetWorktreeCommitHistory(info.Path, 1)
+		if err != nil || len(commits) == 0 {
+			PrintVerbose("Could not read the last commit of worktree '%s': %v", name, err)
+			continue
+		}
+
+		if commits[0].Timestamp.Before(cutoff) {
+			filtered[name] = info
+			lastCommits[name] = commits[0].Timestamp
+		}
+	}
+
+	return filtered, lastCommits
 }
 
 func handleList(lister worktreeLister, cmd *cobra.Command) error {
@@ -34,6 +171,8 @@ func handleList(lister worktreeLister, cmd *cobra.Command) error {
 		return fmt.Errorf("failed to get worktree list: %w", err)
 	}
 
+	worktrees = withoutMainWorktree(lister, cmd, statusFilterFromFlags(cmd).apply(worktrees))
+	worktrees, lastCommits := withoutRecentWorktrees(lister, cmd, worktrees)
 	PrintVerbose("Found %d worktrees to display", len(worktrees))
 
 	if len(worktrees) == 0 {
@@ -41,10 +180,15 @@ func handleList(lister worktreeLister, cmd *cobra.Command) error {
 	}
 
 	PrintVerbose("Building worktree list table")
-	table := internal.NewTable([]string{"WORKTREE", "BRANCH", "GIT STATUS", "SYNC STATUS", "PATH"})
+	headers := []string{"WORKTREE", "BRANCH", "GIT STATUS", "SYNC STATUS", "PATH"}
+	if lastCommits != nil {
+		headers = slices.Insert(headers, 4, "LAST COMMIT")
+	}
+	table := internal.NewTable(headers)
 
 	// Get sorted worktree names (tracked first, then ad hoc by creation time desc)
 	sortedNames := lister.GetSortedWorktreeNames(worktrees)
+	conflicts := make(map[string][]string)
 
 	for _, worktreeName := range sortedNames {
 		info := worktrees[worktreeName]
@@ -82,12 +226,26 @@ func handleList(lister worktreeLister, cmd *cobra.Command) error {
 			branchDisplay = fmt.Sprintf("%s (expected: %s)", info.CurrentBranch, info.ExpectedBranch)
 		}
 
-		table.AddRow([]string{worktreeName, branchDisplay, gitStatusIcon, syncStatus, info.Path})
+		if info.Conflicted {
+			branchDisplay = internal.FormatError(branchDisplay)
+			conflicts[info.CurrentBranch] = append(conflicts[info.CurrentBranch], worktreeName)
+		}
+
+		row := []string{worktreeName, branchDisplay, gitStatusIcon, syncStatus, info.Path}
+		if lastCommits != nil {
+			row = slices.Insert(row, 4, internal.FormatRelativeTime(lastCommits[worktreeName]))
+		}
+		table.AddRow(row)
 	}
 
 	_, _ = fmt.Fprint(cmd.OutOrStdout(), table.String())
 	_, _ = fmt.Fprintln(cmd.OutOrStdout())
 
+	for _, branch := range slices.Sorted(maps.Keys(conflicts)) {
+		_, _ = fmt.Fprintln(cmd.OutOrStdout(), internal.FormatWarning(fmt.Sprintf("Branch '%s' is checked out in more than one worktree: %s",
+			branch, strings.Join(conflicts[branch], ", "))))
+	}
+
 	// Only show sync hint if there are actual sync issues that gbm sync can resolve
 	hasExistingSyncIssues := len(status.BranchChanges) > 0
 	if hasExistingSyncIssues {
@@ -98,6 +256,150 @@ func handleList(lister worktreeLister, cmd *cobra.Command) error {
 	return nil
 }
 
+// worktreeJSON is a single entry of `gbm list --json` output
+type worktreeJSON struct {
+	Name string `json:"name"`
+	*internal.WorktreeListInfo
+}
+
+// handleListJSON writes all worktrees as a JSON array in the same order as the table output
+func handleListJSON(lister worktreeLister, cmd *cobra.Command) error {
+	worktrees, err := lister.GetAllWorktrees()
+	if err != nil {
+		return fmt.Errorf("failed to get worktree list: %w", err)
+	}
+
+	worktrees = withoutMainWorktree(lister, cmd, statusFilterFromFlags(cmd).apply(worktrees))
+	worktrees, _ = withoutRecentWorktrees(lister, cmd, worktrees)
+	entries := make([]worktreeJSON, 0, len(worktrees))
+	if len(worktrees) > 0 {
+		for _, worktreeName := range lister.GetSortedWorktreeNames(worktrees) {
+			entries = append(entries, worktreeJSON{Name: worktreeName, WorktreeListInfo: worktrees[worktreeName]})
+		}
+	}
+
+	encoder := json.NewEncoder(cmd.OutOrStdout())
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(entries); err != nil {
+		return fmt.Errorf("failed to encode worktree list: %w", err)
+	}
+
+	return nil
+}
+
+// listFormatPresets are the named templates accepted by `gbm list --format`
+var listFormatPresets = map[string]string{
+	"paths": "{{.Path}}",
+	"wide": "{{.Name}}\t{{.CurrentBranch}}\t{{.ExpectedBranch}}\t" +
+		"{{with .GitStatus}}+{{.Ahead}} -{{.Behind}} ~{{.Modified}} ?{{.Untracked}}{{else}}unknown{{end}}\t{{.Path}}",
+}
+
+// handleListFormat executes a Go template, or a named preset, once per worktree in table order.
+// Tab-separated columns are aligned.
+func handleListFormat(lister worktreeLister, cmd *cobra.Command, format string) error {
+	if preset, ok := listFormatPresets[format]; ok {
+		format = preset
+	}
+	// Shells pass \t and \n through literally, so accept them as escapes
+	format = strings.NewReplacer(`\t`, "\t", `\n`, "\n").Replace(format)
+
+	tmpl, err := template.New("list").Parse(format)
+	if err != nil {
+		return fmt.Errorf("invalid --format template: %w", err)
+	}
+
+	worktrees, err := lister.GetAllWorktrees()
+	if err != nil {
+		return fmt.Errorf("failed to get worktree list: %w", err)
+	}
+
+	worktrees = withoutMainWorktree(lister, cmd, statusFilterFromFlags(cmd).apply(worktrees))
+	worktrees, _ = withoutRecentWorktrees(lister, cmd, worktrees)
+	if len(worktrees) == 0 {
+		return nil
+	}
+
+	writer := tabwriter.NewWriter(cmd.OutOrStdout(), 0, 0, 2, ' ', 0)
+	for _, worktreeName := range lister.GetSortedWorktreeNames(worktrees) {
+		entry := worktreeJSON{Name: worktreeName, WorktreeListInfo: worktrees[worktreeName]}
+		if err := tmpl.Execute(writer, entry); err != nil {
+			return fmt.Errorf("failed to format worktree '%s': %w", worktreeName, err)
+		}
+		_, _ = fmt.Fprintln(writer)
+	}
+
+	return writer.Flush()
+}
+
+// handleListRemote shows remote branches that no worktree has checked out, with their
+// ahead/behind counts against the default branch
+func handleListRemote(lister remoteBranchLister, cmd *cobra.Command, jsonOutput bool) error {
+	branches, err := lister.GetRemoteBranches()
+	if err != nil {
+		return err
+	}
+
+	worktrees, err := lister.GetWorktrees()
+	if err != nil {
+		return fmt.Errorf("failed to get worktrees: %w", err)
+	}
+
+	checkedOut := make(map[string]bool, len(worktrees))
+	for _, wt := range worktrees {
+		checkedOut[wt.Branch] = true
+	}
+
+	defaultBranch, err := lister.GetDefaultBranch()
+	if err != nil {
+		return fmt.Errorf("failed to determine default branch: %w", err)
+	}
+
+	remote := lister.GetDefaultRemote()
+	baseRef := internal.RemoteFor(remote, defaultBranch)
+
+	entries := []remoteBranchJSON{}
+	for _, branch := range branches {
+		if checkedOut[branch] || branch == defaultBranch {
+			continue
+		}
+
+		ref := internal.RemoteFor(remote, branch)
+		ahead, behind, err := lister.GetAheadBehindRefs(ref, baseRef)
+		if err != nil {
+			PrintVerbose("Could not compare %s with %s: %v", ref, baseRef, err)
+		}
+		entries = append(entries, remoteBranchJSON{Branch: branch, Ref: ref, Ahead: ahead, Behind: behind})
+	}
+	slices.SortFunc(entries, func(a, b remoteBranchJSON) int { return strings.Compare(a.Branch, b.Branch) })
+	PrintVerbose("Found %d remote branches without a worktree", len(entries))
+
+	if jsonOutput {
+		encoder := json.NewEncoder(cmd.OutOrStdout())
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(entries); err != nil {
+			return fmt.Errorf("failed to encode remote branch list: %w", err)
+		}
+		return nil
+	}
+
+	if len(entries) == 0 {
+		PrintInfo("No remote branches without a worktree")
+		return nil
+	}
+
+	table := internal.NewTable([]string{"BRANCH", "AHEAD", "BEHIND", "REMOTE REF"})
+	for _, entry := range entries {
+		table.AddRow([]string{entry.Branch, strconv.Itoa(entry.Ahead), strconv.Itoa(entry.Behind), entry.Ref})
+	}
+
+	_, _ = fmt.Fprint(cmd.OutOrStdout(), table.String())
+	_, _ = fmt.Fprintln(cmd.OutOrStdout())
+	_, _ = fmt.Fprintln(cmd.OutOrStdout())
+	_, _ = fmt.Fprintln(cmd.OutOrStdout(), internal.FormatInfo(fmt.Sprintf("Ahead/behind is relative to %s. Run 'gbm add <name> --track <remote ref>' to check one out", baseRef)))
+
+	return nil
+}
+
 func newListCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list",
@@ -105,8 +407,46 @@ func newListCommand() *cobra.Command {
 		Long: `List all managed worktrees and their status.
 
 Shows environment variable mappings and indicates sync status for each entry.
-Displays which branches are out of sync, lists missing worktrees, and shows orphaned worktrees.`,
+Displays which branches are out of sync, lists missing worktrees, and shows orphaned worktrees.
+
+Use --json to print the worktrees and their git status as a JSON array for scripts and editor integrations.
+
+Use --dirty to show only worktrees with uncommitted changes, or --clean for the rest. Worktrees
+whose git status could not be read are left out unless --include-unknown is given with --dirty.
+
+Branches checked out in more than one worktree, which git normally forbids but manual git
+operations can cause, are highlighted and listed below the table.
+
+Use --stale <duration> to find abandoned worktrees: only worktrees with no commit newer than the
+duration (30d, 2w, 36h) are listed, with the age of their last commit. Combine it with --dirty to see
+stale worktrees that still hold uncommitted work, or --clean for those that are likely safe to remove.
+
+Use --exclude-main to leave out the main worktree: a root of the merge_into tree, or the worktree
+on the default branch.
+
+Use --remote to list branches on the remote that are not checked out in any worktree, with how far
+each is ahead of and behind the default branch. Check one out with 'gbm add <name> --track <remote ref>'.
+
+Use --format to print each worktree with a Go template instead of the table. Fields are .Name,
+.Path, .CurrentBranch, .ExpectedBranch and .GitStatus (.Ahead, .Behind, .Modified, .Untracked,
+.Staged, .IsDirty), which is nil when the status could not be read. Columns separated by \t are
+aligned. The presets "wide" and "paths" cover common layouts:
+
+  gbm list --format paths
+  gbm list --format '{{.Name}}\t{{.CurrentBranch}}{{with .GitStatus}}\t+{{.Ahead}}{{end}}'`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			jsonOutput, _ := cmd.Flags().GetBool("json")
+			remote, _ := cmd.Flags().GetBool("remote")
+			filter := statusFilterFromFlags(cmd)
+			if filter.includeUnknown && !filter.dirty {
+				return fmt.Errorf("--include-unknown can only be used with --dirty")
+			}
+			if stale, _ := cmd.Flags().GetString("stale"); stale != "" {
+				if _, err := parseStaleDuration(stale); err != nil {
+					return err
+				}
+			}
+
 			manager, err := createInitializedManager()
 			if err != nil {
 				if !errors.Is(err, internal.ErrNoRootNodesFound) {
@@ -114,10 +454,40 @@ Displays which branches are out of sync, lists missing worktrees, and shows orph
 				}
 			}
 
+			if remote {
+				return handleListRemote(manager, cmd, jsonOutput)
+			}
+
+			checkWorktreePrefixChange(cmd, manager, false)
+
+			if jsonOutput {
+				return handleListJSON(manager, cmd)
+			}
+
+			if format, _ := cmd.Flags().GetString("format"); format != "" {
+				return handleListFormat(manager, cmd, format)
+			}
+
 			return handleList(manager, cmd)
 		},
 	}
 
+	cmd.Flags().Bool("json", false, "output worktrees as JSON")
+	cmd.Flags().Bool("dirty", false, "only list worktrees with uncommitted changes")
+	cmd.Flags().Bool("clean", false, "only list worktrees without uncommitted changes")
+	cmd.Flags().Bool("include-unknown", false, "with --dirty, also list worktrees whose git status could not be read")
+	cmd.Flags().Bool("remote", false, "list remote branches that are not checked out in a worktree")
+	cmd.Flags().Bool("exclude-main", false, "leave out the main worktree")
+	cmd.Flags().String("format", "", "print each worktree with a Go template or a preset (wide, paths)")
+	cmd.Flags().String("stale", "", "only list worktrees with no commit newer than this duration (e.g. 30d, 2w)")
+	cmd.MarkFlagsMutuallyExclusive("dirty", "clean")
+	cmd.MarkFlagsMutuallyExclusive("format", "json")
+	cmd.MarkFlagsMutuallyExclusive("format", "remote")
+	cmd.MarkFlagsMutuallyExclusive("exclude-main", "remote")
+	cmd.MarkFlagsMutuallyExclusive("remote", "dirty")
+	cmd.MarkFlagsMutuallyExclusive("remote", "clean")
+	cmd.MarkFlagsMutuallyExclusive("remote", "stale")
+
 	return cmd
 }
 
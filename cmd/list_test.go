@@ -2,10 +2,14 @@ package cmd
 
 import (
 	"bytes"
+	"encoding/json"
 	"fmt"
+	"maps"
 	"regexp"
+	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	"gbm/internal"
 
@@ -405,6 +409,37 @@ func TestHandleList_GitStatusDisplay(t *testing.T) {
 	assert.NotEmpty(t, mainWorktree.GitStatus, "Git status should not be empty")
 }
 
+func TestHandleList_BranchConflicts(t *testing.T) {
+	worktrees := map[string]*internal.WorktreeListInfo{
+		"first":  {Path: "/path/to/worktrees/first", ExpectedBranch: "feature/x", CurrentBranch: "feature/x", Conflicted: true},
+		"second": {Path: "/path/to/worktrees/second", ExpectedBranch: "feature/x", CurrentBranch: "feature/x", Conflicted: true},
+		"other":  {Path: "/path/to/worktrees/other", ExpectedBranch: "feature/y", CurrentBranch: "feature/y"},
+	}
+
+	mock := &worktreeListerMock{
+		GetSyncStatusFunc: func() (*internal.SyncStatus, error) {
+			return &internal.SyncStatus{InSync: true, BranchChanges: map[string]internal.BranchChange{}}, nil
+		},
+		GetAllWorktreesFunc: func() (map[string]*internal.WorktreeListInfo, error) {
+			return worktrees, nil
+		},
+		GetSortedWorktreeNamesFunc: func(wt map[string]*internal.WorktreeListInfo) []string {
+			return []string{"first", "other", "second"}
+		},
+		GetWorktreeMappingFunc: func() (map[string]string, error) {
+			return map[string]string{}, nil
+		},
+	}
+
+	cmd := &cobra.Command{}
+	var output bytes.Buffer
+	cmd.SetOut(&output)
+
+	require.NoError(t, handleList(mock, cmd))
+	assert.Contains(t, output.String(), "Branch 'feature/x' is checked out in more than one worktree: first, second")
+	assert.NotContains(t, output.String(), "feature/y' is checked out")
+}
+
 func TestHandleList_ErrorHandling(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -459,3 +494,422 @@ func TestHandleList_ErrorHandling(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleListJSON(t *testing.T) {
+	worktrees := map[string]*internal.WorktreeListInfo{
+		"main": {
+			Path:           "/path/to/worktrees/main",
+			ExpectedBranch: "main",
+			CurrentBranch:  "main",
+			GitStatus:      &internal.GitStatus{IsDirty: true, Modified: 2, Ahead: 1},
+		},
+		"dev": {
+			Path:           "/path/to/worktrees/dev",
+			ExpectedBranch: "develop",
+			CurrentBranch:  "feature/auth",
+			GitStatus:      nil,
+		},
+	}
+
+	mock := &worktreeListerMock{
+		GetAllWorktreesFunc: func() (map[string]*internal.WorktreeListInfo, error) {
+			return worktrees, nil
+		},
+		GetSortedWorktreeNamesFunc: func(wt map[string]*internal.WorktreeListInfo) []string {
+			return []string{"main", "dev"}
+		},
+	}
+
+	cmd := &cobra.Command{}
+	var output bytes.Buffer
+	cmd.SetOut(&output)
+
+	err := handleListJSON(mock, cmd)
+	require.NoError(t, err)
+
+	var entries []map[string]any
+	require.NoError(t, json.Unmarshal(output.Bytes(), &entries))
+	require.Len(t, entries, 2)
+
+	assert.Equal(t, "main", entries[0]["name"])
+	assert.Equal(t, "/path/to/worktrees/main", entries[0]["path"])
+	gitStatus, ok := entries[0]["git_status"].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, true, gitStatus["is_dirty"])
+	assert.Equal(t, float64(2), gitStatus["modified"])
+	assert.Equal(t, float64(1), gitStatus["ahead"])
+
+	assert.Equal(t, "dev", entries[1]["name"])
+	assert.Equal(t, "develop", entries[1]["expected_branch"])
+	assert.Equal(t, "feature/auth", entries[1]["current_branch"])
+	assert.Contains(t, entries[1], "git_status")
+	assert.Nil(t, entries[1]["git_status"])
+	assert.Len(t, mock.GetSyncStatusCalls(), 0)
+}
+
+func TestHandleListJSON_EmptyWorktrees(t *testing.T) {
+	mock := &worktreeListerMock{
+		GetAllWorktreesFunc: func() (map[string]*internal.WorktreeListInfo, error) {
+			return map[string]*internal.WorktreeListInfo{}, nil
+		},
+	}
+
+	cmd := &cobra.Command{}
+	var output bytes.Buffer
+	cmd.SetOut(&output)
+
+	require.NoError(t, handleListJSON(mock, cmd))
+	assert.Equal(t, "[]", strings.TrimSpace(output.String()))
+}
+
+func TestHandleListFormat(t *testing.T) {
+	worktrees := map[string]*internal.WorktreeListInfo{
+		"main": {
+			Path:           "/path/to/worktrees/main",
+			ExpectedBranch: "main",
+			CurrentBranch:  "main",
+			GitStatus:      &internal.GitStatus{IsDirty: true, Modified: 2, Ahead: 1},
+		},
+		"feature": {
+			Path:          "/path/to/worktrees/feature",
+			CurrentBranch: "feature/auth",
+		},
+	}
+
+	tests := []struct {
+		name      string
+		format    string
+		expected  string
+		assertErr func(t *testing.T, err error)
+	}{
+		{
+			name:     "paths preset",
+			format:   "paths",
+			expected: "/path/to/worktrees/main\n/path/to/worktrees/feature\n",
+		},
+		{
+			name:   "wide preset aligns columns",
+			format: "wide",
+			expected: "main     main          main  +1 -0 ~2 ?0  /path/to/worktrees/main\n" +
+				"feature  feature/auth        unknown      /path/to/worktrees/feature\n",
+		},
+		{
+			name:     "custom template with escaped tab",
+			format:   `{{.Name}}\t{{.CurrentBranch}}{{with .GitStatus}} ahead={{.Ahead}}{{end}}`,
+			expected: "main     main ahead=1\nfeature  feature/auth\n",
+		},
+		{
+			name:   "invalid template",
+			format: "{{.Name",
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "invalid --format template")
+			},
+		},
+		{
+			name:   "unknown field",
+			format: "{{.Bogus}}",
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "failed to format worktree 'main'")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &worktreeListerMock{
+				GetAllWorktreesFunc: func() (map[string]*internal.WorktreeListInfo, error) {
+					return worktrees, nil
+				},
+				GetSortedWorktreeNamesFunc: func(wt map[string]*internal.WorktreeListInfo) []string {
+					return []string{"main", "feature"}
+				},
+			}
+
+			cmd := &cobra.Command{}
+			var output bytes.Buffer
+			cmd.SetOut(&output)
+
+			err := handleListFormat(mock, cmd, tt.format)
+			if tt.assertErr != nil {
+				tt.assertErr(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, output.String())
+			assert.Len(t, mock.GetSyncStatusCalls(), 0)
+		})
+	}
+}
+
+func TestHandleListJSON_ExcludeMain(t *testing.T) {
+	mock := &worktreeListerMock{
+		GetAllWorktreesFunc: func() (map[string]*internal.WorktreeListInfo, error) {
+			return map[string]*internal.WorktreeListInfo{
+				"main":    {Path: "/path/to/worktrees/main", CurrentBranch: "main"},
+				"feature": {Path: "/path/to/worktrees/feature", CurrentBranch: "feature/auth"},
+			}, nil
+		},
+		GetSortedWorktreeNamesFunc: func(wt map[string]*internal.WorktreeListInfo) []string {
+			return slices.Sorted(maps.Keys(wt))
+		},
+		IsMainWorktreeFunc: func(name string) bool { return name == "main" },
+	}
+
+	cmd := &cobra.Command{}
+	cmd.Flags().Bool("exclude-main", false, "")
+	require.NoError(t, cmd.Flags().Set("exclude-main", "true"))
+	var output bytes.Buffer
+	cmd.SetOut(&output)
+
+	require.NoError(t, handleListJSON(mock, cmd))
+
+	var entries []map[string]any
+	require.NoError(t, json.Unmarshal(output.Bytes(), &entries))
+	require.Len(t, entries, 1)
+	assert.Equal(t, "feature", entries[0]["name"])
+}
+
+func TestHandleList_StatusFilter(t *testing.T) {
+	worktrees := map[string]*internal.WorktreeListInfo{
+		"dirty":   {Path: "/path/to/worktrees/dirty", CurrentBranch: "dirty", GitStatus: &internal.GitStatus{IsDirty: true, Modified: 1}},
+		"clean":   {Path: "/path/to/worktrees/clean", CurrentBranch: "clean", GitStatus: &internal.GitStatus{}},
+		"unknown": {Path: "/path/to/worktrees/unknown", CurrentBranch: "unknown"},
+	}
+
+	tests := []struct {
+		name     string
+		flags    []string
+		expected []string
+	}{
+		{name: "no filter lists everything", expected: []string{"dirty", "clean", "unknown"}},
+		{name: "dirty lists worktrees with changes", flags: []string{"--dirty"}, expected: []string{"dirty"}},
+		{name: "dirty with include-unknown adds unknown status", flags: []string{"--dirty", "--include-unknown"}, expected: []string{"dirty", "unknown"}},
+		{name: "clean lists worktrees without changes", flags: []string{"--clean"}, expected: []string{"clean"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &worktreeListerMock{
+				GetSyncStatusFunc: func() (*internal.SyncStatus, error) {
+					return &internal.SyncStatus{BranchChanges: map[string]internal.BranchChange{}}, nil
+				},
+				GetAllWorktreesFunc: func() (map[string]*internal.WorktreeListInfo, error) {
+					return worktrees, nil
+				},
+				GetSortedWorktreeNamesFunc: func(wt map[string]*internal.WorktreeListInfo) []string {
+					var names []string
+					for _, name := range []string{"dirty", "clean", "unknown"} {
+						if _, ok := wt[name]; ok {
+							names = append(names, name)
+						}
+					}
+					return names
+				},
+				GetWorktreeMappingFunc: func() (map[string]string, error) {
+					return map[string]string{}, nil
+				},
+			}
+
+			cmd := newListCommand()
+			require.NoError(t, cmd.ParseFlags(tt.flags))
+			var output bytes.Buffer
+			cmd.SetOut(&output)
+
+			require.NoError(t, handleList(mock, cmd))
+
+			rows, err := parseListOutput(output.String())
+			require.NoError(t, err)
+			var names []string
+			for _, row := range rows {
+				names = append(names, row.Name)
+			}
+			assert.Equal(t, tt.expected, names)
+		})
+	}
+}
+
+func TestParseStaleDuration(t *testing.T) {
+	tests := []struct {
+		value     string
+		expected  time.Duration
+		expectErr bool
+	}{
+		{value: "30d", expected: 30 * 24 * time.Hour},
+		{value: "2w", expected: 14 * 24 * time.Hour},
+		{value: "36h", expected: 36 * time.Hour},
+		{value: "0d", expectErr: true},
+		{value: "-1h", expectErr: true},
+		{value: "xd", expectErr: true},
+		{value: "soon", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			d, err := parseStaleDuration(tt.value)
+			if tt.expectErr {
+				assert.ErrorContains(t, err, "invalid --stale duration")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, d)
+		})
+	}
+}
+
+func TestHandleList_Stale(t *testing.T) {
+	worktrees := map[string]*internal.WorktreeListInfo{
+		"abandoned":  {Path: "/path/to/worktrees/abandoned", CurrentBranch: "abandoned", GitStatus: &internal.GitStatus{}},
+		"wip":        {Path: "/path/to/worktrees/wip", CurrentBranch: "wip", GitStatus: &internal.GitStatus{IsDirty: true, Modified: 1}},
+		"active":     {Path: "/path/to/worktrees/active", CurrentBranch: "active", GitStatus: &internal.GitStatus{}},
+		"unreadable": {Path: "/path/to/worktrees/unreadable", CurrentBranch: "unreadable", GitStatus: &internal.GitStatus{}},
+	}
+	lastCommits := map[string]time.Time{
+		"/path/to/worktrees/abandoned": time.Now().Add(-90 * 24 * time.Hour),
+		"/path/to/worktrees/wip":       time.Now().Add(-45 * 24 * time.Hour),
+		"/path/to/worktrees/active":    time.Now().Add(-2 * time.Hour),
+	}
+
+	tests := []struct {
+		name     string
+		flags    []string
+		expected []string
+	}{
+		{name: "stale lists worktrees without recent commits", flags: []string{"--stale", "30d"}, expected: []string{"abandoned", "wip"}},
+		{name: "stale with dirty keeps worktrees with uncommitted work", flags: []string{"--stale", "30d", "--dirty"}, expected: []string{"wip"}},
+		{name: "stale with clean keeps worktrees safe to delete", flags: []string{"--stale", "30d", "--clean"}, expected: []string{"abandoned"}},
+		{name: "longer duration narrows the list", flags: []string{"--stale", "8w"}, expected: []string{"abandoned"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &worktreeListerMock{
+				GetAllWorktreesFunc: func() (map[string]*internal.WorktreeListInfo, error) {
+					return worktrees, nil
+				},
+				GetSortedWorktreeNamesFunc: func(wt map[string]*internal.WorktreeListInfo) []string {
+					return slices.Sorted(maps.Keys(wt))
+				},
+				GetWorktreeCommitHistoryFunc: func(worktreePath string, limit int) ([]internal.CommitInfo, error) {
+					assert.Equal(t, 1, limit)
+					at, ok := lastCommits[worktreePath]
+					if !ok {
+						return nil, fmt.Errorf("git log failed")
+					}
+					return []internal.CommitInfo{{Hash: "abc1234", Timestamp: at}}, nil
+				},
+			}
+
+			cmd := newListCommand()
+			require.NoError(t, cmd.ParseFlags(tt.flags))
+			var output bytes.Buffer
+			cmd.SetOut(&output)
+
+			require.NoError(t, handleListJSON(mock, cmd))
+
+			var entries []map[string]any
+			require.NoError(t, json.Unmarshal(output.Bytes(), &entries))
+			var names []string
+			for _, entry := range entries {
+				names = append(names, entry["name"].(string))
+			}
+			assert.Equal(t, tt.expected, names)
+		})
+	}
+}
+
+func TestHandleList_StaleShowsLastCommit(t *testing.T) {
+	mock := &worktreeListerMock{
+		GetSyncStatusFunc: func() (*internal.SyncStatus, error) {
+			return &internal.SyncStatus{BranchChanges: map[string]internal.BranchChange{}}, nil
+		},
+		GetAllWorktreesFunc: func() (map[string]*internal.WorktreeListInfo, error) {
+			return map[string]*internal.WorktreeListInfo{
+				"abandoned": {Path: "/path/to/worktrees/abandoned", CurrentBranch: "abandoned", GitStatus: &internal.GitStatus{}},
+			}, nil
+		},
+		GetSortedWorktreeNamesFunc: func(wt map[string]*internal.WorktreeListInfo) []string {
+			return slices.Sorted(maps.Keys(wt))
+		},
+		GetWorktreeMappingFunc: func() (map[string]string, error) {
+			return map[string]string{}, nil
+		},
+		GetWorktreeCommitHistoryFunc: func(worktreePath string, limit int) ([]internal.CommitInfo, error) {
+			return []internal.CommitInfo{{Hash: "abc1234", Timestamp: time.Now().Add(-90 * 24 * time.Hour)}}, nil
+		},
+	}
+
+	cmd := newListCommand()
+	require.NoError(t, cmd.ParseFlags([]string{"--stale", "30d"}))
+	var output bytes.Buffer
+	cmd.SetOut(&output)
+
+	require.NoError(t, handleList(mock, cmd))
+	assert.Contains(t, output.String(), "LAST COMMIT")
+	assert.Contains(t, output.String(), "90 days ago")
+}
+
+func TestHandleListRemote(t *testing.T) {
+	newMock := func() *remoteBranchListerMock {
+		return &remoteBranchListerMock{
+			GetRemoteBranchesFunc: func() ([]string, error) {
+				return []string{"main", "feature/checked-out", "feature/zeta", "feature/alpha"}, nil
+			},
+			GetWorktreesFunc: func() ([]*internal.WorktreeInfo, error) {
+				return []*internal.WorktreeInfo{
+					{Name: "main", Branch: "main"},
+					{Name: "checked-out", Branch: "feature/checked-out"},
+				}, nil
+			},
+			GetDefaultBranchFunc: func() (string, error) { return "main", nil },
+			GetDefaultRemoteFunc: func() string { return "origin" },
+			GetAheadBehindRefsFunc: func(ref, baseRef string) (int, int, error) {
+				if ref == "origin/feature/alpha" {
+					return 3, 1, nil
+				}
+				return 0, 2, nil
+			},
+		}
+	}
+
+	t.Run("lists branches without a worktree", func(t *testing.T) {
+		mock := newMock()
+		cmd := &cobra.Command{}
+		var output bytes.Buffer
+		cmd.SetOut(&output)
+
+		require.NoError(t, handleListRemote(mock, cmd, false))
+
+		out := output.String()
+		assert.Contains(t, out, "feature/alpha")
+		assert.Contains(t, out, "feature/zeta")
+		assert.NotContains(t, out, "feature/checked-out")
+		assert.Less(t, strings.Index(out, "feature/alpha"), strings.Index(out, "feature/zeta"))
+		for _, call := range mock.GetAheadBehindRefsCalls() {
+			assert.Equal(t, "origin/main", call.BaseRef)
+		}
+		assert.Len(t, mock.GetAheadBehindRefsCalls(), 2)
+	})
+
+	t.Run("json output", func(t *testing.T) {
+		cmd := &cobra.Command{}
+		var output bytes.Buffer
+		cmd.SetOut(&output)
+
+		require.NoError(t, handleListRemote(newMock(), cmd, true))
+
+		var entries []remoteBranchJSON
+		require.NoError(t, json.Unmarshal(output.Bytes(), &entries))
+		assert.Equal(t, []remoteBranchJSON{
+			{Branch: "feature/alpha", Ref: "origin/feature/alpha", Ahead: 3, Behind: 1},
+			{Branch: "feature/zeta", Ref: "origin/feature/zeta", Ahead: 0, Behind: 2},
+		}, entries)
+	})
+
+	t.Run("remote branch error", func(t *testing.T) {
+		mock := newMock()
+		mock.GetRemoteBranchesFunc = func() ([]string, error) { return nil, fmt.Errorf("boom") }
+
+		err := handleListRemote(mock, &cobra.Command{}, false)
+		assert.EqualError(t, err, "boom")
+	})
+}
@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"gbm/internal"
+
+	"github.com/spf13/cobra"
+)
+
+//go:generate go run github.com/matryer/moq@latest -out ./autogen_worktreeLogger.go . worktreeLogger
+
+// worktreeLogger interface abstracts the Manager operations needed to show a worktree's commit history
+type worktreeLogger interface {
+	IsInWorktree(currentPath string) (bool, string, error)
+	GetWorktreePath(worktreeName string) (string, error)
+	GetCommitHistory(worktreePath string, options internal.CommitHistoryOptions) ([]internal.CommitInfo, error)
+}
+
+// defaultLogLimit is how many commits `gbm log` shows without --limit
+const defaultLogLimit = 20
+
+// commitJSON is a single entry of `gbm log --json` output
+type commitJSON struct {
+	Hash      string    `json:"hash"`
+	Message   string    `json:"message"`
+	Author    string    `json:"author"`
+	Email     string    `json:"email"`
+	Timestamp time.Time `json:"timestamp"`
+	Refs      string    `json:"refs,omitempty"`
+}
+
+func newLogCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "log [worktree-name]",
+		Short: "Show the commit history of a worktree",
+		Long: `Show the commit history of a worktree, or of the current worktree when no name is given.
+
+Each commit is shown with its short hash, message, author and age. Use --json for the
+full hash, email and refs as a JSON array.
+
+Examples:
+  gbm log                                 # Last 20 commits of the current worktree
+  gbm log feat-auth --limit 50
+  gbm log feat-auth --since 2.weeks.ago --author jane
+  gbm log feat-auth --grep PROJ-123 --merges
+  gbm log feat-auth --range origin/main..HEAD --json`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			limit, _ := cmd.Flags().GetInt("limit")
+			since, _ := cmd.Flags().GetString("since")
+			merges, _ := cmd.Flags().GetBool("merges")
+			grep, _ := cmd.Flags().GetString("grep")
+			author, _ := cmd.Flags().GetString("author")
+			revRange, _ := cmd.Flags().GetString("range")
+			jsonOutput, _ := cmd.Flags().GetBool("json")
+
+			if limit < 0 {
+				return fmt.Errorf("--limit must not be negative")
+			}
+
+			wd, err := os.Getwd()
+			if err != nil {
+				return fmt.Errorf("failed to get working directory: %w", err)
+			}
+
+			manager, err := createInitializedManager()
+			if err != nil {
+				if !errors.Is(err, ErrLoadGBMConfig) {
+					return err
+				}
+
+				PrintVerbose("%v", err)
+			}
+
+			worktreeName := ""
+			if len(args) == 1 {
+				worktreeName = args[0]
+			}
+
+			options := internal.CommitHistoryOptions{
+				Limit:       limit,
+				Range:       revRange,
+				Since:       since,
+				MergesOnly:  merges,
+				GrepPattern: grep,
+				Author:      author,
+			}
+			return handleLog(manager, cmd.OutOrStdout(), worktreeName, wd, options, jsonOutput)
+		},
+	}
+
+	cmd.Flags().IntP("limit", "n", defaultLogLimit, "maximum number of commits to show (0 for no limit)")
+	cmd.Flags().String("since", "", "only show commits newer than this date (e.g. 2.weeks.ago, 2025-01-01)")
+	cmd.Flags().Bool("merges", false, "only show merge commits")
+	cmd.Flags().String("grep", "", "only show commits whose message matches this pattern")
+	cmd.Flags().String("author", "", "only show commits whose author name or email matches this pattern")
+	cmd.Flags().String("range", "", "revision range to show (e.g. origin/main..HEAD)")
+	cmd.Flags().Bool("json", false, "output commits as JSON")
+
+	cmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) != 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return getWorktreeCompletionsWithManager(), cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+// handleLog prints the commit history of the named worktree, or of the worktree containing
+// currentPath when worktreeName is empty
+func handleLog(logger worktreeLogger, out io.Writer, worktreeName, currentPath string, options internal.CommitHistoryOptions, jsonOutput bool) error {
+	if worktreeName == "" {
+		inWorktree, name, err := logger.IsInWorktree(currentPath)
+		if err != nil {
+			return fmt.Errorf("failed to check if in worktree: %w", err)
+		}
+		if !inWorktree {
+			return fmt.Errorf("not currently in a worktree. Use 'gbm log <worktree-name>' to show a specific worktree")
+		}
+		worktreeName = name
+	}
+
+	worktreePath, err := logger.GetWorktreePath(worktreeName)
+	if err != nil {
+		return err
+	}
+
+	commits, err := logger.GetCommitHistory(worktreePath, options)
+	if err != nil {
+		return fmt.Errorf("failed to get commit history for worktree '%s': %w", worktreeName, err)
+	}
+
+	if jsonOutput {
+		entries := make([]commitJSON, 0, len(commits))
+		for _, commit := range commits {
+			entries = append(entries, commitJSON(commit))
+		}
+
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(entries); err != nil {
+			return fmt.Errorf("failed to encode commit history: %w", err)
+		}
+		return nil
+	}
+
+	if len(commits) == 0 {
+		PrintInfo("No commits found")
+		return nil
+	}
+
+	for _, commit := range commits {
+		_, _ = fmt.Fprintf(out, "%s %s %s\n",
+			internal.FormatWarning(shortHash(commit.Hash)),
+			commit.Message,
+			internal.FormatSubtle(fmt.Sprintf("(%s, %s)", commit.Author, internal.FormatRelativeTime(commit.Timestamp))))
+	}
+
+	return nil
+}
+
+func shortHash(hash string) string {
+	if len(hash) > 7 {
+		return hash[:7]
+	}
+	return hash
+}
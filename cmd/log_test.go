@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"gbm/internal"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newLoggerMock(commits []internal.CommitInfo) *worktreeLoggerMock {
+	return &worktreeLoggerMock{
+		IsInWorktreeFunc: func(currentPath string) (bool, string, error) {
+			if currentPath == "/repo/worktrees/feat" {
+				return true, "feat", nil
+			}
+			return false, "", nil
+		},
+		GetWorktreePathFunc: func(worktreeName string) (string, error) {
+			return "/repo/worktrees/" + worktreeName, nil
+		},
+		GetCommitHistoryFunc: func(worktreePath string, options internal.CommitHistoryOptions) ([]internal.CommitInfo, error) {
+			return commits, nil
+		},
+	}
+}
+
+func TestHandleLog(t *testing.T) {
+	commits := []internal.CommitInfo{
+		{
+			Hash:      "a1b2c3d4e5f60718293a4b5c6d7e8f9012345678",
+			Message:   "Fix login redirect",
+			Author:    "Jane Doe",
+			Email:     "jane@example.com",
+			Timestamp: time.Now().Add(-2 * time.Hour),
+			Refs:      "HEAD -> feat",
+		},
+	}
+	options := internal.CommitHistoryOptions{Limit: 5, Since: "2.weeks.ago", GrepPattern: "login", Author: "jane", MergesOnly: true, Range: "main..HEAD"}
+
+	t.Run("named worktree passes options through", func(t *testing.T) {
+		mock := newLoggerMock(commits)
+		var out bytes.Buffer
+
+		require.NoError(t, handleLog(mock, &out, "feat", "/elsewhere", options, false))
+
+		require.Len(t, mock.GetCommitHistoryCalls(), 1)
+		assert.Equal(t, "/repo/worktrees/feat", mock.GetCommitHistoryCalls()[0].WorktreePath)
+		assert.Equal(t, options, mock.GetCommitHistoryCalls()[0].Options)
+		assert.Empty(t, mock.IsInWorktreeCalls())
+
+		assert.Contains(t, out.String(), "a1b2c3d")
+		assert.NotContains(t, out.String(), "a1b2c3d4")
+		assert.Contains(t, out.String(), "Fix login redirect")
+		assert.Contains(t, out.String(), "Jane Doe")
+		assert.Contains(t, out.String(), "2 hours ago")
+	})
+
+	t.Run("defaults to the current worktree", func(t *testing.T) {
+		mock := newLoggerMock(commits)
+		var out bytes.Buffer
+
+		require.NoError(t, handleLog(mock, &out, "", "/repo/worktrees/feat", options, false))
+		assert.Equal(t, "feat", mock.GetWorktreePathCalls()[0].WorktreeName)
+	})
+
+	t.Run("outside a worktree without a name", func(t *testing.T) {
+		mock := newLoggerMock(commits)
+		var out bytes.Buffer
+
+		err := handleLog(mock, &out, "", "/elsewhere", options, false)
+		assert.ErrorContains(t, err, "not currently in a worktree")
+		assert.Empty(t, mock.GetCommitHistoryCalls())
+	})
+
+	t.Run("json output", func(t *testing.T) {
+		mock := newLoggerMock(commits)
+		var out bytes.Buffer
+
+		require.NoError(t, handleLog(mock, &out, "feat", "", options, true))
+
+		var entries []map[string]any
+		require.NoError(t, json.Unmarshal(out.Bytes(), &entries))
+		require.Len(t, entries, 1)
+		assert.Equal(t, commits[0].Hash, entries[0]["hash"])
+		assert.Equal(t, "jane@example.com", entries[0]["email"])
+		assert.Equal(t, "HEAD -> feat", entries[0]["refs"])
+	})
+
+	t.Run("json output with no commits is an empty array", func(t *testing.T) {
+		mock := newLoggerMock(nil)
+		var out bytes.Buffer
+
+		require.NoError(t, handleLog(mock, &out, "feat", "", options, true))
+		assert.JSONEq(t, "[]", out.String())
+	})
+
+	t.Run("history errors are returned", func(t *testing.T) {
+		mock := newLoggerMock(nil)
+		mock.GetCommitHistoryFunc = func(worktreePath string, options internal.CommitHistoryOptions) ([]internal.CommitInfo, error) {
+			return nil, errors.New("fatal: bad revision 'nope..HEAD'")
+		}
+		var out bytes.Buffer
+
+		err := handleLog(mock, &out, "feat", "", options, false)
+		assert.ErrorContains(t, err, "bad revision")
+	})
+}
@@ -13,6 +13,23 @@ import (
 	"github.com/spf13/cobra"
 )
 
+//go:generate go run github.com/matryer/moq@latest -out ./autogen_mergebackChainRunner.go . mergebackChainRunner
+
+// mergebackChainRunner interface abstracts the Manager operations needed for chained mergebacks
+type mergebackChainRunner interface {
+	PlanMergebackChain() ([]internal.MergebackStep, error)
+	MergebackAll(steps []internal.MergebackStep) (*internal.MergebackChainResult, error)
+}
+
+//go:generate go run github.com/matryer/moq@latest -out ./autogen_mergeAborter.go . mergeAborter
+
+// mergeAborter interface abstracts the Manager operations needed for aborting a conflicted mergeback
+type mergeAborter interface {
+	GetWorktreePath(worktreeName string) (string, error)
+	IsMergeInProgress(worktreePath string) (bool, error)
+	AbortMerge(worktreePath string) error
+}
+
 func newMergebackCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "mergeback [worktree-name]",
@@ -23,27 +40,47 @@ func newMergebackCommand() *cobra.Command {
 The mergeback command automatically:
 - Detects which branch in the mergeback chain needs the merge based on configuration
 - Creates a worktree directory with configurable prefix (default: MERGE_<worktree>_<base>)
-- Creates a new branch with merge/ prefix
+- Creates a new branch with the merge/ prefix (configurable via merge_branch_prefix)
 - Offers to perform the merge automatically with user confirmation
 
 The worktree prefix can be configured in .gbm/config.toml under settings.mergeback_prefix.
 Set to empty string to disable prefixing (worktrees will still include target suffix for namespace separation).
 
 After creating the worktree, gbm will show you which commits will be merged and ask if you 
-want to perform the merge automatically. If conflicts occur, gbm will let you know and you 
-can resolve them manually in the mergeback worktree.
+want to perform the merge automatically. If conflicts occur, gbm offers to abort the merge;
+otherwise you can resolve them manually in the mergeback worktree, or later run
+'gbm mergeback --abort <mergeback-worktree>' to reset it.
 
 Examples:
   gbm mergeback                            # Auto-detects recent merge activity and creates appropriate mergeback
   gbm mergeback <TAB>                      # Shows smart suggestions from recent git activity (press Tab)
   gbm mergeback fix-auth                   # Creates worktree MERGE_fix-auth_preview with branch merge/fix-auth_preview
   gbm mb deploy-hotfix                     # Creates MERGE_deploy-hotfix_<base> worktree
+  gbm mergeback --chain                    # Plans and runs every pending mergeback, bottom-up
+  gbm mergeback --abort MERGE_fix-auth_preview  # Aborts a conflicted merge in that worktree
+  gbm mergeback --dry-run                  # Shows the detected mergeback and its commits without creating it
+
+Chain Mode:
+  With --chain, gbm computes every mergeback needed to carry pending commits up the
+  merge_into tree (e.g., production → preview → main), shows the plan, and after
+  confirmation creates and merges each mergeback worktree in order. Later steps merge
+  from the earlier mergeback branch so changes cascade. It stops at the first conflict
+  and exits non-zero; run it again once the conflict is resolved.
 
 Tab Completion:
   Press TAB to see intelligent suggestions based on recent merge activity,
-  or press ENTER for automatic detection with confirmation prompt.`,
+  or press ENTER for automatic detection with confirmation prompt. Suggestions
+  cover the last 7 days; use --since to widen or narrow the window, e.g.
+  'gbm mergeback --since v1.4.0 <TAB>' for activity since the last release tag.
+  Without a worktree name, 'gbm mergeback --since <window>' picks the most relevant
+  hotfix or merge in that window and asks before using it.`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
+			chain, _ := cmd.Flags().GetBool("chain")
+			abort, _ := cmd.Flags().GetBool("abort")
+			dryRun, _ := cmd.Flags().GetBool("dry-run")
+			since, _ := cmd.Flags().GetString("since")
+
 			// Create manager
 			manager, err := createInitializedManager()
 			if err != nil {
@@ -54,6 +91,32 @@ Tab Completion:
 				PrintVerbose("%v", err)
 			}
 
+			if abort {
+				if chain || len(args) == 0 {
+					return fmt.Errorf("--abort requires the mergeback worktree name and cannot be combined with --chain")
+				}
+				return handleMergebackAbort(manager, args[0])
+			}
+
+			if chain {
+				if len(args) > 0 {
+					return fmt.Errorf("a worktree name cannot be combined with --chain")
+				}
+				return handleMergebackChain(manager, newConfirmation(cmd))
+			}
+
+			// Name the mergeback after the given worktree, or with --since after the most relevant
+			// recent hotfix or merge in that window. With a name given, --since only served completion.
+			var worktreeName string
+			if len(args) > 0 {
+				worktreeName = args[0]
+			} else if since != "" {
+				worktreeName, err = autoDetectMergebackTarget(manager, internal.ActivityOptions{Since: since}, newConfirmation(cmd))
+				if err != nil {
+					return err
+				}
+			}
+
 			// Find the source and target branches for merging
 			sourceBranch, baseBranch, baseWorktreeName, sourceWorktreeName, err := findMergeTargetBranchAndWorktree(manager)
 			if err != nil {
@@ -63,25 +126,35 @@ Tab Completion:
 			PrintInfo("Mergeback needed: '%s' → '%s'", sourceWorktreeName, baseWorktreeName)
 			PrintVerbose("Will merge from '%s' into '%s'", sourceBranch, baseBranch)
 
-			// Use source worktree name for naming (e.g., "production" for production → preview)
-			// User can override by passing worktree name as argument
-			var worktreeName string
-			if len(args) == 0 {
+			// Otherwise use source worktree name for naming (e.g., "production" for production → preview)
+			if worktreeName == "" {
 				worktreeName = sourceWorktreeName
-			} else {
-				worktreeName = args[0]
 			}
 
 			// Generate mergeback branch name
-			branchName := fmt.Sprintf("merge/%s_%s", worktreeName, strings.ToLower(baseWorktreeName))
+			mergeBranchPrefix := manager.GetConfig().Settings.MergeBranchPrefix
+			branchName := internal.MergebackBranchName(mergeBranchPrefix, worktreeName, baseWorktreeName)
 
 			// Get mergeback prefix from config and build worktree name
 			mergebackPrefix := manager.GetConfig().Settings.MergebackPrefix
-			var mergebackWorktreeName string
-			if mergebackPrefix != "" {
-				mergebackWorktreeName = mergebackPrefix + "_" + worktreeName + "_" + baseWorktreeName
-			} else {
-				mergebackWorktreeName = worktreeName + "_" + baseWorktreeName
+			mergebackWorktreeName := internal.MergebackWorktreeName(mergebackPrefix, worktreeName, baseWorktreeName)
+
+			if dryRun {
+				commits, err := getCommitsToMerge(manager.GetGitPath(), baseBranch, sourceBranch)
+				if err != nil {
+					return fmt.Errorf("failed to list commits to merge: %w", err)
+				}
+
+				fmt.Print(renderMergebackDryRun(mergebackDryRun{
+					SourceWorktree: sourceWorktreeName,
+					SourceBranch:   sourceBranch,
+					TargetWorktree: baseWorktreeName,
+					TargetBranch:   baseBranch,
+					WorktreeName:   mergebackWorktreeName,
+					BranchName:     branchName,
+					Commits:        commits,
+				}))
+				return nil
 			}
 
 			PrintInfo("Creating mergeback worktree '%s' on branch '%s'", mergebackWorktreeName, branchName)
@@ -95,7 +168,7 @@ Tab Completion:
 			PrintInfo("Ready to merge changes into '%s'", baseBranch)
 
 			// Offer to perform the merge automatically
-			if err := offerMergeExecution(manager, mergebackWorktreeName, worktreeName, sourceBranch, baseBranch); err != nil {
+			if err := offerMergeExecution(manager, mergebackWorktreeName, worktreeName, sourceBranch, baseBranch, newConfirmation(cmd)); err != nil {
 				return fmt.Errorf("merge execution failed: %w", err)
 			}
 
@@ -103,11 +176,21 @@ Tab Completion:
 		},
 	}
 
+	cmd.Flags().Bool("chain", false, "create and merge every pending mergeback up the chain, bottom-up")
+	cmd.Flags().Bool("abort", false, "abort the in-progress merge in the given mergeback worktree")
+	cmd.Flags().Bool("dry-run", false, "show the mergeback that would be created and the commits it would merge, without creating anything")
+	cmd.MarkFlagsMutuallyExclusive("dry-run", "abort")
+	cmd.MarkFlagsMutuallyExclusive("dry-run", "chain")
+	cmd.Flags().String("since", "", "how far back to look for recent merge activity: a git date (2.weeks.ago, 2025-07-01) or a ref such as the last release tag (default: 7 days)")
+	cmd.MarkFlagsMutuallyExclusive("since", "chain")
+	cmd.MarkFlagsMutuallyExclusive("since", "abort")
+
 	// Add smart auto-detection results as tab completion for first argument
 	cmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		if len(args) == 0 {
 			// First argument: provide smart detection results
-			return getSmartMergebackCompletions(), cobra.ShellCompDirectiveNoFileComp
+			since, _ := cmd.Flags().GetString("since")
+			return getSmartMergebackCompletions(internal.ActivityOptions{Since: since}), cobra.ShellCompDirectiveNoFileComp
 		}
 		return nil, cobra.ShellCompDirectiveNoFileComp
 	}
@@ -115,6 +198,110 @@ Tab Completion:
 	return cmd
 }
 
+// mergebackDryRun describes the mergeback worktree that would be created, for --dry-run
+type mergebackDryRun struct {
+	SourceWorktree string
+	SourceBranch   string
+	TargetWorktree string
+	TargetBranch   string
+	WorktreeName   string
+	BranchName     string
+	Commits        []string
+}
+
+// renderMergebackDryRun formats the mergeback plan and every commit it would merge
+func renderMergebackDryRun(plan mergebackDryRun) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "\n%s\n", internal.FormatSubHeader("Mergeback Plan (dry run):"))
+	fmt.Fprintf(&sb, "  %s: %s (%s)\n", internal.FormatInfo("Source"), plan.SourceWorktree, plan.SourceBranch)
+	fmt.Fprintf(&sb, "  %s: %s (%s)\n", internal.FormatInfo("Target"), plan.TargetWorktree, plan.TargetBranch)
+	fmt.Fprintf(&sb, "  %s: %s\n", internal.FormatInfo("Worktree"), plan.WorktreeName)
+	fmt.Fprintf(&sb, "  %s: %s\n", internal.FormatInfo("Branch"), plan.BranchName)
+	fmt.Fprintf(&sb, "  %s: %d commits\n", internal.FormatInfo("Commits to Merge"), len(plan.Commits))
+
+	if len(plan.Commits) > 0 {
+		fmt.Fprintf(&sb, "\n%s\n", internal.FormatSubHeader("Commits:"))
+		for _, commit := range plan.Commits {
+			fmt.Fprintf(&sb, "  • %s\n", commit)
+		}
+	}
+
+	return sb.String()
+}
+
+// handleMergebackAbort aborts the in-progress merge in a mergeback worktree
+func handleMergebackAbort(aborter mergeAborter, worktreeName string) error {
+	worktreePath, err := aborter.GetWorktreePath(worktreeName)
+	if err != nil {
+		return err
+	}
+
+	inProgress, err := aborter.IsMergeInProgress(worktreePath)
+	if err != nil {
+		return err
+	}
+	if !inProgress {
+		return fmt.Errorf("no merge in progress in worktree '%s'", worktreeName)
+	}
+
+	if err := aborter.AbortMerge(worktreePath); err != nil {
+		return fmt.Errorf("failed to abort merge: %w", err)
+	}
+
+	PrintInfo("%s", internal.FormatSuccess(fmt.Sprintf("Aborted merge in worktree '%s'", worktreeName)))
+	return nil
+}
+
+// handleMergebackChain prints the mergeback plan and, once confirmed, runs every step in order
+func handleMergebackChain(runner mergebackChainRunner, confirm internal.ConfirmationFunc) error {
+	steps, err := runner.PlanMergebackChain()
+	if err != nil {
+		return fmt.Errorf("failed to plan mergeback chain: %w", err)
+	}
+
+	if len(steps) == 0 {
+		PrintInfo("%s", internal.FormatSuccess("No mergebacks needed"))
+		return nil
+	}
+
+	fmt.Printf("\n%s\n", internal.FormatSubHeader("Mergeback Plan:"))
+	for i, step := range steps {
+		pending := "carries earlier merges"
+		if step.Commits > 0 {
+			pending = fmt.Sprintf("%d commits", step.Commits)
+		}
+		fmt.Printf("  %d. %s (%s) → %s (%s): %s\n", i+1, step.SourceWorktree, step.SourceBranch, step.TargetWorktree, step.TargetBranch, pending)
+	}
+	fmt.Println()
+
+	if !confirm(fmt.Sprintf("Create and merge %d mergeback(s)?", len(steps))) {
+		PrintInfo("Mergeback chain cancelled")
+		return nil
+	}
+
+	result, err := runner.MergebackAll(steps)
+	if result != nil {
+		for _, step := range result.Completed {
+			PrintInfo("%s", internal.FormatSuccess(fmt.Sprintf("Merged %s into %s", step.SourceWorktree, step.TargetWorktree)))
+		}
+	}
+
+	if errors.Is(err, internal.ErrMergebackConflict) {
+		PrintInfo("Merge conflicts detected. Please resolve conflicts manually in worktree '%s'", result.ConflictWorktree)
+		PrintInfo("After resolving conflicts, use: git add . && git commit, then run 'gbm mergeback --chain' again")
+		// Exit non-zero so scripts can tell the chain did not finish
+		return fmt.Errorf("mergeback chain stopped: %w", err)
+	}
+	if err != nil {
+		return fmt.Errorf("mergeback chain failed: %w", err)
+	}
+
+	PrintInfo("Mergeback chain completed successfully!")
+	PrintInfo("Review the merges in the mergeback worktrees before pushing")
+	return nil
+}
+
 // findMergeTargetBranchAndWorktree finds the source branch with changes and target branch/worktree for mergeback
 // Uses tree structure and git log to find branches that need merging
 // Returns: sourceBranch, targetBranch, targetWorktreeName, sourceWorktreeName, error
@@ -221,9 +408,10 @@ func findNextMergeTargetInChain(leaves []*internal.WorktreeNode) (string, string
 }
 
 // autoDetectMergebackTarget analyzes recent git history to suggest a mergeback target
-func autoDetectMergebackTarget(manager *internal.Manager) (string, error) {
+func autoDetectMergebackTarget(manager *internal.Manager, opts internal.ActivityOptions, confirm internal.ConfirmationFunc) (string, error) {
 	// Get recent mergeable activity from git history (only hotfix and merge types)
-	activities, err := manager.GetGitManager().GetRecentMergeableActivity(7) // Last 7 days
+	opts.JiraProjects = manager.GetConfig().Jira.Projects
+	activities, err := manager.GetGitManager().GetRecentMergeableActivityWithOptions(opts)
 	if err != nil {
 		return "", fmt.Errorf("failed to analyze git history: %w", err)
 	}
@@ -235,7 +423,7 @@ func autoDetectMergebackTarget(manager *internal.Manager) (string, error) {
 	}
 
 	if len(filteredActivities) == 0 {
-		return "", fmt.Errorf("no recent hotfix or merge activity found that needs mergeback in the last 7 days")
+		return "", fmt.Errorf("no recent hotfix or merge activity found that needs mergeback since %s", activityWindow(opts))
 	}
 
 	// Find the most relevant recent activity
@@ -279,13 +467,8 @@ func autoDetectMergebackTarget(manager *internal.Manager) (string, error) {
 	fmt.Printf("  %s: %s\n", internal.FormatInfo("Date"), bestActivity.Timestamp.Format("2006-01-02 15:04"))
 
 	// Ask for confirmation
-	fmt.Printf("\n%s ", internal.FormatPrompt("Use this for mergeback? (y/n):"))
-	var response string
-	if _, err := fmt.Scanln(&response); err != nil {
-		return "", fmt.Errorf("failed to read confirmation: %w", err)
-	}
-
-	if strings.ToLower(response) != "y" && strings.ToLower(response) != "yes" {
+	fmt.Println()
+	if !confirm("Use this for mergeback?") {
 		return "", fmt.Errorf("mergeback cancelled by user")
 	}
 
@@ -406,7 +589,7 @@ func findPotentialMergeTargets(branchName string, config *internal.GBMConfig) []
 }
 
 // isBranchAheadOf checks if sourceBranch has commits that targetBranch doesn't have
-func isBranchAheadOf(sourceBranch, targetBranch string, _ *internal.Manager) (bool, error) {
+func isBranchAheadOf(sourceBranch, targetBranch string, manager *internal.Manager) (bool, error) {
 	// Get the repo root from working directory
 	wd, err := os.Getwd()
 	if err != nil {
@@ -418,23 +601,25 @@ func isBranchAheadOf(sourceBranch, targetBranch string, _ *internal.Manager) (bo
 		return false, fmt.Errorf("failed to find git root: %w", err)
 	}
 
-	// Use git rev-list to count commits that are in source but not in target
-	output, err := internal.ExecGitCommand(repoRoot, "rev-list", "--count", fmt.Sprintf("%s..%s", targetBranch, sourceBranch))
+	// Source has new commits unless target already contains all of them
+	merged, err := manager.GetGitManager().IsAncestorInPath(repoRoot, sourceBranch, targetBranch)
 	if err != nil {
 		return false, fmt.Errorf("failed to check branch relationship: %w", err)
 	}
 
-	countStr := strings.TrimSpace(string(output))
-	var count int
-	if _, err := fmt.Sscanf(countStr, "%d", &count); err != nil {
-		return false, fmt.Errorf("failed to parse commit count: %w", err)
-	}
+	return !merged, nil
+}
 
-	return count > 0, nil
+// activityWindow describes the start of the history searched for recent activity
+func activityWindow(opts internal.ActivityOptions) string {
+	if opts.Since == "" {
+		return "7 days ago"
+	}
+	return opts.Since
 }
 
 // getSmartMergebackCompletions provides intelligent tab completion based on recent activity
-func getSmartMergebackCompletions() []string {
+func getSmartMergebackCompletions(opts internal.ActivityOptions) []string {
 	completions := make([]string, 0)
 
 	// Try to get smart detection results
@@ -449,7 +634,8 @@ func getSmartMergebackCompletions() []string {
 	}
 
 	// Get recent mergeable activity (same logic as auto-detection)
-	activities, err := manager.GetGitManager().GetRecentMergeableActivity(7)
+	opts.JiraProjects = manager.GetConfig().Jira.Projects
+	activities, err := manager.GetGitManager().GetRecentMergeableActivityWithOptions(opts)
 	if err != nil {
 		return completions
 	}
@@ -480,7 +666,7 @@ func getSmartMergebackCompletions() []string {
 }
 
 // offerMergeExecution prompts user to perform the merge and executes it if confirmed
-func offerMergeExecution(manager *internal.Manager, mergebackWorktreeName, sourceName, sourceBranch, targetBranch string) error {
+func offerMergeExecution(manager *internal.Manager, mergebackWorktreeName, sourceName, sourceBranch, targetBranch string, confirm internal.ConfirmationFunc) error {
 	// Get git root
 	wd, err := os.Getwd()
 	if err != nil {
@@ -492,11 +678,14 @@ func offerMergeExecution(manager *internal.Manager, mergebackWorktreeName, sourc
 		return fmt.Errorf("failed to find git root: %w", err)
 	}
 
-	// Get worktree path
-	worktreePath := filepath.Join(repoRoot, internal.DefaultWorktreeDirname, mergebackWorktreeName)
+	// The mergeback worktree lives under settings.worktree_prefix, which may have been relocated
+	worktreePath, err := manager.GetWorktreePath(mergebackWorktreeName)
+	if err != nil {
+		return fmt.Errorf("failed to find mergeback worktree: %w", err)
+	}
 
 	// Get commits that will be merged
-	mergeBranch := fmt.Sprintf("merge/%s_%s", sourceName, strings.ToLower(targetBranch))
+	mergeBranch := internal.MergebackBranchName(manager.GetConfig().Settings.MergeBranchPrefix, sourceName, targetBranch)
 	commits, err := getCommitsToMerge(repoRoot, targetBranch, sourceBranch)
 	if err != nil {
 		PrintVerbose("Could not get commits to merge: %v", err)
@@ -526,14 +715,15 @@ func offerMergeExecution(manager *internal.Manager, mergebackWorktreeName, sourc
 		}
 	}
 
-	// Ask for confirmation
-	fmt.Printf("\n%s ", internal.FormatPrompt("Perform the merge automatically? (y/n):"))
-	var response string
-	if _, err := fmt.Scanln(&response); err != nil {
-		return fmt.Errorf("failed to read confirmation: %w", err)
+	if files, err := getFilesToMerge(manager, repoRoot, targetBranch, sourceBranch); err != nil {
+		PrintVerbose("Could not get files to merge: %v", err)
+	} else if len(files) > 0 {
+		printMergeDiffstat(files)
 	}
 
-	if strings.ToLower(response) != "y" && strings.ToLower(response) != "yes" {
+	// Ask for confirmation
+	fmt.Println()
+	if !confirm("Perform the merge automatically?") {
 		PrintInfo("Merge cancelled. You can perform the merge manually in the worktree '%s'", mergebackWorktreeName)
 		return nil
 	}
@@ -544,8 +734,24 @@ func offerMergeExecution(manager *internal.Manager, mergebackWorktreeName, sourc
 	// Execute the merge in the worktree
 	if err := performMerge(worktreePath, sourceBranch, targetBranch); err != nil {
 		if isMergeConflict(err) {
-			PrintInfo("Merge conflicts detected. Please resolve conflicts manually in worktree '%s'", mergebackWorktreeName)
+			PrintInfo("Merge conflicts detected in worktree '%s'", mergebackWorktreeName)
+			if files, err := manager.ListConflictedFiles(worktreePath); err != nil {
+				PrintVerbose("Could not list conflicted files: %v", err)
+			} else {
+				for _, file := range files {
+					PrintInfo("  • %s", file)
+				}
+			}
+			if confirm("Abort the merge and leave the worktree clean?") {
+				if err := manager.AbortMerge(worktreePath); err != nil {
+					return fmt.Errorf("failed to abort merge: %w", err)
+				}
+				PrintInfo("Merge aborted. Worktree '%s' is back on '%s'", mergebackWorktreeName, mergeBranch)
+				return nil
+			}
+			PrintInfo("Please resolve conflicts manually in worktree '%s'", mergebackWorktreeName)
 			PrintInfo("After resolving conflicts, use: git add . && git commit")
+			PrintInfo("To give up instead, use: gbm mergeback --abort %s", mergebackWorktreeName)
 			return nil
 		}
 		return fmt.Errorf("merge failed: %w", err)
@@ -557,27 +763,38 @@ func offerMergeExecution(manager *internal.Manager, mergebackWorktreeName, sourc
 	return nil
 }
 
-// getCommitsToMerge gets the list of commits that will be merged
-func getCommitsToMerge(repoRoot, targetBranch, sourceBranch string) ([]string, error) {
-	// Verify the source branch exists
-	if _, err := internal.ExecGitCommand(repoRoot, "rev-parse", "--verify", sourceBranch); err != nil {
-		// Try with origin/ prefix if not found
-		originBranch := "origin/" + sourceBranch
-		if _, err := internal.ExecGitCommand(repoRoot, "rev-parse", "--verify", originBranch); err != nil {
-			return nil, fmt.Errorf("could not find source branch %s or %s", sourceBranch, originBranch)
+// printMergeDiffstat shows the files touched by the incoming commits with their line counts
+func printMergeDiffstat(files []internal.FileChange) {
+	const maxFiles = 10
+
+	var additions, deletions int
+	for _, file := range files {
+		additions += file.Additions
+		deletions += file.Deletions
+	}
+
+	fmt.Printf("\n%s\n", internal.FormatSubHeader("Files Changed:"))
+	for i, file := range files {
+		if i == maxFiles {
+			fmt.Printf("  ... and %d more files\n", len(files)-maxFiles)
+			break
 		}
-		sourceBranch = originBranch
+		fmt.Printf("  • %s %s\n", file.Path,
+			internal.FormatSubtle(fmt.Sprintf("(+%d -%d)", file.Additions, file.Deletions)))
 	}
+	fmt.Printf("  %d files changed, %s, %s\n", len(files),
+		internal.StatusOKStyle.Render(fmt.Sprintf("+%d", additions)), internal.StatusErrorStyle.Render(fmt.Sprintf("-%d", deletions)))
+}
 
-	// Get commits that are in source but not in target
-	var targetRef string
-	if strings.Contains(targetBranch, "/") {
-		targetRef = targetBranch
-	} else {
-		targetRef = "origin/" + targetBranch
+// getCommitsToMerge gets the list of commits that will be merged
+func getCommitsToMerge(repoRoot, targetBranch, sourceBranch string) ([]string, error) {
+	targetRef, sourceRef, err := resolveMergeRefs(repoRoot, targetBranch, sourceBranch)
+	if err != nil {
+		return nil, err
 	}
 
-	output, err := internal.ExecGitCommand(repoRoot, "log", "--oneline", fmt.Sprintf("%s..%s", targetRef, sourceBranch))
+	// Get commits that are in source but not in target
+	output, err := internal.ExecGitCommand(repoRoot, "log", "--oneline", fmt.Sprintf("%s..%s", targetRef, sourceRef))
 	if err != nil {
 		return nil, fmt.Errorf("failed to get commit list: %w", err)
 	}
@@ -590,6 +807,38 @@ func getCommitsToMerge(repoRoot, targetBranch, sourceBranch string) ([]string, e
 	return lines, nil
 }
 
+// getFilesToMerge gets the files changed by the commits that will be merged
+func getFilesToMerge(manager *internal.Manager, repoRoot, targetBranch, sourceBranch string) ([]internal.FileChange, error) {
+	targetRef, sourceRef, err := resolveMergeRefs(repoRoot, targetBranch, sourceBranch)
+	if err != nil {
+		return nil, err
+	}
+
+	// Three dots diffs against the merge base, so only the incoming side is shown
+	return manager.GetRangeFileChanges(fmt.Sprintf("%s...%s", targetRef, sourceRef))
+}
+
+// resolveMergeRefs resolves the refs to compare when merging sourceBranch into targetBranch,
+// falling back to the origin/ remote-tracking branch when the source is not a local branch
+func resolveMergeRefs(repoRoot, targetBranch, sourceBranch string) (string, string, error) {
+	// Verify the source branch exists
+	if _, err := internal.ExecGitCommand(repoRoot, "rev-parse", "--verify", sourceBranch); err != nil {
+		// Try with origin/ prefix if not found
+		originBranch := "origin/" + sourceBranch
+		if _, err := internal.ExecGitCommand(repoRoot, "rev-parse", "--verify", originBranch); err != nil {
+			return "", "", fmt.Errorf("could not find source branch %s or %s", sourceBranch, originBranch)
+		}
+		sourceBranch = originBranch
+	}
+
+	targetRef := "origin/" + targetBranch
+	if strings.Contains(targetBranch, "/") {
+		targetRef = targetBranch
+	}
+
+	return targetRef, sourceBranch, nil
+}
+
 // performMerge executes the actual merge operation
 // sourceBranch is the branch being merged FROM (e.g., "production")
 // targetBranch is the branch being merged INTO (e.g., "preview")
@@ -15,15 +15,21 @@ import (
 )
 
 func TestGetSmartMergebackCompletions(t *testing.T) {
+	// Run outside any repository so the test never touches this checkout's .gbm state
+	t.Chdir(t.TempDir())
+
 	t.Run("function exists and handles no manager gracefully", func(t *testing.T) {
 		// This test ensures the function doesn't panic when manager creation fails
-		completions := getSmartMergebackCompletions()
+		completions := getSmartMergebackCompletions(internal.ActivityOptions{})
 		assert.NotNil(t, completions)
 		// Should return empty slice when no activities found
 	})
 }
 
 func TestMergebackValidArgsFunction(t *testing.T) {
+	// Run outside any repository so the test never touches this checkout's .gbm state
+	t.Chdir(t.TempDir())
+
 	t.Run("returns completions for first argument", func(t *testing.T) {
 		// Test the ValidArgsFunction for the mergeback command
 		cmd := newMergebackCommand()
@@ -230,7 +236,7 @@ func TestCompletionIntegration(t *testing.T) {
 
 	// Test smart completions
 	t.Run("smart completions with activity", func(t *testing.T) {
-		completions := getSmartMergebackCompletions()
+		completions := getSmartMergebackCompletions(internal.ActivityOptions{})
 
 		// In test environment, completion function may return empty if no valid manager/config
 		// Just verify it doesn't panic and returns a valid slice
@@ -250,7 +256,7 @@ func TestCompletionIntegration(t *testing.T) {
 
 	// Test that completions are formatted correctly
 	t.Run("completion formatting", func(t *testing.T) {
-		completions := getSmartMergebackCompletions()
+		completions := getSmartMergebackCompletions(internal.ActivityOptions{})
 
 		for _, completion := range completions {
 			if strings.Contains(completion, "SHOP-456") {
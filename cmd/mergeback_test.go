@@ -1,9 +1,11 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -97,36 +99,89 @@ func TestMergebackCommand(t *testing.T) {
 	}
 }
 
+func TestMergebackCommand_Since(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		errorMsg string
+	}{
+		{
+			name:     "since drives auto-detection at run time",
+			args:     []string{"--since", "1.day.ago"},
+			errorMsg: "no recent hotfix or merge activity found that needs mergeback since 1.day.ago",
+		},
+		{
+			name:     "since cannot be combined with chain",
+			args:     []string{"--since", "1.day.ago", "--chain"},
+			errorMsg: "none of the others can be",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := testutils.NewGitTestRepo(t, testutils.WithDefaultBranch("main"))
+			require.NoError(t, repo.CreateBranch("production", "Production content"))
+			require.NoError(t, repo.SwitchToBranch("main"))
+			t.Chdir(repo.GetLocalPath())
+
+			cmd := newMergebackCommand()
+			cmd.SetArgs(tt.args)
+			cmd.SilenceUsage = true
+
+			err := cmd.Execute()
+			assert.ErrorContains(t, err, tt.errorMsg)
+		})
+	}
+}
+
 func TestMergebackBranchNaming(t *testing.T) {
 	tests := []struct {
 		name           string
+		prefix         string
 		worktreeName   string
 		targetWorktree string
 		expected       string
 	}{
 		{
 			name:           "simple worktree name with target",
+			prefix:         "merge",
 			worktreeName:   "fix-auth",
 			targetWorktree: "preview",
 			expected:       "merge/fix-auth_preview",
 		},
 		{
 			name:           "worktree name with target",
+			prefix:         "merge",
 			worktreeName:   "PROJECT-123",
 			targetWorktree: "main",
 			expected:       "merge/PROJECT-123_main",
 		},
 		{
 			name:           "uppercase target worktree",
+			prefix:         "merge",
 			worktreeName:   "hotfix",
 			targetWorktree: "PREVIEW",
 			expected:       "merge/hotfix_preview",
 		},
+		{
+			name:           "custom prefix",
+			prefix:         "team/mergeback",
+			worktreeName:   "fix-auth",
+			targetWorktree: "preview",
+			expected:       "team/mergeback/fix-auth_preview",
+		},
+		{
+			name:           "empty prefix",
+			prefix:         "",
+			worktreeName:   "fix-auth",
+			targetWorktree: "preview",
+			expected:       "fix-auth_preview",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := fmt.Sprintf("merge/%s_%s", tt.worktreeName, strings.ToLower(tt.targetWorktree))
+			result := internal.MergebackBranchName(tt.prefix, tt.worktreeName, tt.targetWorktree)
 			assert.Equal(t, tt.expected, result)
 		})
 	}
@@ -386,7 +441,7 @@ func TestFindMergeTargetBranchAndWorktree(t *testing.T) {
 		require.NotNil(t, manager, "Manager should not be nil even if config is missing")
 
 		// Test that the function doesn't panic and returns something reasonable
-		branch, worktree, err := findMergeTargetBranchAndWorktree(manager)
+		_, branch, worktree, _, err := findMergeTargetBranchAndWorktree(manager)
 
 		// Should not panic and should return some default values
 		assert.NoError(t, err)
@@ -399,6 +454,26 @@ func TestFindMergeTargetBranchAndWorktree(t *testing.T) {
 	})
 }
 
+func TestRenderMergebackDryRun(t *testing.T) {
+	output := renderMergebackDryRun(mergebackDryRun{
+		SourceWorktree: "production",
+		SourceBranch:   "production",
+		TargetWorktree: "preview",
+		TargetBranch:   "preview",
+		WorktreeName:   "MERGE_production_preview",
+		BranchName:     "merge/production_preview",
+		Commits:        []string{"abc1234 hotfix: SHOP-456 Fix auth", "def5678 hotfix: SHOP-457 Fix cache"},
+	})
+
+	assert.Contains(t, output, "production (production)")
+	assert.Contains(t, output, "preview (preview)")
+	assert.Contains(t, output, "MERGE_production_preview")
+	assert.Contains(t, output, "merge/production_preview")
+	assert.Contains(t, output, "2 commits")
+	assert.Contains(t, output, "• abc1234 hotfix: SHOP-456 Fix auth")
+	assert.Contains(t, output, "• def5678 hotfix: SHOP-457 Fix cache")
+}
+
 func TestMergebackIntegration(t *testing.T) {
 	// Create a test repository with proper GBM config and mergeback chain
 	repo := testutils.NewGitTestRepo(t, testutils.WithDefaultBranch("main"))
@@ -498,3 +573,255 @@ func TestMergebackIntegration(t *testing.T) {
 		assert.True(t, found, "Expected merge branch not found. Local branches: %v", localBranches)
 	})
 }
+
+func TestHandleMergebackChain(t *testing.T) {
+	plan := []internal.MergebackStep{
+		{SourceWorktree: "prod", SourceBranch: "production", TargetWorktree: "preview", TargetBranch: "preview", Commits: 2},
+		{SourceWorktree: "preview", SourceBranch: "preview", TargetWorktree: "main", TargetBranch: "main"},
+	}
+
+	tests := []struct {
+		name        string
+		confirm     bool
+		mockSetup   func() *mergebackChainRunnerMock
+		assertMocks func(t *testing.T, mock *mergebackChainRunnerMock)
+		assertErr   func(t *testing.T, err error)
+	}{
+		{
+			name:    "nothing to merge",
+			confirm: true,
+			mockSetup: func() *mergebackChainRunnerMock {
+				return &mergebackChainRunnerMock{
+					PlanMergebackChainFunc: func() ([]internal.MergebackStep, error) {
+						return nil, nil
+					},
+				}
+			},
+			assertMocks: func(t *testing.T, mock *mergebackChainRunnerMock) {
+				assert.Len(t, mock.MergebackAllCalls(), 0)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:    "declined confirmation does not merge",
+			confirm: false,
+			mockSetup: func() *mergebackChainRunnerMock {
+				return &mergebackChainRunnerMock{
+					PlanMergebackChainFunc: func() ([]internal.MergebackStep, error) {
+						return plan, nil
+					},
+				}
+			},
+			assertMocks: func(t *testing.T, mock *mergebackChainRunnerMock) {
+				assert.Len(t, mock.MergebackAllCalls(), 0)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:    "runs the full plan",
+			confirm: true,
+			mockSetup: func() *mergebackChainRunnerMock {
+				return &mergebackChainRunnerMock{
+					PlanMergebackChainFunc: func() ([]internal.MergebackStep, error) {
+						return plan, nil
+					},
+					MergebackAllFunc: func(steps []internal.MergebackStep) (*internal.MergebackChainResult, error) {
+						return &internal.MergebackChainResult{Completed: steps}, nil
+					},
+				}
+			},
+			assertMocks: func(t *testing.T, mock *mergebackChainRunnerMock) {
+				calls := mock.MergebackAllCalls()
+				assert.Len(t, calls, 1)
+				assert.Equal(t, plan, calls[0].Steps)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:    "conflict stops the chain with an error",
+			confirm: true,
+			mockSetup: func() *mergebackChainRunnerMock {
+				return &mergebackChainRunnerMock{
+					PlanMergebackChainFunc: func() ([]internal.MergebackStep, error) {
+						return plan, nil
+					},
+					MergebackAllFunc: func(steps []internal.MergebackStep) (*internal.MergebackChainResult, error) {
+						return &internal.MergebackChainResult{Conflicted: &steps[0], ConflictWorktree: "MERGE_prod_preview"},
+							fmt.Errorf("%w in worktree 'MERGE_prod_preview'", internal.ErrMergebackConflict)
+					},
+				}
+			},
+			assertMocks: func(t *testing.T, mock *mergebackChainRunnerMock) {
+				assert.Len(t, mock.MergebackAllCalls(), 1)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, internal.ErrMergebackConflict)
+				assert.ErrorContains(t, err, "mergeback chain stopped")
+			},
+		},
+		{
+			name:    "other failures are returned",
+			confirm: true,
+			mockSetup: func() *mergebackChainRunnerMock {
+				return &mergebackChainRunnerMock{
+					PlanMergebackChainFunc: func() ([]internal.MergebackStep, error) {
+						return plan, nil
+					},
+					MergebackAllFunc: func(steps []internal.MergebackStep) (*internal.MergebackChainResult, error) {
+						return &internal.MergebackChainResult{}, errors.New("failed to add mergeback worktree")
+					},
+				}
+			},
+			assertMocks: func(t *testing.T, mock *mergebackChainRunnerMock) {
+				assert.Len(t, mock.MergebackAllCalls(), 1)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "mergeback chain failed")
+			},
+		},
+		{
+			name:    "planning failure is returned",
+			confirm: true,
+			mockSetup: func() *mergebackChainRunnerMock {
+				return &mergebackChainRunnerMock{
+					PlanMergebackChainFunc: func() ([]internal.MergebackStep, error) {
+						return nil, errors.New("no gbm.branchconfig.yaml loaded")
+					},
+				}
+			},
+			assertMocks: func(t *testing.T, mock *mergebackChainRunnerMock) {
+				assert.Len(t, mock.MergebackAllCalls(), 0)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "failed to plan mergeback chain")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := tt.mockSetup()
+			err := handleMergebackChain(mock, func(string) bool { return tt.confirm })
+
+			tt.assertMocks(t, mock)
+			tt.assertErr(t, err)
+		})
+	}
+}
+
+func TestHandleMergebackAbort(t *testing.T) {
+	tests := []struct {
+		name        string
+		mockSetup   func() *mergeAborterMock
+		assertMocks func(t *testing.T, mock *mergeAborterMock)
+		assertErr   func(t *testing.T, err error)
+	}{
+		{
+			name: "success - aborts in-progress merge",
+			mockSetup: func() *mergeAborterMock {
+				return &mergeAborterMock{
+					GetWorktreePathFunc:   func(worktreeName string) (string, error) { return "/repo/worktrees/" + worktreeName, nil },
+					IsMergeInProgressFunc: func(worktreePath string) (bool, error) { return true, nil },
+					AbortMergeFunc:        func(worktreePath string) error { return nil },
+				}
+			},
+			assertMocks: func(t *testing.T, mock *mergeAborterMock) {
+				calls := mock.AbortMergeCalls()
+				require.Len(t, calls, 1)
+				assert.Equal(t, "/repo/worktrees/MERGE_prod_preview", calls[0].WorktreePath)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "error - no merge in progress",
+			mockSetup: func() *mergeAborterMock {
+				return &mergeAborterMock{
+					GetWorktreePathFunc:   func(worktreeName string) (string, error) { return "/repo/worktrees/" + worktreeName, nil },
+					IsMergeInProgressFunc: func(worktreePath string) (bool, error) { return false, nil },
+				}
+			},
+			assertMocks: func(t *testing.T, mock *mergeAborterMock) {
+				assert.Len(t, mock.AbortMergeCalls(), 0)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "no merge in progress in worktree 'MERGE_prod_preview'")
+			},
+		},
+		{
+			name: "error - worktree does not exist",
+			mockSetup: func() *mergeAborterMock {
+				return &mergeAborterMock{
+					GetWorktreePathFunc: func(worktreeName string) (string, error) {
+						return "", fmt.Errorf("worktree directory '%s' does not exist", worktreeName)
+					},
+				}
+			},
+			assertMocks: func(t *testing.T, mock *mergeAborterMock) {
+				assert.Len(t, mock.IsMergeInProgressCalls(), 0)
+				assert.Len(t, mock.AbortMergeCalls(), 0)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "does not exist")
+			},
+		},
+		{
+			name: "error - abort fails",
+			mockSetup: func() *mergeAborterMock {
+				return &mergeAborterMock{
+					GetWorktreePathFunc:   func(worktreeName string) (string, error) { return "/repo/worktrees/" + worktreeName, nil },
+					IsMergeInProgressFunc: func(worktreePath string) (bool, error) { return true, nil },
+					AbortMergeFunc:        func(worktreePath string) error { return errors.New("git merge --abort failed") },
+				}
+			},
+			assertMocks: func(t *testing.T, mock *mergeAborterMock) {
+				assert.Len(t, mock.AbortMergeCalls(), 1)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "failed to abort merge")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := tt.mockSetup()
+			err := handleMergebackAbort(mock, "MERGE_prod_preview")
+
+			tt.assertMocks(t, mock)
+			tt.assertErr(t, err)
+		})
+	}
+}
+
+func TestOfferMergeExecution_RelocatedWorktreePrefix(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t, testutils.WithDefaultBranch("main"))
+	defer repo.Cleanup()
+	require.NoError(t, repo.CreateBranch("prod", "prod fix"))
+	t.Chdir(repo.GetLocalPath())
+
+	require.NoError(t, os.MkdirAll(filepath.Join(repo.GetLocalPath(), internal.DefaultConfigDirname), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(repo.GetLocalPath(), internal.DefaultConfigDirname, internal.DefaultConfigFilename),
+		[]byte("[settings]\nworktree_prefix = \"../gbm-worktrees\"\n"), 0o644))
+
+	manager, err := internal.NewManager(repo.GetLocalPath())
+	require.NoError(t, err)
+	require.NoError(t, manager.AddWorktree("MERGE_prod_main", "merge/prod_main", true, "main"))
+
+	err = offerMergeExecution(manager, "MERGE_prod_main", "prod", "prod", "main", func(string) bool { return true })
+	require.NoError(t, err)
+
+	// The merge ran in the mergeback worktree under the relocated prefix
+	worktreePath, err := manager.GetWorktreePath("MERGE_prod_main")
+	require.NoError(t, err)
+	content, err := os.ReadFile(filepath.Join(worktreePath, "content.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "prod fix", string(content))
+}
@@ -4,9 +4,11 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 	"testing"
 
+	"gbm/internal"
 	"gbm/internal/testutils"
 
 	"github.com/stretchr/testify/assert"
@@ -171,7 +173,7 @@ func TestFindMergeTargetWithTreeStructure(t *testing.T) {
 			require.NoError(t, err)
 
 			// Test findMergeTargetBranchAndWorktree
-			branch, worktree, err := findMergeTargetBranchAndWorktree(manager)
+			_, branch, worktree, _, err := findMergeTargetBranchAndWorktree(manager)
 
 			if tt.expectError {
 				assert.Error(t, err)
@@ -236,7 +238,7 @@ func TestMergebackNamingWithTreeStructure(t *testing.T) {
 	require.NoError(t, err)
 
 	// Find merge target (should be production -> preview)
-	targetBranch, targetWorktree, err := findMergeTargetBranchAndWorktree(manager)
+	_, targetBranch, targetWorktree, _, err := findMergeTargetBranchAndWorktree(manager)
 	require.NoError(t, err)
 
 	// Should target preview branch/worktree (immediate parent of production)
@@ -260,6 +262,40 @@ func TestMergebackNamingWithTreeStructure(t *testing.T) {
 	assert.Equal(t, "MERGE_INGSVC-5638_preview", expectedWorktreeName)
 }
 
+func TestMergebackDryRunWithTreeStructure(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t, testutils.WithDefaultBranch("master"))
+	defer repo.Cleanup()
+
+	worktrees := map[string]testutils.WorktreeConfig{
+		"master": {
+			Branch:      "master",
+			Description: "Master branch",
+		},
+		"production": {
+			Branch:      "production-2025-05-1",
+			MergeInto:   "master",
+			Description: "Production branch",
+		},
+	}
+	require.NoError(t, repo.CreateGBMConfig(worktrees))
+	require.NoError(t, repo.CreateBranch("production-2025-05-1", "Production content"))
+	require.NoError(t, repo.WriteFile("production-change.txt", "Production change"))
+	require.NoError(t, repo.CommitChangesWithForceAdd("Add production change"))
+
+	originalDir, _ := os.Getwd()
+	defer func() { _ = os.Chdir(originalDir) }()
+	require.NoError(t, os.Chdir(repo.GetLocalPath()))
+
+	cmd := newRootCommand()
+	cmd.SetArgs([]string{"mergeback", "--dry-run"})
+	require.NoError(t, cmd.Execute())
+
+	assert.NoDirExists(t, filepath.Join(repo.GetLocalPath(), "worktrees", "MERGE_production_master"))
+	output, err := internal.ExecGitCommand(repo.GetLocalPath(), "branch", "--list", "merge/*")
+	require.NoError(t, err)
+	assert.Empty(t, strings.TrimSpace(string(output)))
+}
+
 func TestMergebackNamingProductionToMaster(t *testing.T) {
 	// Test the specific case mentioned in the issue: production -> master should use "_master" suffix
 
@@ -306,7 +342,7 @@ func TestMergebackNamingProductionToMaster(t *testing.T) {
 	require.NoError(t, err)
 
 	// Find merge target (should be production -> master)
-	targetBranch, targetWorktree, err := findMergeTargetBranchAndWorktree(manager)
+	_, targetBranch, targetWorktree, _, err := findMergeTargetBranchAndWorktree(manager)
 	require.NoError(t, err)
 
 	// Should target master branch/worktree
@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	"gbm/internal"
+
+	"github.com/spf13/cobra"
+)
+
+//go:generate go run github.com/matryer/moq@latest -out ./autogen_worktreeOpener.go . worktreeOpener
+
+// worktreeOpener interface abstracts the Manager operations needed to open a worktree in an editor
+type worktreeOpener interface {
+	GetWorktreePath(worktreeName string) (string, error)
+	GetConfig() *internal.Config
+}
+
+// editorLauncher runs an editor command with the worktree path as its argument
+type editorLauncher func(command, worktreePath string) error
+
+// editorEnvVars are checked in order before falling back to settings.editor
+var editorEnvVars = []string{"GBM_EDITOR", "VISUAL", "EDITOR"}
+
+func newOpenCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open <worktree-name>",
+		Short: "Open a worktree in your editor or IDE",
+		Long: `Open a worktree in your editor, passing the worktree path as its argument.
+
+The editor is taken from $GBM_EDITOR, $VISUAL or $EDITOR, in that order, falling back
+to settings.editor in .gbm/config.toml. With --ide, settings.ide (e.g. code, idea) is
+used instead when it is set. Like git, the command is run through the shell, so it may
+include arguments such as "code --wait".
+
+Examples:
+  gbm open dev
+  gbm open feat-auth --ide`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			useIDE, _ := cmd.Flags().GetBool("ide")
+
+			manager, err := createInitializedManager()
+			if err != nil {
+				return err
+			}
+
+			return handleOpen(manager, args[0], useIDE, launchEditor)
+		},
+	}
+
+	cmd.Flags().Bool("ide", false, "open in the IDE from settings.ide instead of the editor")
+
+	cmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) != 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return getWorktreeCompletionsWithManager(), cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+func handleOpen(opener worktreeOpener, worktreeName string, useIDE bool, launch editorLauncher) error {
+	worktreePath, err := opener.GetWorktreePath(worktreeName)
+	if err != nil {
+		return err
+	}
+
+	command, err := resolveEditorCommand(opener.GetConfig().Settings, useIDE)
+	if err != nil {
+		return err
+	}
+
+	PrintVerbose("Opening %s with %s", worktreePath, command)
+	if err := launch(command, worktreePath); err != nil {
+		return fmt.Errorf("failed to open worktree '%s' with %s: %w", worktreeName, command, err)
+	}
+
+	return nil
+}
+
+// resolveEditorCommand picks the command used to open a worktree
+func resolveEditorCommand(settings internal.ConfigSettings, useIDE bool) (string, error) {
+	if useIDE {
+		if ide := strings.TrimSpace(settings.IDE); ide != "" {
+			return ide, nil
+		}
+		PrintVerbose("settings.ide is not set, falling back to the editor")
+	}
+
+	for _, name := range editorEnvVars {
+		if editor := strings.TrimSpace(os.Getenv(name)); editor != "" {
+			return editor, nil
+		}
+	}
+
+	if editor := strings.TrimSpace(settings.Editor); editor != "" {
+		return editor, nil
+	}
+
+	return "", errors.New("no editor configured: set $GBM_EDITOR, $VISUAL or $EDITOR, or settings.editor in .gbm/config.toml")
+}
+
+// launchEditor runs the editor through the shell, the way git runs $EDITOR, and waits for it to exit
+func launchEditor(command, worktreePath string) error {
+	cmd := exec.Command("sh", "-c", command+` "$@"`, command, worktreePath)
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
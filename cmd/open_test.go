@@ -0,0 +1,134 @@
+package cmd
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"gbm/internal"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveEditorCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		settings internal.ConfigSettings
+		useIDE   bool
+		expected string
+		errMsg   string
+	}{
+		{
+			name:     "GBM_EDITOR wins over VISUAL and EDITOR",
+			env:      map[string]string{"GBM_EDITOR": "nvim", "VISUAL": "code", "EDITOR": "vi"},
+			expected: "nvim",
+		},
+		{
+			name:     "VISUAL before EDITOR",
+			env:      map[string]string{"VISUAL": "code --wait", "EDITOR": "vi"},
+			expected: "code --wait",
+		},
+		{
+			name:     "environment wins over settings.editor",
+			env:      map[string]string{"EDITOR": "vi"},
+			settings: internal.ConfigSettings{Editor: "nano"},
+			expected: "vi",
+		},
+		{
+			name:     "falls back to settings.editor",
+			settings: internal.ConfigSettings{Editor: "nano"},
+			expected: "nano",
+		},
+		{
+			name:     "ide uses settings.ide",
+			env:      map[string]string{"EDITOR": "vi"},
+			settings: internal.ConfigSettings{IDE: "idea"},
+			useIDE:   true,
+			expected: "idea",
+		},
+		{
+			name:     "ide falls back to the editor when not configured",
+			env:      map[string]string{"EDITOR": "vi"},
+			useIDE:   true,
+			expected: "vi",
+		},
+		{
+			name:   "nothing configured",
+			errMsg: "no editor configured",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range editorEnvVars {
+				t.Setenv(name, tt.env[name])
+			}
+
+			command, err := resolveEditorCommand(tt.settings, tt.useIDE)
+			if tt.errMsg != "" {
+				assert.ErrorContains(t, err, tt.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, command)
+		})
+	}
+}
+
+func TestHandleOpen(t *testing.T) {
+	for _, name := range editorEnvVars {
+		t.Setenv(name, "")
+	}
+	config := &internal.Config{Settings: internal.ConfigSettings{Editor: "nvim", IDE: "code"}}
+
+	t.Run("launches the editor with the worktree path", func(t *testing.T) {
+		mock := &worktreeOpenerMock{
+			GetWorktreePathFunc: func(worktreeName string) (string, error) { return "/repo/worktrees/" + worktreeName, nil },
+			GetConfigFunc:       func() *internal.Config { return config },
+		}
+
+		var gotCommand, gotPath string
+		err := handleOpen(mock, "dev", true, func(command, worktreePath string) error {
+			gotCommand, gotPath = command, worktreePath
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "code", gotCommand)
+		assert.Equal(t, "/repo/worktrees/dev", gotPath)
+	})
+
+	t.Run("unknown worktree", func(t *testing.T) {
+		mock := &worktreeOpenerMock{
+			GetWorktreePathFunc: func(worktreeName string) (string, error) {
+				return "", errors.New("worktree directory 'missing' does not exist")
+			},
+		}
+
+		err := handleOpen(mock, "missing", false, func(command, worktreePath string) error {
+			t.Fatal("editor should not be launched")
+			return nil
+		})
+		assert.ErrorContains(t, err, "does not exist")
+	})
+
+	t.Run("editor failure is reported", func(t *testing.T) {
+		mock := &worktreeOpenerMock{
+			GetWorktreePathFunc: func(worktreeName string) (string, error) { return "/repo/worktrees/dev", nil },
+			GetConfigFunc:       func() *internal.Config { return config },
+		}
+
+		err := handleOpen(mock, "dev", false, func(command, worktreePath string) error {
+			return errors.New("exit status 127")
+		})
+		assert.ErrorContains(t, err, "failed to open worktree 'dev' with nvim")
+	})
+}
+
+func TestLaunchEditor_PassesPathAsSingleArgument(t *testing.T) {
+	target := filepath.Join(t.TempDir(), "path with spaces")
+
+	require.NoError(t, launchEditor("mkdir -p", target))
+	assert.DirExists(t, target)
+}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+)
+
+//go:generate go run github.com/matryer/moq@latest -out ./autogen_worktreePathResolver.go . worktreePathResolver
+
+// worktreePathResolver interface abstracts the Manager operations needed to resolve a worktree path
+type worktreePathResolver interface {
+	GetWorktreePath(worktreeName string) (string, error)
+}
+
+func newPathCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "path <worktree-name>",
+		Short: "Print the absolute path of a worktree",
+		Long: `Print the absolute path of a worktree and nothing else, for use in scripts and
+shell functions:
+
+  cd "$(gbm path dev)"
+
+See 'gbm shell-init' for a gbmcd function that wraps this.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manager, err := createInitializedManager()
+			if err != nil {
+				return err
+			}
+
+			return handlePath(manager, args[0])
+		},
+	}
+
+	cmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) != 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return getWorktreeCompletionsWithManager(), cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+func handlePath(resolver worktreePathResolver, worktreeName string) error {
+	worktreePath, err := resolver.GetWorktreePath(worktreeName)
+	if err != nil {
+		return err
+	}
+
+	absPath, err := filepath.Abs(worktreePath)
+	if err != nil {
+		return fmt.Errorf("failed to resolve path for worktree '%s': %w", worktreeName, err)
+	}
+
+	fmt.Println(absPath)
+	return nil
+}
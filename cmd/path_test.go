@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandlePath(t *testing.T) {
+	tests := []struct {
+		name         string
+		worktreeName string
+		mockSetup    func() *worktreePathResolverMock
+		assertErr    func(t *testing.T, err error)
+	}{
+		{
+			name:         "prints worktree path",
+			worktreeName: "dev",
+			mockSetup: func() *worktreePathResolverMock {
+				return &worktreePathResolverMock{
+					GetWorktreePathFunc: func(worktreeName string) (string, error) {
+						return "/repo/worktrees/" + worktreeName, nil
+					},
+				}
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:         "unknown worktree",
+			worktreeName: "missing",
+			mockSetup: func() *worktreePathResolverMock {
+				return &worktreePathResolverMock{
+					GetWorktreePathFunc: func(worktreeName string) (string, error) {
+						return "", errors.New("worktree directory 'missing' does not exist")
+					},
+				}
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "does not exist")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := tt.mockSetup()
+			tt.assertErr(t, handlePath(mock, tt.worktreeName))
+			assert.Len(t, mock.GetWorktreePathCalls(), 1)
+			assert.Equal(t, tt.worktreeName, mock.GetWorktreePathCalls()[0].WorktreeName)
+		})
+	}
+}
+
+func TestShellInitScript(t *testing.T) {
+	for _, shell := range []string{"bash", "zsh", "fish"} {
+		t.Run(shell, func(t *testing.T) {
+			script, err := shellInitScript(shell)
+			assert.NoError(t, err)
+			assert.Contains(t, script, "gbmcd")
+			assert.Contains(t, script, "gbm path")
+		})
+	}
+
+	_, err := shellInitScript("powershell")
+	assert.ErrorContains(t, err, "unsupported shell")
+}
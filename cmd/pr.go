@@ -0,0 +1,147 @@
+package cmd
+
+import (
+	"fmt"
+	"os/exec"
+	"runtime"
+	"strings"
+	"time"
+
+	"gbm/internal"
+
+	"github.com/spf13/cobra"
+)
+
+//go:generate go run github.com/matryer/moq@latest -out ./autogen_worktreePRCreator.go . worktreePRCreator
+
+// worktreePRCreator interface abstracts the Manager operations needed to open a pull request for a worktree
+type worktreePRCreator interface {
+	GetConfig() *internal.Config
+	GetState() *internal.State
+	GetGBMConfig() *internal.GBMConfig
+	GetWorktreePath(worktreeName string) (string, error)
+	GetWorktreeCurrentBranch(worktreePath string) (string, error)
+	VerifyWorktreeRef(ref string, worktreePath string) (bool, error)
+	GetWorktreeMergeBase(worktreePath, baseBranch string) (string, time.Time, error)
+	PushWorktree(worktreeName string) error
+	GetRepoIdentity() (*internal.RepoIdentity, error)
+}
+
+// browserLauncher opens a URL in the user's browser
+type browserLauncher func(url string) error
+
+func newPRCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pr <worktree-name>",
+		Short: "Push a worktree's branch and open a pull request for it",
+		Long: `Push the branch checked out in a worktree, then open the provider's "create pull request"
+page for it in your browser.
+
+The repository is taken from the default remote's URL; GitHub and GitLab are supported. The
+pull request targets the worktree's base branch (its base_branch in gbm.branchconfig.yaml, the
+one recorded when it was added, or the closest of settings.candidate_branches) unless --base
+is given.
+
+Examples:
+  gbm pr feat-auth                  # Push and open a pull request against the base branch
+  gbm pr feat-auth --base develop   # Target develop instead
+  gbm pr feat-auth --print          # Only print the URL, e.g. for piping`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			base, _ := cmd.Flags().GetString("base")
+			printOnly, _ := cmd.Flags().GetBool("print")
+
+			manager, err := createInitializedManager()
+			if err != nil {
+				return err
+			}
+
+			launch := openBrowser
+			if printOnly {
+				launch = nil
+			}
+			return handlePR(manager, args[0], base, launch)
+		},
+	}
+
+	cmd.Flags().String("base", "", "branch to merge into (defaults to the worktree's base branch)")
+	cmd.Flags().Bool("print", false, "only print the pull request URL instead of opening it")
+
+	cmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) != 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return getWorktreeCompletionsWithManager(), cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+// handlePR pushes the worktree's branch and opens its pull request URL. With a nil launcher the
+// URL is printed to stdout instead.
+func handlePR(creator worktreePRCreator, worktreeName, base string, launch browserLauncher) error {
+	worktreePath, err := creator.GetWorktreePath(worktreeName)
+	if err != nil {
+		return err
+	}
+
+	branch, err := creator.GetWorktreeCurrentBranch(worktreePath)
+	if err != nil {
+		return fmt.Errorf("failed to get branch of worktree '%s': %w", worktreeName, err)
+	}
+	if branch == "" || branch == "HEAD" {
+		return fmt.Errorf("worktree '%s' is not on a branch", worktreeName)
+	}
+
+	if base == "" {
+		base = resolveWorktreeBaseBranch(worktreeName, worktreePath, creator)
+		if base == "" {
+			return fmt.Errorf("could not determine the base branch of worktree '%s'; use --base", worktreeName)
+		}
+	}
+	// A recorded base may be a remote-tracking ref such as origin/develop
+	base = strings.TrimPrefix(base, creator.GetConfig().Settings.DefaultRemote+"/")
+	if base == branch {
+		return fmt.Errorf("worktree '%s' is on its base branch '%s'; nothing to open a pull request for", worktreeName, base)
+	}
+
+	identity, err := creator.GetRepoIdentity()
+	if err != nil {
+		return err
+	}
+	prURL, err := identity.PullRequestURL(base, branch)
+	if err != nil {
+		return err
+	}
+
+	PrintInfo("Pushing worktree '%s'...", worktreeName)
+	if err := creator.PushWorktree(worktreeName); err != nil {
+		return fmt.Errorf("failed to push worktree '%s': %w", worktreeName, err)
+	}
+
+	if launch == nil {
+		fmt.Println(prURL)
+		return nil
+	}
+
+	PrintInfo("Opening %s", prURL)
+	if err := launch(prURL); err != nil {
+		return fmt.Errorf("failed to open browser: %w (open %s manually)", err, prURL)
+	}
+
+	return nil
+}
+
+// openBrowser opens a URL with the platform's default handler without waiting for the browser
+func openBrowser(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+	return cmd.Start()
+}
@@ -0,0 +1,176 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"gbm/internal"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newPRCreatorMock(stateBase, branch string) *worktreePRCreatorMock {
+	return &worktreePRCreatorMock{
+		GetConfigFunc: func() *internal.Config {
+			config := internal.DefaultConfig()
+			config.Settings.CandidateBranches = []string{"main", "develop"}
+			return config
+		},
+		GetGBMConfigFunc: func() *internal.GBMConfig {
+			return nil
+		},
+		GetStateFunc: func() *internal.State {
+			state := &internal.State{}
+			if stateBase != "" {
+				state.WorktreeBaseBranch = map[string]string{"feat": stateBase}
+			}
+			return state
+		},
+		GetWorktreePathFunc: func(worktreeName string) (string, error) {
+			return "/repo/worktrees/" + worktreeName, nil
+		},
+		GetWorktreeCurrentBranchFunc: func(worktreePath string) (string, error) {
+			return branch, nil
+		},
+		VerifyWorktreeRefFunc: func(ref string, worktreePath string) (bool, error) {
+			return ref == "develop", nil
+		},
+		GetWorktreeMergeBaseFunc: func(worktreePath, baseBranch string) (string, time.Time, error) {
+			return "abc1234", time.Now(), nil
+		},
+		PushWorktreeFunc: func(worktreeName string) error {
+			return nil
+		},
+		GetRepoIdentityFunc: func() (*internal.RepoIdentity, error) {
+			return &internal.RepoIdentity{Host: "github.com", Owner: "owner", Repo: "repo"}, nil
+		},
+	}
+}
+
+func TestHandlePR(t *testing.T) {
+	tests := []struct {
+		name      string
+		base      string
+		mockSetup func() *worktreePRCreatorMock
+		assertErr func(t *testing.T, err error)
+		assertRun func(t *testing.T, mock *worktreePRCreatorMock, opened []string)
+	}{
+		{
+			name: "pushes and opens the compare URL for the stored base branch",
+			mockSetup: func() *worktreePRCreatorMock {
+				return newPRCreatorMock("origin/main", "feature/auth")
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+			assertRun: func(t *testing.T, mock *worktreePRCreatorMock, opened []string) {
+				assert.Len(t, mock.PushWorktreeCalls(), 1)
+				assert.Equal(t, []string{"https://github.com/owner/repo/compare/main...feature/auth?expand=1"}, opened)
+			},
+		},
+		{
+			name: "falls back to the detected base branch",
+			mockSetup: func() *worktreePRCreatorMock {
+				return newPRCreatorMock("", "feature/auth")
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+			assertRun: func(t *testing.T, mock *worktreePRCreatorMock, opened []string) {
+				assert.Equal(t, []string{"https://github.com/owner/repo/compare/develop...feature/auth?expand=1"}, opened)
+			},
+		},
+		{
+			name: "--base overrides the detected base branch",
+			base: "release",
+			mockSetup: func() *worktreePRCreatorMock {
+				return newPRCreatorMock("main", "feature/auth")
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+			assertRun: func(t *testing.T, mock *worktreePRCreatorMock, opened []string) {
+				assert.Empty(t, mock.GetStateCalls())
+				assert.Equal(t, []string{"https://github.com/owner/repo/compare/release...feature/auth?expand=1"}, opened)
+			},
+		},
+		{
+			name: "error - detached HEAD",
+			mockSetup: func() *worktreePRCreatorMock {
+				return newPRCreatorMock("main", "HEAD")
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "is not on a branch")
+			},
+			assertRun: func(t *testing.T, mock *worktreePRCreatorMock, opened []string) {
+				assert.Empty(t, mock.PushWorktreeCalls())
+				assert.Empty(t, opened)
+			},
+		},
+		{
+			name: "error - worktree is on its base branch",
+			mockSetup: func() *worktreePRCreatorMock {
+				return newPRCreatorMock("main", "main")
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "is on its base branch 'main'")
+			},
+			assertRun: func(t *testing.T, mock *worktreePRCreatorMock, opened []string) {
+				assert.Empty(t, mock.PushWorktreeCalls())
+			},
+		},
+		{
+			name: "error - unsupported provider does not push",
+			mockSetup: func() *worktreePRCreatorMock {
+				mock := newPRCreatorMock("main", "feature/auth")
+				mock.GetRepoIdentityFunc = func() (*internal.RepoIdentity, error) {
+					return &internal.RepoIdentity{Host: "git.example.com", Owner: "owner", Repo: "repo"}, nil
+				}
+				return mock
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "only GitHub and GitLab are supported")
+			},
+			assertRun: func(t *testing.T, mock *worktreePRCreatorMock, opened []string) {
+				assert.Empty(t, mock.PushWorktreeCalls())
+			},
+		},
+		{
+			name: "error - push fails",
+			mockSetup: func() *worktreePRCreatorMock {
+				mock := newPRCreatorMock("main", "feature/auth")
+				mock.PushWorktreeFunc = func(worktreeName string) error {
+					return errors.New("rejected")
+				}
+				return mock
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "failed to push worktree 'feat'")
+			},
+			assertRun: func(t *testing.T, mock *worktreePRCreatorMock, opened []string) {
+				assert.Empty(t, opened)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := tt.mockSetup()
+			var opened []string
+			err := handlePR(mock, "feat", tt.base, func(url string) error {
+				opened = append(opened, url)
+				return nil
+			})
+
+			tt.assertErr(t, err)
+			tt.assertRun(t, mock, opened)
+		})
+	}
+}
+
+func TestHandlePR_PrintOnly(t *testing.T) {
+	mock := newPRCreatorMock("main", "feature/auth")
+	assert.NoError(t, handlePR(mock, "feat", "", nil))
+	assert.Len(t, mock.PushWorktreeCalls(), 1)
+}
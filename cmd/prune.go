@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"gbm/internal"
+
+	"github.com/spf13/cobra"
+)
+
+//go:generate go run github.com/matryer/moq@latest -out ./autogen_worktreePruner.go . worktreePruner
+
+// worktreePruner interface abstracts the Manager operations needed for pruning worktrees and branches
+type worktreePruner interface {
+	PruneWorktrees(dryRun bool) ([]string, error)
+	FindPrunableMergeBranches(mergedOnly bool) ([]internal.PrunableBranch, error)
+	DeleteLocalBranch(branchName string, force bool) error
+	ReconcileWorktreeState(dryRun bool) ([]string, error)
+}
+
+func newPruneCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune",
+		Short: "Clean up stale worktree metadata and leftover mergeback branches",
+		Long: `Clean up stale worktree metadata and leftover mergeback branches.
+
+Runs 'git worktree prune' to drop metadata for worktree directories that were deleted
+manually, removes local merge/ branches from completed mergebacks that are no longer
+checked out in any worktree, and drops state entries for worktrees that no longer exist.
+
+Only merge/ branches already merged into their target are deleted. Unmerged ones are listed
+and kept; --force deletes them too, along with their unmerged commits, after confirming.
+
+Examples:
+  gbm prune                # Prune worktrees and delete merged merge/ branches
+  gbm prune --dry-run      # Show what would be pruned without changing anything
+  gbm prune --force        # Also delete merge/ branches that were never merged`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dryRun, _ := cmd.Flags().GetBool("dry-run")
+			force, _ := cmd.Flags().GetBool("force")
+
+			manager, err := createInitializedManager()
+			if err != nil {
+				if !errors.Is(err, ErrLoadGBMConfig) {
+					return err
+				}
+
+				PrintVerbose("%v", err)
+			}
+
+			return handlePrune(manager, newConfirmation(cmd), dryRun, force)
+		},
+	}
+
+	cmd.Flags().Bool("dry-run", false, "show what would be pruned without making changes")
+	cmd.Flags().Bool("force", false, "also delete merge/ branches that are not merged into their target")
+
+	return cmd
+}
+
+func handlePrune(pruner worktreePruner, confirm internal.ConfirmationFunc, dryRun, force bool) error {
+	iconManager := internal.GetGlobalIconManager()
+	if dryRun {
+		PrintInfo("%s", internal.FormatStatusIcon(iconManager.DryRun(), "Dry run mode - showing what would be pruned:"))
+	}
+
+	PrintVerbose("Pruning stale worktree metadata (dryRun=%v)", dryRun)
+	pruned, err := pruner.PruneWorktrees(dryRun)
+	if err != nil {
+		return fmt.Errorf("failed to prune worktrees: %w", err)
+	}
+	for _, entry := range pruned {
+		PrintInfo("  • %s", entry)
+	}
+
+	PrintVerbose("Looking for leftover merge branches (force=%v)", force)
+	branches, err := pruner.FindPrunableMergeBranches(false)
+	if err != nil {
+		return fmt.Errorf("failed to find merge branches: %w", err)
+	}
+
+	var unmerged []string
+	for _, branch := range branches {
+		if !branch.Merged {
+			unmerged = append(unmerged, branch.Name)
+		}
+	}
+	// Deleting an unmerged branch loses its commits, so it takes --force and a confirmation
+	deleteUnmerged := force && len(unmerged) > 0 && (dryRun ||
+		confirm(fmt.Sprintf("Delete %d unmerged merge branch(es) and their commits (%s)?", len(unmerged), strings.Join(unmerged, ", "))))
+
+	deleted := 0
+	for _, branch := range branches {
+		status := "not merged"
+		if branch.Merged {
+			status = "merged into " + branch.Target
+		}
+
+		if !branch.Merged && !deleteUnmerged {
+			PrintInfo("  • Kept branch %s (%s; use --force to delete it)", branch.Name, status)
+			continue
+		}
+
+		if dryRun {
+			PrintInfo("  • Would delete branch %s (%s)", branch.Name, status)
+			continue
+		}
+
+		if err := pruner.DeleteLocalBranch(branch.Name, !branch.Merged); err != nil {
+			PrintError("Failed to delete branch %s: %v", branch.Name, err)
+			continue
+		}
+		deleted++
+		PrintInfo("  • Deleted branch %s (%s)", branch.Name, status)
+	}
+
+	staleEntries, err := pruner.ReconcileWorktreeState(dryRun)
+	if err != nil {
+		return fmt.Errorf("failed to reconcile state: %w", err)
+	}
+	for _, name := range staleEntries {
+		if dryRun {
+			PrintInfo("  • Would drop state for missing worktree %s", name)
+		} else {
+			PrintInfo("  • Dropped state for missing worktree %s", name)
+		}
+	}
+
+	if dryRun {
+		return nil
+	}
+
+	PrintInfo("%s", internal.FormatSuccess(fmt.Sprintf("Prune complete: %d stale worktree(s), %d branch(es) deleted, %d state entries dropped", len(pruned), deleted, len(staleEntries))))
+	return nil
+}
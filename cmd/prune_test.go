@@ -0,0 +1,191 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	"gbm/internal"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandlePrune(t *testing.T) {
+	tests := []struct {
+		name        string
+		dryRun      bool
+		force       bool
+		confirm     bool
+		mockSetup   func() *worktreePrunerMock
+		assertMocks func(t *testing.T, mock *worktreePrunerMock)
+		assertErr   func(t *testing.T, err error)
+	}{
+		{
+			name:    "success - force deletes merged and unmerged branches once confirmed",
+			force:   true,
+			confirm: true,
+			mockSetup: func() *worktreePrunerMock {
+				return &worktreePrunerMock{
+					PruneWorktreesFunc: func(dryRun bool) ([]string, error) {
+						return []string{"Removing worktrees/old: gitdir file points to non-existent location"}, nil
+					},
+					FindPrunableMergeBranchesFunc: func(mergedOnly bool) ([]internal.PrunableBranch, error) {
+						return []internal.PrunableBranch{
+							{Name: "merge/fix_preview", Target: "preview", Merged: true},
+							{Name: "merge/wip_preview", Target: "preview", Merged: false},
+						}, nil
+					},
+					DeleteLocalBranchFunc: func(branchName string, force bool) error {
+						return nil
+					},
+					ReconcileWorktreeStateFunc: func(dryRun bool) ([]string, error) {
+						return []string{"old"}, nil
+					},
+				}
+			},
+			assertMocks: func(t *testing.T, mock *worktreePrunerMock) {
+				calls := mock.DeleteLocalBranchCalls()
+				assert.Len(t, calls, 2)
+				assert.Equal(t, "merge/fix_preview", calls[0].BranchName)
+				assert.False(t, calls[0].Force, "merged branches should use safe delete")
+				assert.Equal(t, "merge/wip_preview", calls[1].BranchName)
+				assert.True(t, calls[1].Force, "unmerged branches require force delete")
+				assert.False(t, mock.ReconcileWorktreeStateCalls()[0].DryRun)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:   "success - dry run does not delete anything",
+			dryRun: true,
+			mockSetup: func() *worktreePrunerMock {
+				return &worktreePrunerMock{
+					PruneWorktreesFunc: func(dryRun bool) ([]string, error) {
+						return nil, nil
+					},
+					FindPrunableMergeBranchesFunc: func(mergedOnly bool) ([]internal.PrunableBranch, error) {
+						return []internal.PrunableBranch{{Name: "merge/fix_preview", Target: "preview", Merged: true}}, nil
+					},
+					ReconcileWorktreeStateFunc: func(dryRun bool) ([]string, error) {
+						return nil, nil
+					},
+				}
+			},
+			assertMocks: func(t *testing.T, mock *worktreePrunerMock) {
+				assert.True(t, mock.PruneWorktreesCalls()[0].DryRun)
+				assert.True(t, mock.ReconcileWorktreeStateCalls()[0].DryRun)
+				assert.Len(t, mock.DeleteLocalBranchCalls(), 0)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "success - unmerged branches are kept without force",
+			mockSetup: func() *worktreePrunerMock {
+				return &worktreePrunerMock{
+					PruneWorktreesFunc: func(dryRun bool) ([]string, error) {
+						return nil, nil
+					},
+					FindPrunableMergeBranchesFunc: func(mergedOnly bool) ([]internal.PrunableBranch, error) {
+						return []internal.PrunableBranch{
+							{Name: "merge/fix_preview", Target: "preview", Merged: true},
+							{Name: "merge/wip_preview", Target: "preview", Merged: false},
+						}, nil
+					},
+					DeleteLocalBranchFunc: func(branchName string, force bool) error {
+						return nil
+					},
+					ReconcileWorktreeStateFunc: func(dryRun bool) ([]string, error) {
+						return nil, nil
+					},
+				}
+			},
+			assertMocks: func(t *testing.T, mock *worktreePrunerMock) {
+				calls := mock.DeleteLocalBranchCalls()
+				assert.Len(t, calls, 1)
+				assert.Equal(t, "merge/fix_preview", calls[0].BranchName)
+				assert.False(t, calls[0].Force)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:  "success - declining force keeps unmerged branches",
+			force: true,
+			mockSetup: func() *worktreePrunerMock {
+				return &worktreePrunerMock{
+					PruneWorktreesFunc: func(dryRun bool) ([]string, error) {
+						return nil, nil
+					},
+					FindPrunableMergeBranchesFunc: func(mergedOnly bool) ([]internal.PrunableBranch, error) {
+						return []internal.PrunableBranch{{Name: "merge/wip_preview", Target: "preview", Merged: false}}, nil
+					},
+					ReconcileWorktreeStateFunc: func(dryRun bool) ([]string, error) {
+						return nil, nil
+					},
+				}
+			},
+			assertMocks: func(t *testing.T, mock *worktreePrunerMock) {
+				assert.Len(t, mock.DeleteLocalBranchCalls(), 0)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "success - branch deletion failure is reported but not fatal",
+			mockSetup: func() *worktreePrunerMock {
+				return &worktreePrunerMock{
+					PruneWorktreesFunc: func(dryRun bool) ([]string, error) {
+						return nil, nil
+					},
+					FindPrunableMergeBranchesFunc: func(mergedOnly bool) ([]internal.PrunableBranch, error) {
+						return []internal.PrunableBranch{{Name: "merge/fix_preview", Merged: true}}, nil
+					},
+					DeleteLocalBranchFunc: func(branchName string, force bool) error {
+						return errors.New("branch is checked out")
+					},
+					ReconcileWorktreeStateFunc: func(dryRun bool) ([]string, error) {
+						return nil, nil
+					},
+				}
+			},
+			assertMocks: func(t *testing.T, mock *worktreePrunerMock) {
+				assert.Len(t, mock.DeleteLocalBranchCalls(), 1)
+				assert.Len(t, mock.ReconcileWorktreeStateCalls(), 1)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "error - worktree prune fails",
+			mockSetup: func() *worktreePrunerMock {
+				return &worktreePrunerMock{
+					PruneWorktreesFunc: func(dryRun bool) ([]string, error) {
+						return nil, errors.New("git worktree prune failed")
+					},
+				}
+			},
+			assertMocks: func(t *testing.T, mock *worktreePrunerMock) {
+				assert.Len(t, mock.FindPrunableMergeBranchesCalls(), 0)
+				assert.Len(t, mock.ReconcileWorktreeStateCalls(), 0)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "failed to prune worktrees")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := tt.mockSetup()
+			err := handlePrune(mock, func(string) bool { return tt.confirm }, tt.dryRun, tt.force)
+
+			tt.assertMocks(t, mock)
+			tt.assertErr(t, err)
+		})
+	}
+}
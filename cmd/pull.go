@@ -14,7 +14,7 @@ import (
 
 // worktreePuller interface abstracts the Manager operations needed for pulling worktrees
 type worktreePuller interface {
-	PullAllWorktrees() error
+	PullAllWorktreesWithResults(failFast bool) ([]internal.WorktreeResult, error)
 	PullWorktree(worktreeName string) error
 	IsInWorktree(currentPath string) (bool, string, error)
 	GetAllWorktrees() (map[string]*internal.WorktreeListInfo, error)
@@ -29,10 +29,16 @@ func newPullCommand() *cobra.Command {
 Usage:
   gbm pull                    # Pull current worktree (if in a worktree)
   gbm pull <worktree-name>    # Pull specific worktree
-  gbm pull --all              # Pull all worktrees`,
+  gbm pull --all              # Fetch once, fast-forward all worktrees concurrently and print a summary
+  gbm pull --all --exclude-main  # Pull every worktree except the main one`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			pullAll, _ := cmd.Flags().GetBool("all")
+			failFast, _ := cmd.Flags().GetBool("fail-fast")
+			excludeMain, _ := cmd.Flags().GetBool("exclude-main")
+			if excludeMain && !pullAll {
+				return fmt.Errorf("--exclude-main can only be used with --all")
+			}
 
 			wd, err := os.Getwd()
 			if err != nil {
@@ -49,7 +55,8 @@ Usage:
 			}
 
 			if pullAll {
-				return handlePullAll(manager)
+				manager.SetExcludeMain(excludeMain)
+				return handlePullAll(manager, failFast)
 			}
 
 			if len(args) == 0 {
@@ -61,6 +68,8 @@ Usage:
 	}
 
 	cmd.Flags().Bool("all", false, "Pull all worktrees")
+	cmd.Flags().Bool("fail-fast", false, "With --all, stop starting new pulls after the first failure")
+	cmd.Flags().Bool("exclude-main", false, "With --all, skip the main worktree (root of the merge_into tree or on the default branch)")
 
 	// Add completion for worktree names
 	cmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -73,9 +82,11 @@ Usage:
 	return cmd
 }
 
-func handlePullAll(puller worktreePuller) error {
+func handlePullAll(puller worktreePuller, failFast bool) error {
 	PrintInfo("Pulling all worktrees...")
-	return puller.PullAllWorktrees()
+	results, err := puller.PullAllWorktreesWithResults(failFast)
+	printWorktreeResults(results)
+	return err
 }
 
 func handlePullCurrent(puller worktreePuller, currentPath string) error {
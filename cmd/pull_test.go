@@ -25,8 +25,8 @@ func TestHandlePullAll(t *testing.T) {
 			name: "success - pull all worktrees",
 			mockSetup: func() *worktreePullerMock {
 				return &worktreePullerMock{
-					PullAllWorktreesFunc: func() error {
-						return nil
+					PullAllWorktreesWithResultsFunc: func(failFast bool) ([]internal.WorktreeResult, error) {
+						return []internal.WorktreeResult{{Name: "wt1"}}, nil
 					},
 				}
 			},
@@ -38,8 +38,8 @@ func TestHandlePullAll(t *testing.T) {
 			name: "error - pull all fails with git error",
 			mockSetup: func() *worktreePullerMock {
 				return &worktreePullerMock{
-					PullAllWorktreesFunc: func() error {
-						return errors.New("git pull failed")
+					PullAllWorktreesWithResultsFunc: func(failFast bool) ([]internal.WorktreeResult, error) {
+						return []internal.WorktreeResult{{Name: "wt1", Err: errors.New("conflict")}}, errors.New("git pull failed")
 					},
 				}
 			},
@@ -53,7 +53,7 @@ func TestHandlePullAll(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mock := tt.mockSetup()
-			err := handlePullAll(mock)
+			err := handlePullAll(mock, false)
 			tt.expectErr(t, err)
 		})
 	}
@@ -14,8 +14,8 @@ import (
 //
 //go:generate go run github.com/matryer/moq@latest -out ./autogen_worktreePusher.go . worktreePusher
 type worktreePusher interface {
-	PushAllWorktrees() error
-	PushWorktree(worktreeName string) error
+	PushAllWorktreesWithResults(opts internal.PushOptions, failFast bool) ([]internal.WorktreeResult, error)
+	PushWorktreeWithOptions(worktreeName string, opts internal.PushOptions) error
 	IsInWorktree(currentPath string) (bool, string, error)
 	GetAllWorktrees() (map[string]*internal.WorktreeListInfo, error)
 }
@@ -29,12 +29,30 @@ func newPushCommand() *cobra.Command {
 Usage:
   gbm push                    # Push current worktree (if in a worktree)
   gbm push <worktree-name>    # Push specific worktree
-  gbm push --all              # Push all worktrees
+  gbm push --all              # Push all worktrees concurrently and print a summary
+  gbm push --all --exclude-main  # Push every worktree except the main one
 
-The command will automatically set upstream (-u) if not already set.`,
+The command will automatically set upstream (-u) if not already set.
+
+Use --force-with-lease to update a rebased branch; it fails if the remote has commits you
+haven't fetched. A plain --force is not supported. Use --tags to also push local tags and
+--dry-run to see what would be pushed.`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			pushAll, _ := cmd.Flags().GetBool("all")
+			failFast, _ := cmd.Flags().GetBool("fail-fast")
+			excludeMain, _ := cmd.Flags().GetBool("exclude-main")
+			if excludeMain && !pushAll {
+				return fmt.Errorf("--exclude-main can only be used with --all")
+			}
+			if force, _ := cmd.Flags().GetBool("force"); force {
+				return fmt.Errorf("--force is not supported to protect shared branches; use --force-with-lease instead")
+			}
+
+			opts := internal.PushOptions{}
+			opts.ForceWithLease, _ = cmd.Flags().GetBool("force-with-lease")
+			opts.Tags, _ = cmd.Flags().GetBool("tags")
+			opts.DryRun, _ = cmd.Flags().GetBool("dry-run")
 
 			wd, err := os.Getwd()
 			if err != nil {
@@ -51,18 +69,26 @@ The command will automatically set upstream (-u) if not already set.`,
 			}
 
 			if pushAll {
-				return handlePushAll(manager)
+				manager.SetExcludeMain(excludeMain)
+				return handlePushAll(manager, opts, failFast)
 			}
 
 			if len(args) == 0 {
-				return handlePushCurrent(manager, wd)
+				return handlePushCurrent(manager, wd, opts)
 			}
 
-			return handlePushNamed(manager, args[0])
+			return handlePushNamed(manager, args[0], opts)
 		},
 	}
 
 	cmd.Flags().Bool("all", false, "Push all worktrees")
+	cmd.Flags().Bool("fail-fast", false, "With --all, stop starting new pushes after the first failure")
+	cmd.Flags().Bool("exclude-main", false, "With --all, skip the main worktree (root of the merge_into tree or on the default branch)")
+	cmd.Flags().Bool("force-with-lease", false, "Overwrite the remote branch only if it matches the last fetched state")
+	cmd.Flags().Bool("tags", false, "Also push all local tags")
+	cmd.Flags().Bool("dry-run", false, "Show what would be pushed without updating the remote")
+	cmd.Flags().BoolP("force", "f", false, "Not supported; use --force-with-lease")
+	_ = cmd.Flags().MarkHidden("force")
 
 	// Add completion for worktree names
 	cmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -75,12 +101,14 @@ The command will automatically set upstream (-u) if not already set.`,
 	return cmd
 }
 
-func handlePushAll(pusher worktreePusher) error {
+func handlePushAll(pusher worktreePusher, opts internal.PushOptions, failFast bool) error {
 	PrintInfo("Pushing all worktrees...")
-	return pusher.PushAllWorktrees()
+	results, err := pusher.PushAllWorktreesWithResults(opts, failFast)
+	printWorktreeResults(results)
+	return err
 }
 
-func handlePushCurrent(pusher worktreePusher, currentPath string) error {
+func handlePushCurrent(pusher worktreePusher, currentPath string, opts internal.PushOptions) error {
 	// Check if we're in a worktree
 	inWorktree, worktreeName, err := pusher.IsInWorktree(currentPath)
 	if err != nil {
@@ -92,10 +120,10 @@ func handlePushCurrent(pusher worktreePusher, currentPath string) error {
 	}
 
 	PrintInfo("Pushing current worktree '%s'...", worktreeName)
-	return pusher.PushWorktree(worktreeName)
+	return pusher.PushWorktreeWithOptions(worktreeName, opts)
 }
 
-func handlePushNamed(pusher worktreePusher, worktreeName string) error {
+func handlePushNamed(pusher worktreePusher, worktreeName string, opts internal.PushOptions) error {
 	// Check if worktree exists
 	worktrees, err := pusher.GetAllWorktrees()
 	if err != nil {
@@ -107,5 +135,5 @@ func handlePushNamed(pusher worktreePusher, worktreeName string) error {
 	}
 
 	PrintInfo("Pushing worktree '%s'...", worktreeName)
-	return pusher.PushWorktree(worktreeName)
+	return pusher.PushWorktreeWithOptions(worktreeName, opts)
 }
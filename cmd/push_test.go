@@ -19,8 +19,8 @@ func TestHandlePushAll(t *testing.T) {
 			name: "success - push all worktrees",
 			setupMock: func() *worktreePusherMock {
 				return &worktreePusherMock{
-					PushAllWorktreesFunc: func() error {
-						return nil
+					PushAllWorktreesWithResultsFunc: func(opts internal.PushOptions, failFast bool) ([]internal.WorktreeResult, error) {
+						return []internal.WorktreeResult{{Name: "wt1"}}, nil
 					},
 				}
 			},
@@ -32,8 +32,8 @@ func TestHandlePushAll(t *testing.T) {
 			name: "error - push all worktrees fails",
 			setupMock: func() *worktreePusherMock {
 				return &worktreePusherMock{
-					PushAllWorktreesFunc: func() error {
-						return errors.New("push failed")
+					PushAllWorktreesWithResultsFunc: func(opts internal.PushOptions, failFast bool) ([]internal.WorktreeResult, error) {
+						return []internal.WorktreeResult{{Name: "wt1", Err: errors.New("rejected")}}, errors.New("push failed")
 					},
 				}
 			},
@@ -48,11 +48,11 @@ func TestHandlePushAll(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mock := tt.setupMock()
 
-			err := handlePushAll(mock)
+			err := handlePushAll(mock, internal.PushOptions{}, false)
 			tt.expectErr(t, err)
 
 			// Verify the mock was called
-			assert.Equal(t, 1, len(mock.PushAllWorktreesCalls()))
+			assert.Equal(t, 1, len(mock.PushAllWorktreesWithResultsCalls()))
 		})
 	}
 }
@@ -72,7 +72,7 @@ func TestHandlePushCurrent(t *testing.T) {
 					IsInWorktreeFunc: func(currentPath string) (bool, string, error) {
 						return true, "dev", nil
 					},
-					PushWorktreeFunc: func(worktreeName string) error {
+					PushWorktreeWithOptionsFunc: func(worktreeName string, opts internal.PushOptions) error {
 						return nil
 					},
 				}
@@ -119,7 +119,7 @@ func TestHandlePushCurrent(t *testing.T) {
 					IsInWorktreeFunc: func(currentPath string) (bool, string, error) {
 						return true, "dev", nil
 					},
-					PushWorktreeFunc: func(worktreeName string) error {
+					PushWorktreeWithOptionsFunc: func(worktreeName string, opts internal.PushOptions) error {
 						return errors.New("push failed")
 					},
 				}
@@ -135,7 +135,7 @@ func TestHandlePushCurrent(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mock := tt.setupMock()
 
-			err := handlePushCurrent(mock, tt.currentPath)
+			err := handlePushCurrent(mock, tt.currentPath, internal.PushOptions{})
 			tt.expectErr(t, err)
 		})
 	}
@@ -158,7 +158,7 @@ func TestHandlePushNamed(t *testing.T) {
 							"dev": {Path: "/path/to/dev"},
 						}, nil
 					},
-					PushWorktreeFunc: func(worktreeName string) error {
+					PushWorktreeWithOptionsFunc: func(worktreeName string, opts internal.PushOptions) error {
 						return nil
 					},
 				}
@@ -209,7 +209,7 @@ func TestHandlePushNamed(t *testing.T) {
 							"dev": {Path: "/path/to/dev"},
 						}, nil
 					},
-					PushWorktreeFunc: func(worktreeName string) error {
+					PushWorktreeWithOptionsFunc: func(worktreeName string, opts internal.PushOptions) error {
 						return errors.New("push failed")
 					},
 				}
@@ -225,7 +225,7 @@ func TestHandlePushNamed(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mock := tt.setupMock()
 
-			err := handlePushNamed(mock, tt.worktreeName)
+			err := handlePushNamed(mock, tt.worktreeName, internal.PushOptions{})
 			tt.expectErr(t, err)
 		})
 	}
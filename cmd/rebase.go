@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gbm/internal"
+
+	"github.com/spf13/cobra"
+)
+
+//go:generate go run github.com/matryer/moq@latest -out ./autogen_worktreeRebaser.go . worktreeRebaser
+
+// worktreeRebaser interface abstracts the Manager operations needed to rebase a worktree onto its base branch
+type worktreeRebaser interface {
+	GetConfig() *internal.Config
+	GetState() *internal.State
+	GetGBMConfig() *internal.GBMConfig
+	GetWorktreePath(worktreeName string) (string, error)
+	VerifyWorktreeRef(ref string, worktreePath string) (bool, error)
+	GetWorktreeMergeBase(worktreePath, baseBranch string) (string, time.Time, error)
+	IsRebaseInProgress(worktreePath string) (bool, error)
+	RebaseWorktree(worktreePath, ontoRef string) error
+	ContinueRebase(worktreePath string) error
+	AbortRebase(worktreePath string) error
+	ListConflictedFiles(worktreePath string) ([]string, error)
+}
+
+func newRebaseCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebase <worktree-name>",
+		Short: "Rebase a worktree's branch onto its base branch",
+		Long: `Rebase the branch checked out in a worktree onto the branch it was created from.
+
+The base branch is the base_branch declared for a tracked worktree in gbm.branchconfig.yaml,
+or the one recorded when the worktree was added. For worktrees without either, the closest of
+settings.candidate_branches is used (the same detection as 'gbm info').
+
+If the rebase stops on conflicts, resolve them in the worktree, stage the files and run
+'gbm rebase --continue <worktree>', or give up with 'gbm rebase --abort <worktree>'.
+
+Examples:
+  gbm rebase feat-auth             # Rebase feat-auth onto its base branch
+  gbm rebase --continue feat-auth  # Continue after resolving conflicts
+  gbm rebase --abort feat-auth     # Abandon the rebase`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			abort, _ := cmd.Flags().GetBool("abort")
+			cont, _ := cmd.Flags().GetBool("continue")
+
+			manager, err := createInitializedManager()
+			if err != nil {
+				return err
+			}
+
+			switch {
+			case abort:
+				return handleRebaseAbort(manager, args[0])
+			case cont:
+				return handleRebaseContinue(manager, args[0])
+			default:
+				return handleRebase(manager, args[0])
+			}
+		},
+	}
+
+	cmd.Flags().Bool("abort", false, "abort the in-progress rebase in the worktree")
+	cmd.Flags().Bool("continue", false, "continue the in-progress rebase after resolving conflicts")
+	cmd.MarkFlagsMutuallyExclusive("abort", "continue")
+
+	cmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) != 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return getWorktreeCompletionsWithManager(), cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+// handleRebase rebases the worktree's branch onto its base branch
+func handleRebase(rebaser worktreeRebaser, worktreeName string) error {
+	worktreePath, err := rebaser.GetWorktreePath(worktreeName)
+	if err != nil {
+		return err
+	}
+
+	inProgress, err := rebaser.IsRebaseInProgress(worktreePath)
+	if err != nil {
+		return err
+	}
+	if inProgress {
+		return fmt.Errorf("a rebase is already in progress in worktree '%s'; use --continue or --abort", worktreeName)
+	}
+
+	baseBranch := resolveWorktreeBaseBranch(worktreeName, worktreePath, rebaser)
+	if baseBranch == "" {
+		return fmt.Errorf("could not determine the base branch of worktree '%s'", worktreeName)
+	}
+
+	PrintInfo("Rebasing worktree '%s' onto '%s'...", worktreeName, baseBranch)
+	if err := rebaser.RebaseWorktree(worktreePath, baseBranch); err != nil {
+		return handleRebaseError(rebaser, worktreeName, worktreePath, err)
+	}
+
+	PrintInfo("%s", internal.FormatSuccess(fmt.Sprintf("Rebased worktree '%s' onto '%s'", worktreeName, baseBranch)))
+	return nil
+}
+
+// handleRebaseContinue resumes a rebase stopped on conflicts
+func handleRebaseContinue(rebaser worktreeRebaser, worktreeName string) error {
+	worktreePath, err := requireRebaseInProgress(rebaser, worktreeName)
+	if err != nil {
+		return err
+	}
+
+	if err := rebaser.ContinueRebase(worktreePath); err != nil {
+		return handleRebaseError(rebaser, worktreeName, worktreePath, err)
+	}
+
+	PrintInfo("%s", internal.FormatSuccess(fmt.Sprintf("Finished rebase in worktree '%s'", worktreeName)))
+	return nil
+}
+
+// handleRebaseAbort abandons the in-progress rebase in a worktree
+func handleRebaseAbort(rebaser worktreeRebaser, worktreeName string) error {
+	worktreePath, err := requireRebaseInProgress(rebaser, worktreeName)
+	if err != nil {
+		return err
+	}
+
+	if err := rebaser.AbortRebase(worktreePath); err != nil {
+		return fmt.Errorf("failed to abort rebase: %w", err)
+	}
+
+	PrintInfo("%s", internal.FormatSuccess(fmt.Sprintf("Aborted rebase in worktree '%s'", worktreeName)))
+	return nil
+}
+
+func requireRebaseInProgress(rebaser worktreeRebaser, worktreeName string) (string, error) {
+	worktreePath, err := rebaser.GetWorktreePath(worktreeName)
+	if err != nil {
+		return "", err
+	}
+
+	inProgress, err := rebaser.IsRebaseInProgress(worktreePath)
+	if err != nil {
+		return "", err
+	}
+	if !inProgress {
+		return "", fmt.Errorf("no rebase in progress in worktree '%s'", worktreeName)
+	}
+
+	return worktreePath, nil
+}
+
+// handleRebaseError reports conflicted files when the rebase stopped on conflicts; other errors are returned as is
+func handleRebaseError(rebaser worktreeRebaser, worktreeName, worktreePath string, err error) error {
+	if !errors.Is(err, internal.ErrRebaseConflict) {
+		return fmt.Errorf("failed to rebase worktree '%s': %w", worktreeName, err)
+	}
+
+	PrintInfo("Rebase conflicts detected in worktree '%s'", worktreeName)
+	if files, err := rebaser.ListConflictedFiles(worktreePath); err != nil {
+		PrintVerbose("Could not list conflicted files: %v", err)
+	} else {
+		for _, file := range files {
+			PrintInfo("  • %s", file)
+		}
+	}
+	PrintInfo("Resolve the conflicts and stage the files, then run: gbm rebase --continue %s", worktreeName)
+	PrintInfo("To give up and restore the branch, run: gbm rebase --abort %s", worktreeName)
+	return nil
+}
@@ -0,0 +1,220 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"gbm/internal"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newRebaserMock(stateBase string, inProgress bool) *worktreeRebaserMock {
+	return &worktreeRebaserMock{
+		GetConfigFunc: func() *internal.Config {
+			return &internal.Config{
+				Settings: internal.ConfigSettings{
+					CandidateBranches: []string{"main", "develop"},
+				},
+			}
+		},
+		GetGBMConfigFunc: func() *internal.GBMConfig {
+			return nil
+		},
+		GetStateFunc: func() *internal.State {
+			state := &internal.State{}
+			if stateBase != "" {
+				state.WorktreeBaseBranch = map[string]string{"feat": stateBase}
+			}
+			return state
+		},
+		GetWorktreePathFunc: func(worktreeName string) (string, error) {
+			return "/repo/worktrees/" + worktreeName, nil
+		},
+		VerifyWorktreeRefFunc: func(ref string, worktreePath string) (bool, error) {
+			return ref == "develop", nil
+		},
+		GetWorktreeMergeBaseFunc: func(worktreePath, baseBranch string) (string, time.Time, error) {
+			return "abc1234", time.Now(), nil
+		},
+		IsRebaseInProgressFunc: func(worktreePath string) (bool, error) {
+			return inProgress, nil
+		},
+		RebaseWorktreeFunc: func(worktreePath, ontoRef string) error {
+			return nil
+		},
+		ContinueRebaseFunc: func(worktreePath string) error {
+			return nil
+		},
+		AbortRebaseFunc: func(worktreePath string) error {
+			return nil
+		},
+		ListConflictedFilesFunc: func(worktreePath string) ([]string, error) {
+			return []string{"content.txt"}, nil
+		},
+	}
+}
+
+func TestHandleRebase(t *testing.T) {
+	tests := []struct {
+		name      string
+		mockSetup func() *worktreeRebaserMock
+		assertErr func(t *testing.T, err error)
+		assertRun func(t *testing.T, mock *worktreeRebaserMock)
+	}{
+		{
+			name: "rebases onto stored base branch",
+			mockSetup: func() *worktreeRebaserMock {
+				return newRebaserMock("main", false)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+			assertRun: func(t *testing.T, mock *worktreeRebaserMock) {
+				assert.Len(t, mock.RebaseWorktreeCalls(), 1)
+				assert.Equal(t, "/repo/worktrees/feat", mock.RebaseWorktreeCalls()[0].WorktreePath)
+				assert.Equal(t, "main", mock.RebaseWorktreeCalls()[0].OntoRef)
+				assert.Empty(t, mock.VerifyWorktreeRefCalls())
+			},
+		},
+		{
+			name: "prefers base_branch declared for a tracked worktree",
+			mockSetup: func() *worktreeRebaserMock {
+				mock := newRebaserMock("main", false)
+				mock.GetGBMConfigFunc = func() *internal.GBMConfig {
+					return &internal.GBMConfig{Worktrees: map[string]internal.WorktreeConfig{
+						"feat": {Branch: "feature/x", BaseBranch: "release/1.0"},
+					}}
+				}
+				return mock
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+			assertRun: func(t *testing.T, mock *worktreeRebaserMock) {
+				assert.Len(t, mock.RebaseWorktreeCalls(), 1)
+				assert.Equal(t, "release/1.0", mock.RebaseWorktreeCalls()[0].OntoRef)
+				assert.Empty(t, mock.VerifyWorktreeRefCalls())
+			},
+		},
+		{
+			name: "falls back to detected base branch",
+			mockSetup: func() *worktreeRebaserMock {
+				return newRebaserMock("", false)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+			assertRun: func(t *testing.T, mock *worktreeRebaserMock) {
+				assert.Len(t, mock.RebaseWorktreeCalls(), 1)
+				assert.Equal(t, "develop", mock.RebaseWorktreeCalls()[0].OntoRef)
+			},
+		},
+		{
+			name: "no base branch found",
+			mockSetup: func() *worktreeRebaserMock {
+				mock := newRebaserMock("", false)
+				mock.VerifyWorktreeRefFunc = func(ref string, worktreePath string) (bool, error) {
+					return false, nil
+				}
+				return mock
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "could not determine the base branch")
+			},
+			assertRun: func(t *testing.T, mock *worktreeRebaserMock) {
+				assert.Empty(t, mock.RebaseWorktreeCalls())
+			},
+		},
+		{
+			name: "rebase already in progress",
+			mockSetup: func() *worktreeRebaserMock {
+				return newRebaserMock("main", true)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "already in progress")
+			},
+			assertRun: func(t *testing.T, mock *worktreeRebaserMock) {
+				assert.Empty(t, mock.RebaseWorktreeCalls())
+			},
+		},
+		{
+			name: "conflicts are reported and left for resolution",
+			mockSetup: func() *worktreeRebaserMock {
+				mock := newRebaserMock("main", false)
+				mock.RebaseWorktreeFunc = func(worktreePath, ontoRef string) error {
+					return fmt.Errorf("%w: %s", internal.ErrRebaseConflict, worktreePath)
+				}
+				return mock
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+			assertRun: func(t *testing.T, mock *worktreeRebaserMock) {
+				assert.Len(t, mock.ListConflictedFilesCalls(), 1)
+				assert.Empty(t, mock.AbortRebaseCalls())
+			},
+		},
+		{
+			name: "other rebase failures are returned",
+			mockSetup: func() *worktreeRebaserMock {
+				mock := newRebaserMock("main", false)
+				mock.RebaseWorktreeFunc = func(worktreePath, ontoRef string) error {
+					return errors.New("git rebase failed: cannot rebase: You have unstaged changes")
+				}
+				return mock
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "unstaged changes")
+			},
+			assertRun: func(t *testing.T, mock *worktreeRebaserMock) {
+				assert.Empty(t, mock.ListConflictedFilesCalls())
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := tt.mockSetup()
+			tt.assertErr(t, handleRebase(mock, "feat"))
+			tt.assertRun(t, mock)
+		})
+	}
+}
+
+func TestHandleRebaseContinueAndAbort(t *testing.T) {
+	t.Run("continue requires a rebase in progress", func(t *testing.T) {
+		mock := newRebaserMock("main", false)
+		assert.ErrorContains(t, handleRebaseContinue(mock, "feat"), "no rebase in progress")
+		assert.Empty(t, mock.ContinueRebaseCalls())
+	})
+
+	t.Run("continue resumes the rebase", func(t *testing.T) {
+		mock := newRebaserMock("main", true)
+		assert.NoError(t, handleRebaseContinue(mock, "feat"))
+		assert.Len(t, mock.ContinueRebaseCalls(), 1)
+	})
+
+	t.Run("continue stopping on further conflicts lists files", func(t *testing.T) {
+		mock := newRebaserMock("main", true)
+		mock.ContinueRebaseFunc = func(worktreePath string) error {
+			return internal.ErrRebaseConflict
+		}
+		assert.NoError(t, handleRebaseContinue(mock, "feat"))
+		assert.Len(t, mock.ListConflictedFilesCalls(), 1)
+	})
+
+	t.Run("abort requires a rebase in progress", func(t *testing.T) {
+		mock := newRebaserMock("main", false)
+		assert.ErrorContains(t, handleRebaseAbort(mock, "feat"), "no rebase in progress")
+		assert.Empty(t, mock.AbortRebaseCalls())
+	})
+
+	t.Run("abort abandons the rebase", func(t *testing.T) {
+		mock := newRebaserMock("main", true)
+		assert.NoError(t, handleRebaseAbort(mock, "feat"))
+		assert.Len(t, mock.AbortRebaseCalls(), 1)
+	})
+}
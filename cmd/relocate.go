@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"fmt"
+
+	"gbm/internal"
+
+	"github.com/spf13/cobra"
+)
+
+//go:generate go run github.com/matryer/moq@latest -out ./autogen_worktreeRootRelocator.go . worktreeRootRelocator
+
+// worktreeRootRelocator interface abstracts the Manager operations needed to move the worktree directory
+type worktreeRootRelocator interface {
+	PlanWorktreeRootRelocation(newPrefix string) (*internal.WorktreePrefixChange, error)
+	RelocateWorktreeRoot(newPrefix string) error
+}
+
+func newRelocateCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "relocate <new-prefix>",
+		Short: "Move all worktrees to a new worktree directory",
+		Long: `Move every worktree, tracked and ad hoc, from the current worktree directory to a new one
+and save it as settings.worktree_prefix.
+
+The new prefix is relative to the repository and may point outside it, e.g. to a bigger disk
+mounted next to it. Worktrees are moved with 'git worktree move', so git keeps track of them.
+The new location must be writable and must not be inside an existing worktree.
+
+Examples:
+  gbm relocate ../gbm-worktrees            # Move worktrees/ next to the repository
+  gbm relocate ../gbm-worktrees --dry-run  # Show what would move without changing anything`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+			manager, err := createInitializedManager()
+			if err != nil {
+				return err
+			}
+
+			return handleRelocate(manager, newConfirmation(cmd), args[0], dryRun)
+		},
+	}
+
+	cmd.Flags().Bool("dry-run", false, "show which worktrees would move without moving them")
+
+	return cmd
+}
+
+func handleRelocate(relocator worktreeRootRelocator, confirm internal.ConfirmationFunc, newPrefix string, dryRun bool) error {
+	change, err := relocator.PlanWorktreeRootRelocation(newPrefix)
+	if err != nil {
+		return err
+	}
+
+	PrintInfo("Moving %d worktree(s) from '%s' to '%s':", len(change.Worktrees), change.OldPrefix, change.NewPrefix)
+	for _, wt := range change.Worktrees {
+		PrintInfo("  • %s (%s)", wt.Name, wt.Path)
+	}
+
+	if dryRun {
+		iconManager := internal.GetGlobalIconManager()
+		PrintInfo("%s", internal.FormatStatusIcon(iconManager.DryRun(), "Dry run - nothing was moved"))
+		return nil
+	}
+
+	if !confirm(fmt.Sprintf("Move worktrees to '%s' and update settings.worktree_prefix?", change.NewPrefix)) {
+		PrintInfo("Relocation cancelled")
+		return nil
+	}
+
+	if err := relocator.RelocateWorktreeRoot(newPrefix); err != nil {
+		return fmt.Errorf("failed to relocate worktrees: %w", err)
+	}
+
+	PrintInfo("%s", internal.FormatSuccess(fmt.Sprintf("Moved %d worktree(s) to '%s'", len(change.Worktrees), change.NewPrefix)))
+	return nil
+}
@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	"gbm/internal"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleRelocate(t *testing.T) {
+	plan := &internal.WorktreePrefixChange{
+		OldPrefix: "worktrees",
+		NewPrefix: "../gbm-worktrees",
+		Worktrees: []*internal.WorktreeInfo{{Name: "dev", Path: "/repo/worktrees/dev"}},
+	}
+
+	tests := []struct {
+		name        string
+		dryRun      bool
+		confirm     bool
+		mockSetup   func() *worktreeRootRelocatorMock
+		assertMocks func(t *testing.T, mock *worktreeRootRelocatorMock)
+		assertErr   func(t *testing.T, err error)
+	}{
+		{
+			name:    "success - confirmed relocation moves worktrees",
+			confirm: true,
+			mockSetup: func() *worktreeRootRelocatorMock {
+				return &worktreeRootRelocatorMock{
+					PlanWorktreeRootRelocationFunc: func(newPrefix string) (*internal.WorktreePrefixChange, error) { return plan, nil },
+					RelocateWorktreeRootFunc:       func(newPrefix string) error { return nil },
+				}
+			},
+			assertMocks: func(t *testing.T, mock *worktreeRootRelocatorMock) {
+				calls := mock.RelocateWorktreeRootCalls()
+				assert.Len(t, calls, 1)
+				assert.Equal(t, "../gbm-worktrees", calls[0].NewPrefix)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:    "success - dry run only plans",
+			dryRun:  true,
+			confirm: true,
+			mockSetup: func() *worktreeRootRelocatorMock {
+				return &worktreeRootRelocatorMock{
+					PlanWorktreeRootRelocationFunc: func(newPrefix string) (*internal.WorktreePrefixChange, error) { return plan, nil },
+				}
+			},
+			assertMocks: func(t *testing.T, mock *worktreeRootRelocatorMock) {
+				assert.Len(t, mock.PlanWorktreeRootRelocationCalls(), 1)
+				assert.Len(t, mock.RelocateWorktreeRootCalls(), 0)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "success - declined relocation changes nothing",
+			mockSetup: func() *worktreeRootRelocatorMock {
+				return &worktreeRootRelocatorMock{
+					PlanWorktreeRootRelocationFunc: func(newPrefix string) (*internal.WorktreePrefixChange, error) { return plan, nil },
+				}
+			},
+			assertMocks: func(t *testing.T, mock *worktreeRootRelocatorMock) {
+				assert.Len(t, mock.RelocateWorktreeRootCalls(), 0)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:    "error - invalid target is rejected before asking",
+			confirm: true,
+			mockSetup: func() *worktreeRootRelocatorMock {
+				return &worktreeRootRelocatorMock{
+					PlanWorktreeRootRelocationFunc: func(newPrefix string) (*internal.WorktreePrefixChange, error) {
+						return nil, errors.New("cannot move worktrees into /repo/worktrees/dev/wt, which is inside worktree 'dev'")
+					},
+				}
+			},
+			assertMocks: func(t *testing.T, mock *worktreeRootRelocatorMock) {
+				assert.Len(t, mock.RelocateWorktreeRootCalls(), 0)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "inside worktree 'dev'")
+			},
+		},
+		{
+			name:    "error - move fails",
+			confirm: true,
+			mockSetup: func() *worktreeRootRelocatorMock {
+				return &worktreeRootRelocatorMock{
+					PlanWorktreeRootRelocationFunc: func(newPrefix string) (*internal.WorktreePrefixChange, error) { return plan, nil },
+					RelocateWorktreeRootFunc: func(newPrefix string) error {
+						return errors.New("failed to move 1 of 1 worktrees")
+					},
+				}
+			},
+			assertMocks: func(t *testing.T, mock *worktreeRootRelocatorMock) {
+				assert.Len(t, mock.RelocateWorktreeRootCalls(), 1)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "failed to relocate worktrees")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := tt.mockSetup()
+			err := handleRelocate(mock, func(string) bool { return tt.confirm }, "../gbm-worktrees", tt.dryRun)
+
+			tt.assertMocks(t, mock)
+			tt.assertErr(t, err)
+		})
+	}
+}
@@ -3,7 +3,7 @@ package cmd
 import (
 	"errors"
 	"fmt"
-	"strings"
+	"path/filepath"
 
 	"gbm/internal"
 
@@ -17,35 +17,62 @@ type worktreeRemover interface {
 	GetWorktreePath(worktreeName string) (string, error)
 	GetWorktreeStatus(worktreePath string) (*internal.GitStatus, error)
 	RemoveWorktree(worktreeName string) error
+	RemoveWorktreeWithoutForce(worktreeName string) error
+	RemoveArchivedWorktree(worktreeName string) error
 	GetAllWorktrees() (map[string]*internal.WorktreeListInfo, error)
+	ArchiveWorktree(worktreeName, destDir string) error
+	GetWorktreeCurrentBranch(worktreePath string) (string, error)
+	GetDefaultRemote() string
+	DeleteLocalBranch(branchName string, force bool) error
+	DeleteRemoteBranch(remoteName, branchName string) error
+}
+
+// removeOptions holds the flags for gbm remove
+type removeOptions struct {
+	// Force skips the uncommitted changes check and confirmation, and deletes unmerged branches
+	Force bool
+	// ArchiveDir, when set, archives the worktree there before removing it
+	ArchiveDir string
+	// DeleteBranch deletes the worktree's local branch after removing it
+	DeleteBranch bool
+	// DeleteRemote deletes the worktree's branch on the default remote after removing it
+	DeleteRemote bool
 }
 
 // confirmationFunc is a function type for confirming actions
 type confirmationFunc func(worktreeName string) bool
 
-// defaultConfirmation is the default confirmation function that prompts the user
-func defaultConfirmation(worktreeName string) bool {
-	fmt.Printf("Are you sure you want to remove worktree '%s'? [y/N]: ", worktreeName)
-	var response string
-	_, _ = fmt.Scanln(&response)
-	return strings.ToLower(response) == "y" || strings.ToLower(response) == "yes"
-}
-
-// handleRemove handles the removal of a worktree with the specified options
-func handleRemove(remover worktreeRemover, worktreeName string, force bool) error {
-	return handleRemoveWithConfirmation(remover, worktreeName, force, defaultConfirmation)
+// worktreeConfirmation asks confirm whether to remove the named worktree
+func worktreeConfirmation(confirm internal.ConfirmationFunc) confirmationFunc {
+	return func(worktreeName string) bool {
+		return confirm(fmt.Sprintf("Are you sure you want to remove worktree '%s'?", worktreeName))
+	}
 }
 
-// handleRemoveWithConfirmation handles the removal with a custom confirmation function
-func handleRemoveWithConfirmation(remover worktreeRemover, worktreeName string, force bool, confirm confirmationFunc) error {
+// handleRemoveWithConfirmation handles the removal of a worktree with the specified options, asking confirm
+// first unless opts.Force is set. When opts.ArchiveDir is set the worktree is archived there first, which also
+// allows removing it with uncommitted changes.
+func handleRemoveWithConfirmation(remover worktreeRemover, worktreeName string, opts removeOptions, confirm confirmationFunc) error {
 	// Check if worktree exists
 	worktreePath, err := remover.GetWorktreePath(worktreeName)
 	if err != nil {
 		return fmt.Errorf("worktree '%s' not found: %w", worktreeName, err)
 	}
 
-	// Check if worktree has uncommitted changes (unless force is used)
-	if !force {
+	// Resolve the branch up front; it can't be read once the worktree is gone
+	branchName := ""
+	if opts.DeleteBranch || opts.DeleteRemote {
+		branchName, err = remover.GetWorktreeCurrentBranch(worktreePath)
+		if err != nil {
+			return fmt.Errorf("failed to determine branch of worktree '%s': %w", worktreeName, err)
+		}
+		if branchName == "HEAD" {
+			return fmt.Errorf("worktree '%s' is in detached HEAD state, so there is no branch to delete", worktreeName)
+		}
+	}
+
+	// Check if worktree has uncommitted changes (unless force is used or they are archived first)
+	if !opts.Force && opts.ArchiveDir == "" {
 		gitStatus, err := remover.GetWorktreeStatus(worktreePath)
 		if err != nil {
 			return fmt.Errorf("failed to check worktree status: %w", err)
@@ -57,23 +84,62 @@ func handleRemoveWithConfirmation(remover worktreeRemover, worktreeName string,
 	}
 
 	// Confirm removal (unless force is used)
-	if !force {
+	if !opts.Force {
 		if !confirm(worktreeName) {
 			PrintInfo("Removal cancelled")
 			return nil
 		}
 	}
 
-	// Remove the worktree
-	if err := remover.RemoveWorktree(worktreeName); err != nil {
-		return fmt.Errorf("failed to remove worktree: %w", err)
+	if opts.ArchiveDir != "" {
+		if err := remover.ArchiveWorktree(worktreeName, opts.ArchiveDir); err != nil {
+			return fmt.Errorf("failed to archive worktree, not removing it: %w", err)
+		}
+	}
+
+	// Remove the worktree, letting git refuse if changes appeared since the status check. Archived
+	// changes are safe to discard, but only --force overrides a failing pre_remove hook.
+	switch {
+	case opts.Force:
+		if err := remover.RemoveWorktree(worktreeName); err != nil {
+			return fmt.Errorf("failed to remove worktree: %w", err)
+		}
+	case opts.ArchiveDir != "":
+		if err := remover.RemoveArchivedWorktree(worktreeName); err != nil {
+			return fmt.Errorf("failed to remove worktree: %w", err)
+		}
+	default:
+		if err := remover.RemoveWorktreeWithoutForce(worktreeName); err != nil {
+			if errors.Is(err, internal.ErrWorktreeDirty) {
+				return fmt.Errorf("worktree '%s' has uncommitted changes. Use --force to remove anyway", worktreeName)
+			}
+			return fmt.Errorf("failed to remove worktree: %w", err)
+		}
 	}
 
 	PrintInfo("Worktree '%s' removed successfully", worktreeName)
+
+	if opts.DeleteBranch {
+		if err := remover.DeleteLocalBranch(branchName, opts.Force); err != nil {
+			if !opts.Force {
+				return fmt.Errorf("%w. Use --force to delete an unmerged branch", err)
+			}
+			return err
+		}
+		PrintInfo("Deleted branch '%s'", branchName)
+	}
+
+	if opts.DeleteRemote {
+		remoteName := remover.GetDefaultRemote()
+		if err := remover.DeleteRemoteBranch(remoteName, branchName); err != nil {
+			return err
+		}
+		PrintInfo("Deleted remote branch '%s/%s'", remoteName, branchName)
+	}
+
 	return nil
 }
 
-
 func newRemoveCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "remove <worktree-name>",
@@ -82,13 +148,29 @@ func newRemoveCommand() *cobra.Command {
 
 This command removes the specified worktree and its associated directory.
 If the worktree contains uncommitted changes, use --force to remove anyway.
+Configured pre_remove hooks run first and abort the removal if they fail,
+unless --force is given; use --no-hooks to skip them.
+
+With --archive, the worktree's uncommitted changes, untracked files and a patch
+against its base branch are saved to a tarball in .gbm/archives before removal,
+so worktrees with uncommitted changes can be removed without losing work.
+
+With --delete-branch, the worktree's branch is deleted afterwards. Like git branch -d,
+this refuses to delete a branch that is not fully merged unless --force is given.
+With --delete-remote, the branch is also deleted on the default remote.
 
 Examples:
   gbm remove FEATURE-123
-  gbm remove FEATURE-123 --force`,
+  gbm remove FEATURE-123 --force
+  gbm remove FEATURE-123 --archive
+  gbm remove FEATURE-123 --delete-branch --delete-remote`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			force, _ := cmd.Flags().GetBool("force")
+			noHooks, _ := cmd.Flags().GetBool("no-hooks")
+			archive, _ := cmd.Flags().GetBool("archive")
+			deleteBranch, _ := cmd.Flags().GetBool("delete-branch")
+			deleteRemote, _ := cmd.Flags().GetBool("delete-remote")
 			worktreeName := args[0]
 
 			// Create manager
@@ -101,11 +183,28 @@ Examples:
 				PrintVerbose("%v", err)
 			}
 
-			return handleRemove(manager, worktreeName, force)
+			if noHooks {
+				manager.SetSkipHooks(true)
+			}
+
+			opts := removeOptions{
+				Force:        force,
+				DeleteBranch: deleteBranch,
+				DeleteRemote: deleteRemote,
+			}
+			if archive {
+				opts.ArchiveDir = filepath.Join(internal.GetGBMDir(manager.GetRepoPath()), "archives")
+			}
+
+			return handleRemoveWithConfirmation(manager, worktreeName, opts, worktreeConfirmation(newConfirmation(cmd)))
 		},
 	}
 
 	cmd.Flags().BoolP("force", "f", false, "Force removal even if worktree has uncommitted changes")
+	cmd.Flags().Bool("archive", false, "Save uncommitted changes, untracked files and a patch against the base branch to .gbm/archives before removing")
+	cmd.Flags().Bool("delete-branch", false, "Also delete the worktree's local branch (refuses unmerged branches unless --force)")
+	cmd.Flags().Bool("delete-remote", false, "Also delete the worktree's branch on the default remote")
+	cmd.Flags().Bool("no-hooks", false, "Skip the [hooks] pre_remove and post_remove commands from .gbm/config.toml")
 
 	// Add completion for worktree names
 	cmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"errors"
+	"fmt"
 	"testing"
 
 	"gbm/internal"
@@ -20,6 +21,9 @@ func TestHandleRemoveWithConfirmation(t *testing.T) {
 		name         string
 		worktreeName string
 		force        bool
+		archiveDir   string
+		deleteBranch bool
+		deleteRemote bool
 		confirmFunc  confirmationFunc
 		mockSetup    func() *worktreeRemoverMock
 		assertMocks  func(t *testing.T, mock *worktreeRemoverMock)
@@ -66,7 +70,7 @@ func TestHandleRemoveWithConfirmation(t *testing.T) {
 						assert.Equal(t, "/path/to/clean-worktree", worktreePath)
 						return &internal.GitStatus{}, nil // Clean status
 					},
-					RemoveWorktreeFunc: func(worktreeName string) error {
+					RemoveWorktreeWithoutForceFunc: func(worktreeName string) error {
 						assert.Equal(t, "clean-worktree", worktreeName)
 						return nil
 					},
@@ -75,7 +79,8 @@ func TestHandleRemoveWithConfirmation(t *testing.T) {
 			assertMocks: func(t *testing.T, mock *worktreeRemoverMock) {
 				assert.Len(t, mock.GetWorktreePathCalls(), 1)
 				assert.Len(t, mock.GetWorktreeStatusCalls(), 1)
-				assert.Len(t, mock.RemoveWorktreeCalls(), 1)
+				assert.Len(t, mock.RemoveWorktreeCalls(), 0) // Force removal only with --force
+				assert.Len(t, mock.RemoveWorktreeWithoutForceCalls(), 1)
 			},
 			assertErr: func(t *testing.T, err error) {
 				assert.NoError(t, err)
@@ -164,6 +169,34 @@ func TestHandleRemoveWithConfirmation(t *testing.T) {
 				assert.Contains(t, err.Error(), "Use --force to remove anyway")
 			},
 		},
+		{
+			name:         "error - git refuses removal of dirty worktree",
+			worktreeName: "racy-worktree",
+			force:        false,
+			confirmFunc:  func(worktreeName string) bool { return true },
+			mockSetup: func() *worktreeRemoverMock {
+				return &worktreeRemoverMock{
+					GetWorktreePathFunc: func(worktreeName string) (string, error) {
+						return "/path/to/racy-worktree", nil
+					},
+					GetWorktreeStatusFunc: func(worktreePath string) (*internal.GitStatus, error) {
+						return &internal.GitStatus{}, nil // Clean at check time
+					},
+					RemoveWorktreeWithoutForceFunc: func(worktreeName string) error {
+						return fmt.Errorf("failed to remove worktree: %w", internal.ErrWorktreeDirty)
+					},
+				}
+			},
+			assertMocks: func(t *testing.T, mock *worktreeRemoverMock) {
+				assert.Len(t, mock.RemoveWorktreeWithoutForceCalls(), 1)
+				assert.Len(t, mock.RemoveWorktreeCalls(), 0) // Must not fall back to force
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), "has uncommitted changes")
+				assert.Contains(t, err.Error(), "Use --force to remove anyway")
+			},
+		},
 		{
 			name:         "error - status check fails",
 			worktreeName: "status-error",
@@ -218,12 +251,215 @@ func TestHandleRemoveWithConfirmation(t *testing.T) {
 				assert.Contains(t, err.Error(), "failed to remove worktree")
 			},
 		},
+		{
+			name:         "success - archive allows removing worktree with uncommitted changes",
+			worktreeName: "dirty-worktree",
+			archiveDir:   "/repo/.gbm/archives",
+			confirmFunc:  func(worktreeName string) bool { return true },
+			mockSetup: func() *worktreeRemoverMock {
+				return &worktreeRemoverMock{
+					GetWorktreePathFunc: func(worktreeName string) (string, error) {
+						return "/path/to/dirty-worktree", nil
+					},
+					ArchiveWorktreeFunc: func(worktreeName, destDir string) error {
+						assert.Equal(t, "dirty-worktree", worktreeName)
+						assert.Equal(t, "/repo/.gbm/archives", destDir)
+						return nil
+					},
+					RemoveArchivedWorktreeFunc: func(worktreeName string) error {
+						return nil
+					},
+				}
+			},
+			assertMocks: func(t *testing.T, mock *worktreeRemoverMock) {
+				assert.Len(t, mock.GetWorktreeStatusCalls(), 0) // Changes are archived instead
+				assert.Len(t, mock.ArchiveWorktreeCalls(), 1)
+				assert.Len(t, mock.RemoveArchivedWorktreeCalls(), 1)
+				assert.Len(t, mock.RemoveWorktreeCalls(), 0) // Archiving doesn't override pre_remove hooks
+				assert.Len(t, mock.RemoveWorktreeWithoutForceCalls(), 0)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:         "error - failing pre_remove hook aborts an archived removal",
+			worktreeName: "dirty-worktree",
+			archiveDir:   "/repo/.gbm/archives",
+			confirmFunc:  func(worktreeName string) bool { return true },
+			mockSetup: func() *worktreeRemoverMock {
+				return &worktreeRemoverMock{
+					GetWorktreePathFunc: func(worktreeName string) (string, error) {
+						return "/path/to/dirty-worktree", nil
+					},
+					ArchiveWorktreeFunc: func(worktreeName, destDir string) error {
+						return nil
+					},
+					RemoveArchivedWorktreeFunc: func(worktreeName string) error {
+						return errors.New("pre_remove hook 'exit 1' failed; use --force to remove anyway")
+					},
+				}
+			},
+			assertMocks: func(t *testing.T, mock *worktreeRemoverMock) {
+				assert.Len(t, mock.RemoveArchivedWorktreeCalls(), 1)
+				assert.Len(t, mock.RemoveWorktreeCalls(), 0)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "pre_remove hook")
+			},
+		},
+		{
+			name:         "error - archive failure keeps the worktree",
+			worktreeName: "dirty-worktree",
+			force:        true,
+			archiveDir:   "/repo/.gbm/archives",
+			mockSetup: func() *worktreeRemoverMock {
+				return &worktreeRemoverMock{
+					GetWorktreePathFunc: func(worktreeName string) (string, error) {
+						return "/path/to/dirty-worktree", nil
+					},
+					ArchiveWorktreeFunc: func(worktreeName, destDir string) error {
+						return errors.New("permission denied")
+					},
+				}
+			},
+			assertMocks: func(t *testing.T, mock *worktreeRemoverMock) {
+				assert.Len(t, mock.ArchiveWorktreeCalls(), 1)
+				assert.Len(t, mock.RemoveWorktreeCalls(), 0)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "not removing it")
+			},
+		},
+		{
+			name:         "cancelled - nothing is archived when removal is declined",
+			worktreeName: "dirty-worktree",
+			archiveDir:   "/repo/.gbm/archives",
+			confirmFunc:  func(worktreeName string) bool { return false },
+			mockSetup: func() *worktreeRemoverMock {
+				return &worktreeRemoverMock{
+					GetWorktreePathFunc: func(worktreeName string) (string, error) {
+						return "/path/to/dirty-worktree", nil
+					},
+				}
+			},
+			assertMocks: func(t *testing.T, mock *worktreeRemoverMock) {
+				assert.Len(t, mock.ArchiveWorktreeCalls(), 0)
+				assert.Len(t, mock.RemoveWorktreeCalls(), 0)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:         "success - deletes local and remote branch after removal",
+			worktreeName: "feature",
+			force:        true,
+			deleteBranch: true,
+			deleteRemote: true,
+			mockSetup: func() *worktreeRemoverMock {
+				return &worktreeRemoverMock{
+					GetWorktreePathFunc: func(worktreeName string) (string, error) {
+						return "/path/to/feature", nil
+					},
+					GetWorktreeCurrentBranchFunc: func(worktreePath string) (string, error) {
+						assert.Equal(t, "/path/to/feature", worktreePath)
+						return "feature/PROJ-1", nil
+					},
+					RemoveWorktreeFunc: func(worktreeName string) error {
+						return nil
+					},
+					DeleteLocalBranchFunc: func(branchName string, force bool) error {
+						assert.Equal(t, "feature/PROJ-1", branchName)
+						assert.True(t, force)
+						return nil
+					},
+					GetDefaultRemoteFunc: func() string { return "origin" },
+					DeleteRemoteBranchFunc: func(remoteName, branchName string) error {
+						assert.Equal(t, "origin", remoteName)
+						assert.Equal(t, "feature/PROJ-1", branchName)
+						return nil
+					},
+				}
+			},
+			assertMocks: func(t *testing.T, mock *worktreeRemoverMock) {
+				assert.Len(t, mock.RemoveWorktreeCalls(), 1)
+				assert.Len(t, mock.DeleteLocalBranchCalls(), 1)
+				assert.Len(t, mock.DeleteRemoteBranchCalls(), 1)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name:         "error - unmerged branch is kept without force",
+			worktreeName: "feature",
+			deleteBranch: true,
+			confirmFunc:  func(worktreeName string) bool { return true },
+			mockSetup: func() *worktreeRemoverMock {
+				return &worktreeRemoverMock{
+					GetWorktreePathFunc: func(worktreeName string) (string, error) {
+						return "/path/to/feature", nil
+					},
+					GetWorktreeCurrentBranchFunc: func(worktreePath string) (string, error) {
+						return "feature", nil
+					},
+					GetWorktreeStatusFunc: func(worktreePath string) (*internal.GitStatus, error) {
+						return &internal.GitStatus{}, nil
+					},
+					RemoveWorktreeWithoutForceFunc: func(worktreeName string) error {
+						return nil
+					},
+					DeleteLocalBranchFunc: func(branchName string, force bool) error {
+						assert.False(t, force)
+						return errors.New("failed to delete branch 'feature': error: the branch 'feature' is not fully merged")
+					},
+				}
+			},
+			assertMocks: func(t *testing.T, mock *worktreeRemoverMock) {
+				assert.Len(t, mock.RemoveWorktreeWithoutForceCalls(), 1)
+				assert.Len(t, mock.DeleteLocalBranchCalls(), 1)
+				assert.Len(t, mock.DeleteRemoteBranchCalls(), 0)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "not fully merged")
+				assert.ErrorContains(t, err, "Use --force")
+			},
+		},
+		{
+			name:         "error - detached HEAD has no branch to delete",
+			worktreeName: "detached",
+			force:        true,
+			deleteRemote: true,
+			mockSetup: func() *worktreeRemoverMock {
+				return &worktreeRemoverMock{
+					GetWorktreePathFunc: func(worktreeName string) (string, error) {
+						return "/path/to/detached", nil
+					},
+					GetWorktreeCurrentBranchFunc: func(worktreePath string) (string, error) {
+						return "HEAD", nil
+					},
+				}
+			},
+			assertMocks: func(t *testing.T, mock *worktreeRemoverMock) {
+				assert.Len(t, mock.RemoveWorktreeCalls(), 0)
+				assert.Len(t, mock.DeleteRemoteBranchCalls(), 0)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "detached HEAD")
+			},
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mock := tt.mockSetup()
-			err := handleRemoveWithConfirmation(mock, tt.worktreeName, tt.force, tt.confirmFunc)
+			err := handleRemoveWithConfirmation(mock, tt.worktreeName, removeOptions{
+				Force:        tt.force,
+				ArchiveDir:   tt.archiveDir,
+				DeleteBranch: tt.deleteBranch,
+				DeleteRemote: tt.deleteRemote,
+			}, tt.confirmFunc)
 
 			// Assert mock calls
 			tt.assertMocks(t, mock)
@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"fmt"
+
+	"gbm/internal"
+
+	"github.com/spf13/cobra"
+)
+
+//go:generate go run github.com/matryer/moq@latest -out ./autogen_remoteHeadRepairer.go . remoteHeadRepairer
+
+// remoteHeadRepairer interface abstracts the Manager operations needed to repair the remote HEAD ref
+type remoteHeadRepairer interface {
+	GetDefaultRemote() string
+	SetRemoteHead() error
+	GetDefaultBranch() (string, error)
+}
+
+func newRepairHeadCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "repair-head",
+		Short: "Set the remote HEAD ref so the default branch is detected reliably",
+		Long: `Query the default remote for its HEAD branch and record it locally as
+refs/remotes/<remote>/HEAD (git remote set-head <remote> --auto).
+
+gbm uses this ref to find the repository's default branch. Clones made without it
+fall back to probing main, master and develop, which is slower and can pick the
+wrong branch.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manager, err := createInitializedManager()
+			if err != nil {
+				return err
+			}
+
+			return handleRepairHead(manager)
+		},
+	}
+}
+
+func handleRepairHead(repairer remoteHeadRepairer) error {
+	remote := repairer.GetDefaultRemote()
+	PrintInfo("Querying %s for its default branch...", remote)
+
+	if err := repairer.SetRemoteHead(); err != nil {
+		return err
+	}
+
+	defaultBranch, err := repairer.GetDefaultBranch()
+	if err != nil {
+		return fmt.Errorf("failed to read default branch: %w", err)
+	}
+
+	PrintInfo("%s", internal.FormatSuccess(fmt.Sprintf("%s/HEAD now points to %s/%s", remote, remote, defaultBranch)))
+	return nil
+}
@@ -0,0 +1,61 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleRepairHead(t *testing.T) {
+	tests := []struct {
+		name        string
+		mockSetup   func() *remoteHeadRepairerMock
+		assertMocks func(t *testing.T, mock *remoteHeadRepairerMock)
+		assertErr   func(t *testing.T, err error)
+	}{
+		{
+			name: "sets remote head and reports the default branch",
+			mockSetup: func() *remoteHeadRepairerMock {
+				return &remoteHeadRepairerMock{
+					GetDefaultRemoteFunc: func() string { return "origin" },
+					SetRemoteHeadFunc:    func() error { return nil },
+					GetDefaultBranchFunc: func() (string, error) { return "main", nil },
+				}
+			},
+			assertMocks: func(t *testing.T, mock *remoteHeadRepairerMock) {
+				assert.Len(t, mock.SetRemoteHeadCalls(), 1)
+				assert.Len(t, mock.GetDefaultBranchCalls(), 1)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "set-head failure is returned",
+			mockSetup: func() *remoteHeadRepairerMock {
+				return &remoteHeadRepairerMock{
+					GetDefaultRemoteFunc: func() string { return "origin" },
+					SetRemoteHeadFunc: func() error {
+						return errors.New("failed to set origin/HEAD: could not read from remote repository")
+					},
+				}
+			},
+			assertMocks: func(t *testing.T, mock *remoteHeadRepairerMock) {
+				assert.Len(t, mock.GetDefaultBranchCalls(), 0)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "could not read from remote")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := tt.mockSetup()
+			err := handleRepairHead(mock)
+			tt.assertMocks(t, mock)
+			tt.assertErr(t, err)
+		})
+	}
+}
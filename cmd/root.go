@@ -24,6 +24,9 @@ and worktrees based on configuration defined in gbm.branchconfig.yaml.
 
 The tool synchronizes local worktrees with branch definitions and provides
 notifications when configurations drift out of sync.`,
+		// main reports the returned error once; cobra's usage dump and "Error:" line would repeat it
+		SilenceUsage:  true,
+		SilenceErrors: true,
 		PersistentPreRun: func(cmd *cobra.Command, args []string) {
 			InitializeLogging(cmd)
 			checkAndDisplayMergeBackAlerts()
@@ -33,6 +36,7 @@ notifications when configurations drift out of sync.`,
 	// Add persistent flags
 	rootCmd.PersistentFlags().String("worktree-dir", "", "override worktree directory location")
 	rootCmd.PersistentFlags().Bool("debug", false, "enable debug logging to ./gbm.log")
+	rootCmd.PersistentFlags().BoolP("yes", "y", false, "answer yes to every confirmation prompt, for scripts")
 
 	// Create manager for commands that need it
 	manager, err := createInitializedManager()
@@ -50,33 +54,86 @@ notifications when configurations drift out of sync.`,
 	// Add all subcommands
 	rootCmd.AddCommand(newAddCommand(manager))
 	rootCmd.AddCommand(newPushCommand())
+	rootCmd.AddCommand(newCherryPickCommand())
 	rootCmd.AddCommand(newCloneCommand())
 	rootCmd.AddCommand(newInitCommand())
 	rootCmd.AddCommand(completionCmd)
+	rootCmd.AddCommand(newConfigCommand())
+	rootCmd.AddCommand(newCopyFilesCommand())
+	rootCmd.AddCommand(newDoctorCommand())
+	rootCmd.AddCommand(newEnvCommand())
+	rootCmd.AddCommand(newExecCommand())
 	rootCmd.AddCommand(newHotfixCommand())
 	rootCmd.AddCommand(newInfoCommand())
 	rootCmd.AddCommand(newListCommand())
+	rootCmd.AddCommand(newLogCommand())
 	rootCmd.AddCommand(newMergebackCommand())
+	rootCmd.AddCommand(newOpenCommand())
+	rootCmd.AddCommand(newPathCommand())
+	rootCmd.AddCommand(newPRCommand())
+	rootCmd.AddCommand(newPruneCommand())
 	rootCmd.AddCommand(newPullCommand())
+	rootCmd.AddCommand(newRebaseCommand())
+	rootCmd.AddCommand(newRelocateCommand())
 	rootCmd.AddCommand(newRemoveCommand())
+	rootCmd.AddCommand(newRepairHeadCommand())
 	rootCmd.AddCommand(shellIntegrationCmd)
+	rootCmd.AddCommand(newShellInitCommand())
+	rootCmd.AddCommand(newStatusCommand())
 	rootCmd.AddCommand(newSwitchCommand())
 	rootCmd.AddCommand(newSyncCommand())
+	rootCmd.AddCommand(newTrackCommand())
+	rootCmd.AddCommand(newTreeCommand())
+	rootCmd.AddCommand(newUntrackCommand())
 	rootCmd.AddCommand(newValidateCommand())
+	rootCmd.AddCommand(newWhereamiCommand())
 
 	return rootCmd
 }
 
+// Process exit codes returned by ExitCode
+const (
+	ExitCodeError = 1
+	ExitCodeDrift = 2
+)
+
 func Execute() error {
 	return newRootCommand().Execute()
 }
 
+// ExitCode maps an error returned by Execute to the process exit code
+func ExitCode(err error) int {
+	var execErr *execExitError
+	if errors.As(err, &execErr) {
+		return execErr.code
+	}
+	if errors.Is(err, ErrSyncDrift) || errors.Is(err, ErrNeedsAttention) {
+		return ExitCodeDrift
+	}
+	return ExitCodeError
+}
+
+// ReportError prints an error returned by Execute. The drift and needs-attention sentinels are
+// not printed since the command has already shown its report and only the exit code remains.
+func ReportError(err error) {
+	if errors.Is(err, ErrSyncDrift) || errors.Is(err, ErrNeedsAttention) {
+		return
+	}
+	PrintError("%v", err)
+}
+
 func isDebugEnabled(cmd *cobra.Command) bool {
 	debug, _ := cmd.Flags().GetBool("debug")
 	return debug
 }
 
 func InitializeLogging(cmd *cobra.Command) {
+	internal.SetLogger(internal.Logger{
+		Debug: PrintVerbose,
+		Info:  PrintInfo,
+		Warn:  PrintWarning,
+	})
+
 	if isDebugEnabled(cmd) {
 		var err error
 		logFile, err = tea.LogToFile("gbm.log", "gbm")
@@ -109,6 +166,16 @@ func PrintVerbose(format string, args ...any) {
 	}
 }
 
+func PrintWarning(format string, args ...any) {
+	msg := fmt.Sprintf(format, args...)
+	fmt.Fprintf(os.Stderr, "%s\n", internal.FormatWarning("Warning: "+msg))
+	if logFile != nil {
+		_, file, line, _ := runtime.Caller(1)
+		timestamp := time.Now().Format("2006-01-02T15:04:05.000")
+		_, _ = fmt.Fprintf(logFile, "%s [WARN] %s:%d %s\n", timestamp, file, line, msg)
+	}
+}
+
 func PrintError(format string, args ...any) {
 	msg := fmt.Sprintf(format, args...)
 	fmt.Fprintf(os.Stderr, "%s\n", internal.FormatError("ERROR: "+msg))
@@ -1,6 +1,9 @@
 package cmd
 
 import (
+	"bytes"
+	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 	"testing"
@@ -237,3 +240,71 @@ func TestUpdateLastMergebackCheck(t *testing.T) {
 		"Updated timestamp %v should be after function call time %v",
 		updatedState.LastMergebackCheck, beforeUpdate)
 }
+
+func TestExitCode(t *testing.T) {
+	assert.Equal(t, ExitCodeDrift, ExitCode(ErrSyncDrift))
+	assert.Equal(t, ExitCodeDrift, ExitCode(fmt.Errorf("dry run: %w", ErrSyncDrift)))
+	assert.Equal(t, ExitCodeDrift, ExitCode(ErrNeedsAttention))
+	assert.Equal(t, ExitCodeError, ExitCode(errors.New("git fetch failed")))
+}
+
+func TestRootCommand_DriftPrintsNoUsage(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+	require.NoError(t, repo.CreateBranch("dev", "dev content"))
+	require.NoError(t, repo.SwitchToBranch("main"))
+	require.NoError(t, repo.CreateGBMConfig(map[string]testutils.WorktreeConfig{
+		"dev": {Branch: "dev"},
+	}))
+	require.NoError(t, repo.CommitChanges("Add gbm config"))
+	t.Chdir(repo.GetLocalPath())
+
+	for _, args := range [][]string{{"sync", "--dry-run"}, {"status"}} {
+		var out bytes.Buffer
+		cmd := newRootCommand()
+		cmd.SetArgs(args)
+		cmd.SetOut(&out)
+		cmd.SetErr(&out)
+		err := cmd.Execute()
+
+		assert.Equal(t, ExitCodeDrift, ExitCode(err), args)
+		assert.NotContains(t, out.String(), "Usage:", args)
+		assert.NotContains(t, out.String(), "Error:", args)
+	}
+}
+
+func TestReportError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected string
+	}{
+		{name: "sync drift is not printed", err: fmt.Errorf("dry run: %w", ErrSyncDrift)},
+		{name: "needs attention is not printed", err: ErrNeedsAttention},
+		{name: "other errors are printed once", err: errors.New("git fetch failed"), expected: "ERROR: git fetch failed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			stderr := os.Stderr
+			r, w, _ := os.Pipe()
+			os.Stderr = w
+
+			ReportError(tt.err)
+
+			_ = w.Close()
+			os.Stderr = stderr
+			_, _ = buf.ReadFrom(r)
+
+			if tt.expected == "" {
+				assert.Empty(t, buf.String())
+				return
+			}
+			assert.Contains(t, buf.String(), tt.expected)
+			assert.NotContains(t, buf.String(), "Error:")
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+const posixShellInit = `# gbm shell function: cd into a worktree
+gbmcd() {
+    if [ $# -ne 1 ]; then
+        echo "usage: gbmcd <worktree>" >&2
+        return 1
+    fi
+
+    local target_dir
+    target_dir=$(command gbm path "$1") || return
+    cd "$target_dir"
+}
+`
+
+const bashShellInitCompletion = `_gbmcd() {
+    local cur=${COMP_WORDS[COMP_CWORD]}
+    COMPREPLY=($(compgen -W "$(command gbm __complete path "" 2>/dev/null | grep -v '^:' | cut -f1)" -- "$cur"))
+}
+complete -F _gbmcd gbmcd
+`
+
+const zshShellInitCompletion = `(( $+functions[compdef] )) && compdef '_gbm_gbmcd' gbmcd
+_gbm_gbmcd() {
+    compadd -- ${(f)"$(command gbm __complete path "" 2>/dev/null | grep -v '^:' | cut -f1)"}
+}
+`
+
+const fishShellInit = `# gbm shell function: cd into a worktree
+function gbmcd --description 'cd into a gbm worktree'
+    if test (count $argv) -ne 1
+        echo "usage: gbmcd <worktree>" >&2
+        return 1
+    end
+
+    set -l target_dir (command gbm path $argv[1]); or return
+    cd $target_dir
+end
+
+complete -c gbmcd -f -a '(command gbm __complete path "" 2>/dev/null | string match -v ":*")'
+`
+
+func newShellInitCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:       "shell-init <bash|zsh|fish>",
+		Short:     "Print a gbmcd shell function for changing into worktrees",
+		ValidArgs: []string{"bash", "zsh", "fish"},
+		Args:      cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+		Long: `Print a gbmcd shell function that changes the current shell into a worktree.
+
+A program can't change its parent shell's directory, so gbmcd wraps 'gbm path'
+and runs cd itself. Worktree names are completed.
+
+Add to your shell configuration:
+  eval "$(gbm shell-init bash)"     # ~/.bashrc
+  eval "$(gbm shell-init zsh)"      # ~/.zshrc
+  gbm shell-init fish | source      # ~/.config/fish/config.fish`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			script, err := shellInitScript(args[0])
+			if err != nil {
+				return err
+			}
+
+			fmt.Print(script)
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// shellInitScript returns the gbmcd function definition for the given shell
+func shellInitScript(shell string) (string, error) {
+	switch shell {
+	case "bash":
+		return posixShellInit + bashShellInitCompletion, nil
+	case "zsh":
+		return posixShellInit + zshShellInitCompletion, nil
+	case "fish":
+		return fishShellInit, nil
+	default:
+		return "", fmt.Errorf("unsupported shell '%s' (supported: bash, zsh, fish)", shell)
+	}
+}
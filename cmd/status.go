@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	"gbm/internal"
+
+	"github.com/spf13/cobra"
+)
+
+// ErrNeedsAttention is returned by gbm status when drift, pending merge-backs or dirty worktrees are found
+var ErrNeedsAttention = errors.New("repository needs attention")
+
+//go:generate go run github.com/matryer/moq@latest -out ./autogen_repoStatusReporter.go . repoStatusReporter
+
+// repoStatusReporter interface abstracts the Manager operations needed for the status summary
+type repoStatusReporter interface {
+	GetSyncStatus() (*internal.SyncStatus, error)
+	CheckMergeBackStatus() (*internal.MergeBackStatus, error)
+	GetAllWorktrees() (map[string]*internal.WorktreeListInfo, error)
+}
+
+func newStatusCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "status",
+		Short: "Summarize worktree drift, pending merge-backs and dirty worktrees",
+		Long: `Summarize the health of the repository in one place.
+
+Reports worktrees that have drifted from gbm.branchconfig.yaml (missing, orphaned or on
+a different branch), tracked branches that still need a merge-back, and worktrees with
+uncommitted changes.
+
+Exits with status 0 when everything is clean, 2 when something needs attention, and 1 on error.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manager, err := createInitializedManager()
+			if err != nil {
+				return err
+			}
+
+			return handleStatus(manager)
+		},
+	}
+
+	return cmd
+}
+
+func handleStatus(reporter repoStatusReporter) error {
+	iconManager := internal.GetGlobalIconManager()
+	clean := true
+
+	syncStatus, err := reporter.GetSyncStatus()
+	if err != nil {
+		return fmt.Errorf("failed to get sync status: %w", err)
+	}
+
+	if syncStatus.InSync {
+		PrintInfo("%s", internal.FormatSuccess("All worktrees are in sync"))
+	} else {
+		clean = false
+		PrintInfo("%s", internal.FormatStatusIcon(iconManager.Warning(), "Worktrees are out of sync with gbm.branchconfig.yaml:"))
+		for _, name := range syncStatus.MissingWorktrees {
+			PrintInfo("  • %s: missing", name)
+		}
+		for _, name := range slices.Sorted(maps.Keys(syncStatus.BranchChanges)) {
+			change := syncStatus.BranchChanges[name]
+			PrintInfo("  • %s: %s → %s", name, change.OldBranch, change.NewBranch)
+		}
+		for _, name := range syncStatus.OrphanedWorktrees {
+			PrintInfo("  • %s: not in gbm.branchconfig.yaml", name)
+		}
+		PrintInfo("  Run 'gbm sync' to reconcile")
+	}
+
+	mergeBackStatus, err := reporter.CheckMergeBackStatus()
+	if err != nil {
+		PrintVerbose("Failed to check merge-back status: %v", err)
+		PrintInfo("%s", internal.FormatStatusIcon(iconManager.Warning(), "Could not check merge-back status"))
+	} else if alert := internal.FormatMergeBackAlert(mergeBackStatus); alert != "" {
+		clean = false
+		fmt.Fprint(os.Stderr, alert)
+	} else {
+		PrintInfo("%s", internal.FormatSuccess("No merge-backs needed"))
+	}
+
+	worktrees, err := reporter.GetAllWorktrees()
+	if err != nil {
+		return fmt.Errorf("failed to get worktrees: %w", err)
+	}
+
+	var dirty []string
+	for _, name := range slices.Sorted(maps.Keys(worktrees)) {
+		if info := worktrees[name]; info.GitStatus != nil && info.GitStatus.HasChanges() {
+			dirty = append(dirty, name)
+		}
+	}
+
+	if len(dirty) == 0 {
+		PrintInfo("%s", internal.FormatSuccess("No worktrees have uncommitted changes"))
+	} else {
+		clean = false
+		PrintInfo("%s", internal.FormatStatusIcon(iconManager.Warning(), fmt.Sprintf("%d worktree(s) with uncommitted changes: %s", len(dirty), strings.Join(dirty, ", "))))
+	}
+
+	if !clean {
+		return ErrNeedsAttention
+	}
+	return nil
+}
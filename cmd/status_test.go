@@ -0,0 +1,181 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"gbm/internal"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleStatus(t *testing.T) {
+	cleanWorktrees := func() (map[string]*internal.WorktreeListInfo, error) {
+		return map[string]*internal.WorktreeListInfo{
+			"main": {GitStatus: &internal.GitStatus{}},
+		}, nil
+	}
+	inSync := func() (*internal.SyncStatus, error) {
+		return &internal.SyncStatus{InSync: true}, nil
+	}
+	noMergeBacks := func() (*internal.MergeBackStatus, error) {
+		return &internal.MergeBackStatus{}, nil
+	}
+
+	tests := []struct {
+		name      string
+		mockSetup func() *repoStatusReporterMock
+		assertErr func(t *testing.T, err error)
+	}{
+		{
+			name: "clean repository",
+			mockSetup: func() *repoStatusReporterMock {
+				return &repoStatusReporterMock{
+					GetSyncStatusFunc:        inSync,
+					CheckMergeBackStatusFunc: noMergeBacks,
+					GetAllWorktreesFunc:      cleanWorktrees,
+				}
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "sync drift needs attention",
+			mockSetup: func() *repoStatusReporterMock {
+				return &repoStatusReporterMock{
+					GetSyncStatusFunc: func() (*internal.SyncStatus, error) {
+						return &internal.SyncStatus{
+							InSync:            false,
+							MissingWorktrees:  []string{"dev"},
+							OrphanedWorktrees: []string{"old"},
+							BranchChanges: map[string]internal.BranchChange{
+								"prod": {OldBranch: "release/1", NewBranch: "release/2"},
+							},
+						}, nil
+					},
+					CheckMergeBackStatusFunc: noMergeBacks,
+					GetAllWorktreesFunc:      cleanWorktrees,
+				}
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, ErrNeedsAttention)
+			},
+		},
+		{
+			name: "pending merge-back needs attention",
+			mockSetup: func() *repoStatusReporterMock {
+				return &repoStatusReporterMock{
+					GetSyncStatusFunc: inSync,
+					CheckMergeBackStatusFunc: func() (*internal.MergeBackStatus, error) {
+						return &internal.MergeBackStatus{
+							MergeBacksNeeded: []internal.MergeBackInfo{
+								{FromBranch: "production", ToBranch: "main", TotalCount: 2},
+							},
+						}, nil
+					},
+					GetAllWorktreesFunc: cleanWorktrees,
+				}
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, ErrNeedsAttention)
+			},
+		},
+		{
+			name: "dirty worktree needs attention",
+			mockSetup: func() *repoStatusReporterMock {
+				return &repoStatusReporterMock{
+					GetSyncStatusFunc:        inSync,
+					CheckMergeBackStatusFunc: noMergeBacks,
+					GetAllWorktreesFunc: func() (map[string]*internal.WorktreeListInfo, error) {
+						return map[string]*internal.WorktreeListInfo{
+							"main": {GitStatus: &internal.GitStatus{}},
+							"feat": {GitStatus: &internal.GitStatus{Modified: 1}},
+							"gone": {GitStatusError: errors.New("path does not exist")},
+						}, nil
+					},
+				}
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, ErrNeedsAttention)
+			},
+		},
+		{
+			name: "merge-back check failure is not fatal",
+			mockSetup: func() *repoStatusReporterMock {
+				return &repoStatusReporterMock{
+					GetSyncStatusFunc: inSync,
+					CheckMergeBackStatusFunc: func() (*internal.MergeBackStatus, error) {
+						return nil, errors.New("git log failed")
+					},
+					GetAllWorktreesFunc: cleanWorktrees,
+				}
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "sync status error",
+			mockSetup: func() *repoStatusReporterMock {
+				return &repoStatusReporterMock{
+					GetSyncStatusFunc: func() (*internal.SyncStatus, error) {
+						return nil, errors.New("no gbm.branchconfig.yaml loaded")
+					},
+				}
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "failed to get sync status")
+				assert.NotErrorIs(t, err, ErrNeedsAttention)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := handleStatus(tt.mockSetup())
+			tt.assertErr(t, err)
+		})
+	}
+}
+
+func TestHandleStatus_BranchChangesSorted(t *testing.T) {
+	mock := &repoStatusReporterMock{
+		GetSyncStatusFunc: func() (*internal.SyncStatus, error) {
+			return &internal.SyncStatus{
+				BranchChanges: map[string]internal.BranchChange{
+					"uat":     {OldBranch: "release/1", NewBranch: "release/2"},
+					"prod":    {OldBranch: "release/0", NewBranch: "release/1"},
+					"preview": {OldBranch: "release/2", NewBranch: "release/3"},
+				},
+			}, nil
+		},
+		CheckMergeBackStatusFunc: func() (*internal.MergeBackStatus, error) {
+			return &internal.MergeBackStatus{}, nil
+		},
+		GetAllWorktreesFunc: func() (map[string]*internal.WorktreeListInfo, error) {
+			return map[string]*internal.WorktreeListInfo{}, nil
+		},
+	}
+
+	var buf bytes.Buffer
+	stderr := os.Stderr
+	r, w, _ := os.Pipe()
+	os.Stderr = w
+
+	err := handleStatus(mock)
+
+	_ = w.Close()
+	os.Stderr = stderr
+	_, _ = buf.ReadFrom(r)
+	output := buf.String()
+
+	assert.ErrorIs(t, err, ErrNeedsAttention)
+	preview := strings.Index(output, "preview: release/2")
+	prod := strings.Index(output, "prod: release/0")
+	uat := strings.Index(output, "uat: release/1")
+	assert.True(t, preview >= 0 && preview < prod && prod < uat, output)
+}
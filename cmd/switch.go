@@ -68,12 +68,7 @@ Examples:
 
 			// Handle special case of "-" to switch to previous worktree
 			if worktreeName == "-" {
-				previous := manager.GetPreviousWorktree()
-				if previous == "" {
-					return fmt.Errorf("no previous worktree available")
-				}
-				PrintInfo("Switching to previous worktree: %s", previous)
-				worktreeName = previous
+				return handleSwitchToPrevious(manager, printPath)
 			}
 
 			return handleSwitchToWorktree(manager, worktreeName, printPath)
@@ -137,6 +132,18 @@ func handleSwitchToWorktree(switcher worktreeSwitcher, worktreeName string, prin
 	return nil
 }
 
+// handleSwitchToPrevious switches to the previously visited worktree. Because switching
+// records the old current worktree as previous, repeated calls toggle like `cd -`.
+func handleSwitchToPrevious(switcher worktreeSwitcher, printPath bool) error {
+	previous := switcher.GetPreviousWorktree()
+	if previous == "" {
+		return fmt.Errorf("no previous worktree recorded. Switch to a worktree with 'gbm switch <name>' first")
+	}
+
+	PrintInfo("Switching to previous worktree: %s", previous)
+	return handleSwitchToWorktree(switcher, previous, printPath)
+}
+
 func findFuzzyMatch(switcher worktreeSwitcher, target string) string {
 	worktrees, err := switcher.GetAllWorktrees()
 	if err != nil {
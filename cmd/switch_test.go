@@ -125,6 +125,45 @@ func TestHandleSwitchToWorktree_FuzzyMatch(t *testing.T) {
 	}
 }
 
+func TestHandleSwitchToPrevious(t *testing.T) {
+	t.Run("no previous worktree recorded", func(t *testing.T) {
+		mock := &worktreeSwitcherMock{
+			GetPreviousWorktreeFunc: func() string {
+				return ""
+			},
+		}
+
+		err := handleSwitchToPrevious(mock, true)
+		assert.ErrorContains(t, err, "no previous worktree recorded")
+		assert.Len(t, mock.GetWorktreePathCalls(), 0)
+		assert.Len(t, mock.SetCurrentWorktreeCalls(), 0)
+	})
+
+	t.Run("repeated switches toggle between two worktrees", func(t *testing.T) {
+		current, previous := "dev", "main"
+		mock := &worktreeSwitcherMock{
+			GetPreviousWorktreeFunc: func() string {
+				return previous
+			},
+			GetWorktreePathFunc: func(worktreeName string) (string, error) {
+				return "/path/to/" + worktreeName, nil
+			},
+			SetCurrentWorktreeFunc: func(worktreeName string) error {
+				previous, current = current, worktreeName
+				return nil
+			},
+		}
+
+		assert.NoError(t, handleSwitchToPrevious(mock, true))
+		assert.Equal(t, "main", current)
+		assert.Equal(t, "dev", previous)
+
+		assert.NoError(t, handleSwitchToPrevious(mock, true))
+		assert.Equal(t, "dev", current)
+		assert.Equal(t, "main", previous)
+	})
+}
+
 func TestHandleListWorktrees(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -1,20 +1,27 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
-	"strings"
+	"os"
+	"os/signal"
 
 	"gbm/internal"
 
 	"github.com/spf13/cobra"
 )
 
+// ErrSyncDrift is returned by a dry run when worktrees do not match gbm.branchconfig.yaml.
+// The CLI exits with ExitCodeDrift for it so scripts can tell drift apart from failures.
+var ErrSyncDrift = errors.New("worktrees are out of sync with gbm.branchconfig.yaml")
+
 //go:generate go run github.com/matryer/moq@latest -out ./autogen_worktreeSyncer.go . worktreeSyncer
 
 // worktreeSyncer interface abstracts the Manager operations needed for sync operations
 type worktreeSyncer interface {
 	GetSyncStatus() (*internal.SyncStatus, error)
-	SyncWithConfirmation(dryRun, force bool, removeOrphans bool, confirmFunc internal.ConfirmationFunc) error
+	SyncWithConfirmation(opts internal.SyncOptions, confirmFunc internal.ConfirmationFunc) error
 }
 
 func newSyncCommand() *cobra.Command {
@@ -25,28 +32,79 @@ func newSyncCommand() *cobra.Command {
 
 Fetches from remote first, then creates missing worktrees for new worktree configurations,
 updates existing worktrees if branch references have changed. Use --remove-orphans to also
-remove untracked worktrees not defined in the configuration.`,
+remove untracked worktrees not defined in the configuration.
+
+Worktrees are recreated when their branch changes or they take part in a promotion, so sync
+refuses to run while any of them has uncommitted changes. Use --stash to stash those changes
+first and restore them on the new branch, or --reset-dirty to discard them.
+
+Use --no-fetch to skip the fetch when offline; sync then works from the remote branches
+as of the last fetch. Use --prune-remote to fetch with --prune, dropping remote-tracking
+branches that were deleted on the remote. A fetch that hangs is stopped after
+settings.fetch_timeout (5m by default), and Ctrl-C cancels it right away.
+
+Use --create-missing-branches to create configured branches that don't exist yet instead of
+failing. Each one starts from the worktree's base_branch in gbm.branchconfig.yaml, or the default
+branch, and is confirmed first unless --yes is given. settings.create_missing_branches in
+.gbm/config.toml turns this on for every sync.
+
+With --dry-run the command exits with status 0 when everything is in sync, 2 when
+drift was detected (missing worktrees, branch changes or orphaned worktrees), and 1 on error.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			syncDryRun, _ := cmd.Flags().GetBool("dry-run")
 			syncForce, _ := cmd.Flags().GetBool("force")
 			removeOrphans, _ := cmd.Flags().GetBool("remove-orphans")
+			stashDirty, _ := cmd.Flags().GetBool("stash")
+			resetDirty, _ := cmd.Flags().GetBool("reset-dirty")
+			noFetch, _ := cmd.Flags().GetBool("no-fetch")
+			pruneRemote, _ := cmd.Flags().GetBool("prune-remote")
+			createMissingBranches, _ := cmd.Flags().GetBool("create-missing-branches")
+			if stashDirty && resetDirty {
+				return fmt.Errorf("--stash and --reset-dirty cannot be used together")
+			}
+			if noFetch && pruneRemote {
+				return fmt.Errorf("--no-fetch and --prune-remote cannot be used together")
+			}
 
 			manager, err := createInitializedManager()
 			if err != nil {
 				return err
 			}
 
+			checkWorktreePrefixChange(cmd, manager, !syncDryRun)
+
 			if syncDryRun {
 				return handleSyncDryRun(manager, removeOrphans)
 			}
 
-			return handleSync(manager, syncForce, removeOrphans)
+			// Ctrl-C during the fetch cancels it cleanly; once the fetch is over, or after the first
+			// Ctrl-C, the default handling applies again
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt)
+			defer stop()
+			context.AfterFunc(ctx, stop)
+
+			return handleSync(manager, newConfirmation(cmd), internal.SyncOptions{
+				Force:                 syncForce,
+				RemoveOrphans:         removeOrphans,
+				StashDirty:            stashDirty,
+				ResetDirty:            resetDirty,
+				NoFetch:               noFetch,
+				PruneRemote:           pruneRemote,
+				CreateMissingBranches: createMissingBranches,
+				Context:               ctx,
+				AfterFetch:            stop,
+			})
 		},
 	}
 
 	cmd.Flags().Bool("dry-run", false, "show what would be changed without making changes")
 	cmd.Flags().Bool("force", false, "skip confirmation prompts for sync operations")
 	cmd.Flags().Bool("remove-orphans", false, "remove untracked worktrees not in gbm.branchconfig.yaml")
+	cmd.Flags().Bool("stash", false, "stash uncommitted changes in worktrees changing branch and restore them afterwards")
+	cmd.Flags().Bool("reset-dirty", false, "discard uncommitted changes in worktrees that sync recreates")
+	cmd.Flags().Bool("no-fetch", false, "skip fetching from the remote and sync against the last-fetched remote branches")
+	cmd.Flags().Bool("prune-remote", false, "fetch with --prune to drop remote-tracking branches deleted on the remote")
+	cmd.Flags().Bool("create-missing-branches", false, "create configured branches that don't exist from their base_branch or the default branch")
 
 	return cmd
 }
@@ -90,33 +148,52 @@ func handleSyncDryRun(syncer worktreeSyncer, removeOrphans bool) error {
 		}
 	}
 
-	if removeOrphans && len(status.OrphanedWorktrees) > 0 {
+	if len(status.DirtyWorktrees) > 0 {
 		iconManager := internal.GetGlobalIconManager()
-		PrintInfo("%s", internal.FormatStatusIcon(iconManager.Orphaned(), "Orphaned worktrees (will be removed):"))
+		PrintInfo("%s", internal.FormatStatusIcon(iconManager.Warning(), "Worktrees with uncommitted changes (use --stash or --reset-dirty to sync):"))
+		for _, name := range status.DirtyWorktrees {
+			PrintInfo("  • %s", name)
+		}
+	}
+
+	if len(status.OrphanedWorktrees) > 0 {
+		iconManager := internal.GetGlobalIconManager()
+		header := "Orphaned worktrees (use --remove-orphans to remove):"
+		if removeOrphans {
+			header = "Orphaned worktrees (will be removed):"
+		}
+		PrintInfo("%s", internal.FormatStatusIcon(iconManager.Orphaned(), header))
 		for _, envVar := range status.OrphanedWorktrees {
 			PrintInfo("  • %s", envVar)
 		}
 	}
 
-	return nil
+	return ErrSyncDrift
 }
 
-func handleSync(syncer worktreeSyncer, force bool, removeOrphans bool) error {
-	PrintVerbose("Synchronizing worktrees (force=%v)", force)
+// handleSync runs the sync, asking confirm before promotions and orphaned worktree removal
+func handleSync(syncer worktreeSyncer, confirmFunc internal.ConfirmationFunc, opts internal.SyncOptions) error {
+	PrintVerbose("Synchronizing worktrees (force=%v)", opts.Force)
 
-	// Create confirmation function for destructive operations
-	// Always provide confirmation for promotions; only for orphaned worktrees when force is used
-	confirmFunc := func(message string) bool {
-		fmt.Print(message + " [y/N]: ")
-		var response string
-		_, _ = fmt.Scanln(&response)
-		return strings.ToLower(response) == "y" || strings.ToLower(response) == "yes"
+	if opts.Progress == nil {
+		opts.Progress = syncStepPrinter{}
 	}
 
-	if err := syncer.SyncWithConfirmation(false, force, removeOrphans, confirmFunc); err != nil {
+	if opts.NoFetch {
+		PrintWarning("Skipping fetch; remote branches reflect the last fetch and may be out of date")
+	}
+
+	if err := syncer.SyncWithConfirmation(opts, confirmFunc); err != nil {
 		return err
 	}
 
 	PrintInfo("%s", internal.FormatSuccess("Successfully synchronized worktrees"))
 	return nil
 }
+
+// syncStepPrinter prints a line for each sync step so long syncs don't look hung
+type syncStepPrinter struct{}
+
+func (syncStepPrinter) SyncStep(step internal.SyncStep) {
+	PrintInfo("%s...", step)
+}
@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
@@ -15,6 +16,7 @@ func TestHandleSyncDryRun(t *testing.T) {
 		name        string
 		setupMock   func() *worktreeSyncerMock
 		expectError bool
+		expectDrift bool
 	}{
 		{
 			name: "all worktrees in sync returns no error",
@@ -28,7 +30,7 @@ func TestHandleSyncDryRun(t *testing.T) {
 			expectError: false,
 		},
 		{
-			name: "missing worktrees returns no error",
+			name: "missing worktrees returns drift error",
 			setupMock: func() *worktreeSyncerMock {
 				mock := &worktreeSyncerMock{}
 				mock.GetSyncStatusFunc = func() (*internal.SyncStatus, error) {
@@ -39,10 +41,11 @@ func TestHandleSyncDryRun(t *testing.T) {
 				}
 				return mock
 			},
-			expectError: false,
+			expectError: true,
+			expectDrift: true,
 		},
 		{
-			name: "branch changes returns no error",
+			name: "branch changes returns drift error",
 			setupMock: func() *worktreeSyncerMock {
 				mock := &worktreeSyncerMock{}
 				mock.GetSyncStatusFunc = func() (*internal.SyncStatus, error) {
@@ -55,10 +58,11 @@ func TestHandleSyncDryRun(t *testing.T) {
 				}
 				return mock
 			},
-			expectError: false,
+			expectError: true,
+			expectDrift: true,
 		},
 		{
-			name: "worktree promotions returns no error",
+			name: "worktree promotions returns drift error",
 			setupMock: func() *worktreeSyncerMock {
 				mock := &worktreeSyncerMock{}
 				mock.GetSyncStatusFunc = func() (*internal.SyncStatus, error) {
@@ -76,10 +80,11 @@ func TestHandleSyncDryRun(t *testing.T) {
 				}
 				return mock
 			},
-			expectError: false,
+			expectError: true,
+			expectDrift: true,
 		},
 		{
-			name: "orphaned worktrees returns no error",
+			name: "orphaned worktrees returns drift error",
 			setupMock: func() *worktreeSyncerMock {
 				mock := &worktreeSyncerMock{}
 				mock.GetSyncStatusFunc = func() (*internal.SyncStatus, error) {
@@ -90,7 +95,8 @@ func TestHandleSyncDryRun(t *testing.T) {
 				}
 				return mock
 			},
-			expectError: false,
+			expectError: true,
+			expectDrift: true,
 		},
 		{
 			name: "GetSyncStatus error is propagated",
@@ -108,10 +114,11 @@ func TestHandleSyncDryRun(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mock := tt.setupMock()
-			err := handleSyncDryRun(mock)
+			err := handleSyncDryRun(mock, false)
 
 			if tt.expectError {
 				assert.Error(t, err)
+				assert.Equal(t, tt.expectDrift, errors.Is(err, ErrSyncDrift))
 			} else {
 				assert.NoError(t, err)
 			}
@@ -134,7 +141,7 @@ func TestHandleSync(t *testing.T) {
 			force: false,
 			setupMock: func() *worktreeSyncerMock {
 				mock := &worktreeSyncerMock{}
-				mock.SyncWithConfirmationFunc = func(dryRun, force bool, confirmFunc internal.ConfirmationFunc) error {
+				mock.SyncWithConfirmationFunc = func(opts internal.SyncOptions, confirmFunc internal.ConfirmationFunc) error {
 					return nil
 				}
 				return mock
@@ -146,10 +153,10 @@ func TestHandleSync(t *testing.T) {
 			force: true,
 			setupMock: func() *worktreeSyncerMock {
 				mock := &worktreeSyncerMock{}
-				mock.SyncWithConfirmationFunc = func(dryRun, force bool, confirmFunc internal.ConfirmationFunc) error {
+				mock.SyncWithConfirmationFunc = func(opts internal.SyncOptions, confirmFunc internal.ConfirmationFunc) error {
 					// Verify parameters passed correctly
-					if dryRun != false || force != true {
-						return fmt.Errorf("incorrect parameters: dryRun=%v, force=%v", dryRun, force)
+					if opts.DryRun != false || opts.Force != true {
+						return fmt.Errorf("incorrect parameters: dryRun=%v, force=%v", opts.DryRun, opts.Force)
 					}
 					return nil
 				}
@@ -162,7 +169,7 @@ func TestHandleSync(t *testing.T) {
 			force: false,
 			setupMock: func() *worktreeSyncerMock {
 				mock := &worktreeSyncerMock{}
-				mock.SyncWithConfirmationFunc = func(dryRun, force bool, confirmFunc internal.ConfirmationFunc) error {
+				mock.SyncWithConfirmationFunc = func(opts internal.SyncOptions, confirmFunc internal.ConfirmationFunc) error {
 					return fmt.Errorf("sync failed")
 				}
 				return mock
@@ -174,7 +181,7 @@ func TestHandleSync(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mock := tt.setupMock()
-			err := handleSync(mock, tt.force)
+			err := handleSync(mock, internal.NeverConfirm, internal.SyncOptions{Force: tt.force})
 
 			if tt.expectError {
 				assert.Error(t, err)
@@ -188,9 +195,10 @@ func TestHandleSync(t *testing.T) {
 			// Verify parameters passed to mock
 			if len(mock.SyncWithConfirmationCalls()) > 0 {
 				call := mock.SyncWithConfirmationCalls()[0]
-				assert.False(t, call.DryRun, "DryRun should always be false in handleSync")
-				assert.Equal(t, tt.force, call.Force)
+				assert.False(t, call.Opts.DryRun, "DryRun should always be false in handleSync")
+				assert.Equal(t, tt.force, call.Opts.Force)
 				assert.NotNil(t, call.ConfirmFunc, "ConfirmFunc should not be nil")
+				assert.NotNil(t, call.Opts.Progress, "Progress should default to printing each step")
 			}
 		})
 	}
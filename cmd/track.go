@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"gbm/internal"
+
+	"github.com/spf13/cobra"
+)
+
+//go:generate go run github.com/matryer/moq@latest -out ./autogen_worktreeTracker.go . worktreeTracker
+
+// worktreeTracker interface abstracts the Manager operations needed for moving worktrees in and out of gbm.branchconfig.yaml
+type worktreeTracker interface {
+	TrackWorktree(worktreeName, branch, description, mergeInto string) error
+	UntrackWorktree(worktreeName string) error
+}
+
+func newTrackCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "track <worktree-name> <branch>",
+		Short: "Add an ad hoc worktree to gbm.branchconfig.yaml",
+		Long: `Add an existing ad hoc worktree to gbm.branchconfig.yaml so that 'gbm sync' manages it.
+
+The worktree is removed from the ad hoc list in .gbm/state.toml. Use --merge-into to place it
+in the mergeback chain under another tracked worktree.
+
+Examples:
+  gbm track experiment feature/experiment
+  gbm track hotfix hotfix/login --merge-into main --description "Login hotfix"`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			mergeInto, _ := cmd.Flags().GetString("merge-into")
+			description, _ := cmd.Flags().GetString("description")
+
+			manager, err := createTrackManager()
+			if err != nil {
+				return err
+			}
+
+			return handleTrack(manager, args[0], args[1], description, mergeInto)
+		},
+	}
+
+	cmd.Flags().String("merge-into", "", "tracked worktree this worktree merges into")
+	cmd.Flags().String("description", "", "description stored with the worktree entry")
+
+	cmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) != 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return getWorktreeCompletionsWithManager(), cobra.ShellCompDirectiveNoFileComp
+	}
+
+	_ = cmd.RegisterFlagCompletionFunc("merge-into", func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return getTrackedWorktreeCompletions(), cobra.ShellCompDirectiveNoFileComp
+	})
+
+	return cmd
+}
+
+func newUntrackCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "untrack <worktree-name>",
+		Short: "Remove a worktree from gbm.branchconfig.yaml and keep it as ad hoc",
+		Long: `Remove a worktree from gbm.branchconfig.yaml. Its directory is kept and listed as an ad hoc
+worktree, so 'gbm sync' no longer manages it.
+
+A worktree that other tracked worktrees merge into can't be untracked.
+
+Examples:
+  gbm untrack experiment`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manager, err := createTrackManager()
+			if err != nil {
+				return err
+			}
+
+			return handleUntrack(manager, args[0])
+		},
+	}
+
+	cmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) != 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return getTrackedWorktreeCompletions(), cobra.ShellCompDirectiveNoFileComp
+	}
+
+	return cmd
+}
+
+// createTrackManager loads the manager; a missing branch config is fine since track creates it
+func createTrackManager() (*internal.Manager, error) {
+	manager, err := createInitializedManager()
+	if err != nil {
+		if !errors.Is(err, ErrLoadGBMConfig) {
+			return nil, err
+		}
+
+		PrintVerbose("%v", err)
+	}
+
+	return manager, nil
+}
+
+func getTrackedWorktreeCompletions() []string {
+	manager, err := createInitializedManager()
+	if err != nil || manager.GetGBMConfig() == nil {
+		return nil
+	}
+
+	return slices.Sorted(maps.Keys(manager.GetGBMConfig().Worktrees))
+}
+
+func handleTrack(tracker worktreeTracker, worktreeName, branch, description, mergeInto string) error {
+	if err := tracker.TrackWorktree(worktreeName, branch, description, mergeInto); err != nil {
+		return fmt.Errorf("failed to track worktree: %w", err)
+	}
+
+	PrintInfo("%s", internal.FormatSuccess(fmt.Sprintf("Worktree '%s' is now tracked in %s on branch '%s'", worktreeName, internal.DefaultBranchConfigFilename, branch)))
+	return nil
+}
+
+func handleUntrack(tracker worktreeTracker, worktreeName string) error {
+	if err := tracker.UntrackWorktree(worktreeName); err != nil {
+		return fmt.Errorf("failed to untrack worktree: %w", err)
+	}
+
+	PrintInfo("%s", internal.FormatSuccess(fmt.Sprintf("Worktree '%s' removed from %s and kept as an ad hoc worktree", worktreeName, internal.DefaultBranchConfigFilename)))
+	return nil
+}
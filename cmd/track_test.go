@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleTrack(t *testing.T) {
+	tests := []struct {
+		name        string
+		mockSetup   func() *worktreeTrackerMock
+		assertMocks func(t *testing.T, mock *worktreeTrackerMock)
+		assertErr   func(t *testing.T, err error)
+	}{
+		{
+			name: "success - tracks worktree with merge target",
+			mockSetup: func() *worktreeTrackerMock {
+				return &worktreeTrackerMock{
+					TrackWorktreeFunc: func(worktreeName, branch, description, mergeInto string) error { return nil },
+				}
+			},
+			assertMocks: func(t *testing.T, mock *worktreeTrackerMock) {
+				calls := mock.TrackWorktreeCalls()
+				require.Len(t, calls, 1)
+				assert.Equal(t, "experiment", calls[0].WorktreeName)
+				assert.Equal(t, "feature/experiment", calls[0].Branch)
+				assert.Equal(t, "Experiment", calls[0].Description)
+				assert.Equal(t, "main", calls[0].MergeInto)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "error - track fails",
+			mockSetup: func() *worktreeTrackerMock {
+				return &worktreeTrackerMock{
+					TrackWorktreeFunc: func(worktreeName, branch, description, mergeInto string) error {
+						return errors.New("worktree 'experiment' is already tracked in gbm.branchconfig.yaml")
+					},
+				}
+			},
+			assertMocks: func(t *testing.T, mock *worktreeTrackerMock) {
+				assert.Len(t, mock.TrackWorktreeCalls(), 1)
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "failed to track worktree")
+				assert.ErrorContains(t, err, "already tracked")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := tt.mockSetup()
+			err := handleTrack(mock, "experiment", "feature/experiment", "Experiment", "main")
+
+			tt.assertMocks(t, mock)
+			tt.assertErr(t, err)
+		})
+	}
+}
+
+func TestHandleUntrack(t *testing.T) {
+	mock := &worktreeTrackerMock{
+		UntrackWorktreeFunc: func(worktreeName string) error { return nil },
+	}
+	require.NoError(t, handleUntrack(mock, "experiment"))
+	require.Len(t, mock.UntrackWorktreeCalls(), 1)
+	assert.Equal(t, "experiment", mock.UntrackWorktreeCalls()[0].WorktreeName)
+
+	mock.UntrackWorktreeFunc = func(worktreeName string) error { return errors.New("is not tracked") }
+	assert.ErrorContains(t, handleUntrack(mock, "experiment"), "failed to untrack worktree")
+}
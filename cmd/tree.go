@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"gbm/internal"
+
+	"github.com/spf13/cobra"
+)
+
+//go:generate go run github.com/matryer/moq@latest -out ./autogen_worktreeTreeProvider.go . worktreeTreeProvider
+
+// worktreeTreeProvider interface abstracts the Manager operations needed to render the mergeback tree
+type worktreeTreeProvider interface {
+	GetGBMConfig() *internal.GBMConfig
+	CheckMergeBackStatus() (*internal.MergeBackStatus, error)
+}
+
+func newTreeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tree",
+		Short: "Show the mergeback hierarchy defined in gbm.branchconfig.yaml",
+		Long: `Show the mergeback hierarchy defined by merge_into in gbm.branchconfig.yaml.
+
+Each worktree is listed under the worktree it merges into, with its branch and
+whether a mergeback into its parent is pending.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			manager, err := createInitializedManager()
+			if err != nil {
+				return err
+			}
+
+			return handleTree(manager)
+		},
+	}
+
+	return cmd
+}
+
+func handleTree(provider worktreeTreeProvider) error {
+	config := provider.GetGBMConfig()
+	if config == nil || config.Tree == nil || len(config.Worktrees) == 0 {
+		return fmt.Errorf("no worktrees defined in %s", internal.DefaultBranchConfigFilename)
+	}
+
+	pending := make(map[string]int)
+	status, err := provider.CheckMergeBackStatus()
+	if err != nil {
+		PrintWarning("failed to check mergeback status: %v", err)
+	} else if status != nil {
+		for _, info := range status.MergeBacksNeeded {
+			pending[info.FromBranch] = info.TotalCount
+		}
+	}
+
+	fmt.Print(renderWorktreeTree(config.Tree, pending))
+	return nil
+}
+
+// renderWorktreeTree draws each root and its descendants as an indented tree. pending maps a
+// worktree name to the number of commits waiting to be merged back into its parent.
+func renderWorktreeTree(tree *internal.WorktreeManager, pending map[string]int) string {
+	var sb strings.Builder
+
+	var render func(node *internal.WorktreeNode, prefix, connector, childPrefix string)
+	render = func(node *internal.WorktreeNode, prefix, connector, childPrefix string) {
+		line := fmt.Sprintf("%s%s%s (%s)", prefix, connector, node.Name, node.Config.Branch)
+		if !node.IsRoot() {
+			if count := pending[node.Name]; count > 0 {
+				line += " " + internal.FormatWarning(fmt.Sprintf("%d commit(s) to merge back into %s", count, node.Parent.Name))
+			} else {
+				line += " " + internal.FormatSuccess("up to date")
+			}
+		}
+		sb.WriteString(line + "\n")
+
+		children := sortedNodes(node.GetChildren())
+		for i, child := range children {
+			if i == len(children)-1 {
+				render(child, prefix+childPrefix, "└── ", "    ")
+			} else {
+				render(child, prefix+childPrefix, "├── ", "│   ")
+			}
+		}
+	}
+
+	for _, root := range sortedNodes(tree.GetRoots()) {
+		render(root, "", "", "")
+	}
+
+	return sb.String()
+}
+
+// sortedNodes returns nodes ordered by worktree name so the tree renders deterministically
+func sortedNodes(nodes []*internal.WorktreeNode) []*internal.WorktreeNode {
+	return slices.SortedFunc(slices.Values(nodes), func(a, b *internal.WorktreeNode) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+}
@@ -0,0 +1,107 @@
+package cmd
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"gbm/internal"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTreeConfig(t *testing.T) *internal.GBMConfig {
+	t.Helper()
+
+	config := &internal.GBMConfig{
+		Worktrees: map[string]internal.WorktreeConfig{
+			"main":       {Branch: "main"},
+			"preview":    {Branch: "release/preview", MergeInto: "main"},
+			"hotfix":     {Branch: "hotfix/current", MergeInto: "main"},
+			"production": {Branch: "release/production", MergeInto: "preview"},
+		},
+	}
+
+	tree, err := internal.NewWorktreeManager(config)
+	require.NoError(t, err)
+	config.Tree = tree
+
+	return config
+}
+
+func TestRenderWorktreeTree(t *testing.T) {
+	config := newTestTreeConfig(t)
+
+	output := renderWorktreeTree(config.Tree, map[string]int{"production": 3})
+
+	lines := []string{
+		"main (main)\n",
+		"├── hotfix (hotfix/current) ",
+		"└── preview (release/preview) ",
+		"    └── production (release/production) ",
+	}
+	remaining := output
+	for _, line := range lines {
+		_, after, found := strings.Cut(remaining, line)
+		require.True(t, found, "missing or out of order: %q in\n%s", line, output)
+		remaining = after
+	}
+
+	assert.Contains(t, output, "3 commit(s) to merge back into preview")
+	assert.Contains(t, output, "up to date")
+}
+
+func TestHandleTree(t *testing.T) {
+	tests := []struct {
+		name      string
+		mockSetup func() *worktreeTreeProviderMock
+		assertErr func(t *testing.T, err error)
+	}{
+		{
+			name: "renders tree",
+			mockSetup: func() *worktreeTreeProviderMock {
+				return &worktreeTreeProviderMock{
+					GetGBMConfigFunc: func() *internal.GBMConfig { return newTestTreeConfig(t) },
+					CheckMergeBackStatusFunc: func() (*internal.MergeBackStatus, error) {
+						return &internal.MergeBackStatus{}, nil
+					},
+				}
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "mergeback status failure still renders tree",
+			mockSetup: func() *worktreeTreeProviderMock {
+				return &worktreeTreeProviderMock{
+					GetGBMConfigFunc: func() *internal.GBMConfig { return newTestTreeConfig(t) },
+					CheckMergeBackStatusFunc: func() (*internal.MergeBackStatus, error) {
+						return nil, errors.New("fetch failed")
+					},
+				}
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+		},
+		{
+			name: "no branch config",
+			mockSetup: func() *worktreeTreeProviderMock {
+				return &worktreeTreeProviderMock{
+					GetGBMConfigFunc: func() *internal.GBMConfig { return nil },
+				}
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "no worktrees defined")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.assertErr(t, handleTree(tt.mockSetup()))
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"gbm/internal"
+
+	"github.com/spf13/cobra"
+)
+
+//go:generate go run github.com/matryer/moq@latest -out ./autogen_worktreeLocator.go . worktreeLocator
+
+// worktreeLocator interface abstracts the Manager operations needed to describe the current worktree
+type worktreeLocator interface {
+	IsInWorktree(currentPath string) (bool, string, error)
+	GetWorktreePath(worktreeName string) (string, error)
+	GetWorktreeCurrentBranch(worktreePath string) (string, error)
+	GetGBMConfig() *internal.GBMConfig
+	CheckMergeBackStatus() (*internal.MergeBackStatus, error)
+}
+
+func newWhereamiCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "whereami",
+		Short: "Show which worktree you are in and its place in the mergeback chain",
+		Long: `Show the worktree containing the current directory: its name, path and branch,
+whether it is tracked in gbm.branchconfig.yaml, the worktree it merges into, the worktrees
+merging into it and any pending mergebacks involving it.
+
+Works from any subdirectory of a worktree.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			wd, err := os.Getwd()
+			if err != nil {
+				return fmt.Errorf("failed to get working directory: %w", err)
+			}
+
+			manager, err := createInitializedManager()
+			if err != nil {
+				return err
+			}
+
+			return handleWhereami(manager, cmd, wd)
+		},
+	}
+
+	return cmd
+}
+
+func handleWhereami(locator worktreeLocator, cmd *cobra.Command, currentPath string) error {
+	out := cmd.OutOrStdout()
+
+	inWorktree, worktreeName, err := locator.IsInWorktree(currentPath)
+	if err != nil {
+		return fmt.Errorf("failed to check if in worktree: %w", err)
+	}
+	if !inWorktree {
+		return fmt.Errorf("not currently in a worktree")
+	}
+
+	worktreePath, err := locator.GetWorktreePath(worktreeName)
+	if err != nil {
+		return err
+	}
+
+	branch, err := locator.GetWorktreeCurrentBranch(worktreePath)
+	if err != nil {
+		return fmt.Errorf("failed to get branch of worktree '%s': %w", worktreeName, err)
+	}
+	if branch == "HEAD" {
+		branch = "(detached HEAD)"
+	}
+
+	printWhereamiField(out, "Worktree", worktreeName)
+	printWhereamiField(out, "Path", worktreePath)
+	printWhereamiField(out, "Branch", branch)
+
+	var node *internal.WorktreeNode
+	if config := locator.GetGBMConfig(); config != nil && config.Tree != nil {
+		node = config.Tree.GetNode(worktreeName)
+	}
+	if node == nil {
+		printWhereamiField(out, "Tracked", "no (ad hoc worktree, not in "+internal.DefaultBranchConfigFilename+")")
+		return nil
+	}
+
+	tracked := "yes"
+	if node.Config.Branch != branch {
+		tracked += " " + internal.FormatWarning(fmt.Sprintf("(configured for branch %s)", node.Config.Branch))
+	}
+	printWhereamiField(out, "Tracked", tracked)
+
+	if node.Parent != nil {
+		printWhereamiField(out, "Merges into", fmt.Sprintf("%s (%s)", node.Parent.Name, node.Parent.Config.Branch))
+	} else {
+		printWhereamiField(out, "Merges into", "nothing (root of the mergeback chain)")
+	}
+	if children := sortedNodes(node.GetChildren()); len(children) > 0 {
+		names := make([]string, len(children))
+		for i, child := range children {
+			names[i] = fmt.Sprintf("%s (%s)", child.Name, child.Config.Branch)
+		}
+		printWhereamiField(out, "Merged from", strings.Join(names, ", "))
+	}
+
+	status, err := locator.CheckMergeBackStatus()
+	if err != nil {
+		PrintWarning("failed to check mergeback status: %v", err)
+		return nil
+	}
+
+	var pending []string
+	if status != nil {
+		for _, info := range status.MergeBacksNeeded {
+			if info.FromBranch == worktreeName || info.ToBranch == worktreeName {
+				pending = append(pending, fmt.Sprintf("%d commit(s) from %s into %s", info.TotalCount, info.FromBranch, info.ToBranch))
+			}
+		}
+	}
+	if len(pending) == 0 {
+		printWhereamiField(out, "Mergebacks", internal.FormatSuccess("none pending"))
+		return nil
+	}
+	printWhereamiField(out, "Mergebacks", "")
+	for _, line := range pending {
+		fmt.Fprintf(out, "  %s\n", internal.FormatWarning(line))
+	}
+
+	return nil
+}
+
+// printWhereamiField prints one "label: value" line with the values lined up
+func printWhereamiField(out io.Writer, label, value string) {
+	fmt.Fprintf(out, "%-13s %s\n", label+":", value)
+}
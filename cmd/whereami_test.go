@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"gbm/internal"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+)
+
+func newWorktreeLocatorMock(t *testing.T, worktreeName, branch string) *worktreeLocatorMock {
+	config := newTestTreeConfig(t)
+	return &worktreeLocatorMock{
+		IsInWorktreeFunc: func(currentPath string) (bool, string, error) {
+			return worktreeName != "", worktreeName, nil
+		},
+		GetWorktreePathFunc: func(worktreeName string) (string, error) {
+			return "/repo/worktrees/" + worktreeName, nil
+		},
+		GetWorktreeCurrentBranchFunc: func(worktreePath string) (string, error) {
+			return branch, nil
+		},
+		GetGBMConfigFunc: func() *internal.GBMConfig {
+			return config
+		},
+		CheckMergeBackStatusFunc: func() (*internal.MergeBackStatus, error) {
+			return &internal.MergeBackStatus{MergeBacksNeeded: []internal.MergeBackInfo{
+				{FromBranch: "production", ToBranch: "preview", TotalCount: 3},
+			}}, nil
+		},
+	}
+}
+
+func TestHandleWhereami(t *testing.T) {
+	tests := []struct {
+		name         string
+		mockSetup    func(t *testing.T) *worktreeLocatorMock
+		assertErr    func(t *testing.T, err error)
+		assertOutput func(t *testing.T, output string)
+	}{
+		{
+			name: "tracked worktree shows parent, children and pending mergebacks",
+			mockSetup: func(t *testing.T) *worktreeLocatorMock {
+				return newWorktreeLocatorMock(t, "preview", "release/preview")
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+			assertOutput: func(t *testing.T, output string) {
+				assert.Contains(t, output, "Worktree:     preview\n")
+				assert.Contains(t, output, "Path:         /repo/worktrees/preview\n")
+				assert.Contains(t, output, "Branch:       release/preview\n")
+				assert.Contains(t, output, "Tracked:      yes\n")
+				assert.Contains(t, output, "Merges into:  main (main)\n")
+				assert.Contains(t, output, "Merged from:  production (release/production)\n")
+				assert.Contains(t, output, "3 commit(s) from production into preview")
+			},
+		},
+		{
+			name: "root worktree with nothing pending",
+			mockSetup: func(t *testing.T) *worktreeLocatorMock {
+				return newWorktreeLocatorMock(t, "main", "main")
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+			assertOutput: func(t *testing.T, output string) {
+				assert.Contains(t, output, "nothing (root of the mergeback chain)")
+				assert.Contains(t, output, "Merged from:  hotfix (hotfix/current), preview (release/preview)\n")
+				assert.Contains(t, output, "none pending")
+			},
+		},
+		{
+			name: "tracked worktree on a different branch is flagged",
+			mockSetup: func(t *testing.T) *worktreeLocatorMock {
+				return newWorktreeLocatorMock(t, "hotfix", "HEAD")
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+			assertOutput: func(t *testing.T, output string) {
+				assert.Contains(t, output, "Branch:       (detached HEAD)\n")
+				assert.Contains(t, output, "configured for branch hotfix/current")
+			},
+		},
+		{
+			name: "ad hoc worktree is not in the chain",
+			mockSetup: func(t *testing.T) *worktreeLocatorMock {
+				return newWorktreeLocatorMock(t, "PROJ-123", "feature/PROJ-123")
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+			assertOutput: func(t *testing.T, output string) {
+				assert.Contains(t, output, "Tracked:      no (ad hoc worktree")
+				assert.NotContains(t, output, "Merges into")
+			},
+		},
+		{
+			name: "mergeback check failure still prints the worktree",
+			mockSetup: func(t *testing.T) *worktreeLocatorMock {
+				mock := newWorktreeLocatorMock(t, "production", "release/production")
+				mock.CheckMergeBackStatusFunc = func() (*internal.MergeBackStatus, error) {
+					return nil, errors.New("git log failed")
+				}
+				return mock
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.NoError(t, err)
+			},
+			assertOutput: func(t *testing.T, output string) {
+				assert.Contains(t, output, "Merges into:  preview (release/preview)\n")
+				assert.NotContains(t, output, "Mergebacks")
+			},
+		},
+		{
+			name: "error - not in a worktree",
+			mockSetup: func(t *testing.T) *worktreeLocatorMock {
+				return newWorktreeLocatorMock(t, "", "")
+			},
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "not currently in a worktree")
+			},
+			assertOutput: func(t *testing.T, output string) {
+				assert.Empty(t, output)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			cmd := &cobra.Command{}
+			cmd.SetOut(&out)
+
+			err := handleWhereami(tt.mockSetup(t), cmd, "/repo/worktrees/x/src")
+
+			tt.assertErr(t, err)
+			tt.assertOutput(t, out.String())
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/BurntSushi/toml"
@@ -17,30 +19,64 @@ const (
 	DefaultConfigDirname        = ".gbm"
 	DefaultConfigFilename       = "config.toml"
 	DefaultStateFilename        = "state.toml"
+	DefaultJiraCacheDirname     = "jira-cache"
+
+	// DefaultRemoteName is the git remote used when none is configured
+	DefaultRemoteName = "origin"
+
+	// DefaultMergeBranchPrefix is the branch prefix used for mergeback branches (merge/<worktree>_<base>)
+	DefaultMergeBranchPrefix = "merge"
+
+	// DefaultFetchRetries is how many times a failed fetch is retried on transient errors
+	DefaultFetchRetries = 3
+
+	// DefaultFetchTimeout bounds a single fetch attempt so a dead remote can't hang gbm
+	DefaultFetchTimeout = 5 * time.Minute
+
+	// DefaultJiraCacheTTL is how long JIRA ticket details are reused before the JIRA CLI is asked again
+	DefaultJiraCacheTTL = 15 * time.Minute
+
+	// DefaultEnvBasePort and DefaultEnvPortRange bound the port assigned to each worktree for env templates
+	DefaultEnvBasePort  = 10000
+	DefaultEnvPortRange = 1000
 )
 
+// envVarNamePattern matches names that are safe to reference as shell environment variables
+var envVarNamePattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
 type Config struct {
 	Settings ConfigSettings `toml:"settings"`
 	Icons    ConfigIcons    `toml:"icons"`
 	Jira     ConfigJira     `toml:"jira"`
+	Git      ConfigGit      `toml:"git"`
+	Hooks    ConfigHooks    `toml:"hooks"`
 	FileCopy ConfigFileCopy `toml:"file_copy"`
+	Env      ConfigEnv      `toml:"env_template"`
 }
 
 type ConfigSettings struct {
 	WorktreePrefix              string        `toml:"worktree_prefix"`
+	DefaultRemote               string        `toml:"default_remote"`
 	AutoFetch                   bool          `toml:"auto_fetch"`
 	CreateMissingBranches       bool          `toml:"create_missing_branches"`
 	MergeBackAlerts             bool          `toml:"merge_back_alerts"`
 	HotfixPrefix                string        `toml:"hotfix_prefix"`
 	MergebackPrefix             string        `toml:"mergeback_prefix"`
+	MergeBranchPrefix           string        `toml:"merge_branch_prefix"`
+	FetchRetries                int           `toml:"fetch_retries"`
+	FetchTimeout                time.Duration `toml:"fetch_timeout"`
 	MergeBackCheckInterval      time.Duration `toml:"merge_back_check_interval"`
 	MergeBackUserCommitInterval time.Duration `toml:"merge_back_user_commit_interval"`
 	CandidateBranches           []string      `toml:"candidate_branches"`
+	Editor                      string        `toml:"editor"`
+	IDE                         string        `toml:"ide"`
 }
 
 type FileCopyRule struct {
 	SourceWorktree string   `toml:"source_worktree"`
 	Files          []string `toml:"files"`
+	// Exclude holds .gitignore-style globs for paths that are never copied, even when listed in Files
+	Exclude []string `toml:"exclude"`
 }
 
 type ConfigFileCopy struct {
@@ -64,6 +100,7 @@ type ConfigIcons struct {
 	GitAhead    string `toml:"git_ahead"`
 	GitBehind   string `toml:"git_behind"`
 	GitDiverged string `toml:"git_diverged"`
+	GitConflict string `toml:"git_conflict"`
 	GitUnknown  string `toml:"git_unknown"`
 
 	// Section header icons
@@ -74,6 +111,40 @@ type ConfigIcons struct {
 
 type ConfigJira struct {
 	Me string `toml:"me"`
+	// Projects restricts JIRA keys found in branch names and commit messages to these project
+	// keys (e.g. ["SHOP", "AUTH"]) so look-alikes such as UTF-8 aren't mistaken for tickets
+	Projects []string `toml:"projects"`
+	// BaseURL is the JIRA site (e.g. https://acme.atlassian.net) used to link tickets when the
+	// JIRA CLI can't provide the link itself
+	BaseURL string `toml:"base_url"`
+	// CacheTTL is how long ticket details fetched from the JIRA CLI are cached in .gbm/jira-cache;
+	// 0 disables the cache
+	CacheTTL time.Duration `toml:"cache_ttl"`
+}
+
+type ConfigGit struct {
+	// TokenEnv names the environment variable holding a token for HTTPS remotes.
+	// When empty, DefaultTokenEnvVars are checked in order.
+	TokenEnv string `toml:"token_env"`
+}
+
+type ConfigHooks struct {
+	// PostCreate holds shell commands run in a new worktree after it is created and files are copied
+	PostCreate []string `toml:"post_create"`
+	// PreRemove holds shell commands run in a worktree before it is removed; a failure aborts the removal unless forced
+	PreRemove []string `toml:"pre_remove"`
+	// PostRemove holds shell commands run from the repository root after a worktree is removed
+	PostRemove []string `toml:"post_remove"`
+}
+
+type ConfigEnv struct {
+	// Template is the file rendered into each new worktree, relative to the repository root
+	Template string `toml:"template"`
+	// Destination is where the rendered file is written, relative to the worktree (e.g. ".env")
+	Destination string `toml:"destination"`
+	// BasePort and PortRange bound the port derived from each worktree's name
+	BasePort  int `toml:"base_port"`
+	PortRange int `toml:"port_range"`
 }
 
 // YAML-based configuration structures
@@ -86,17 +157,25 @@ type WorktreeConfig struct {
 	Branch      string `yaml:"branch"`
 	MergeInto   string `yaml:"merge_into,omitempty"`
 	Description string `yaml:"description,omitempty"`
+	// BaseBranch is the branch this worktree's branch is based on. `gbm sync --create-missing-branches`
+	// starts Branch there when it doesn't exist yet (the default branch when empty), and gbm info,
+	// rebase and pr use it as the base instead of detecting one.
+	BaseBranch string `yaml:"base_branch,omitempty"`
 }
 
 func DefaultConfig() *Config {
 	return &Config{
 		Settings: ConfigSettings{
 			WorktreePrefix:              DefaultWorktreeDirname,
+			DefaultRemote:               DefaultRemoteName,
 			AutoFetch:                   true,
 			CreateMissingBranches:       false,
 			MergeBackAlerts:             true,                                         // Enabled by default
 			HotfixPrefix:                "HOTFIX",                                     // Default hotfix prefix
 			MergebackPrefix:             "MERGE",                                      // Default mergeback prefix
+			MergeBranchPrefix:           DefaultMergeBranchPrefix,                     // Default mergeback branch prefix
+			FetchRetries:                DefaultFetchRetries,                          // Retry transient fetch failures
+			FetchTimeout:                DefaultFetchTimeout,                          // Give up on a fetch that hangs
 			MergeBackCheckInterval:      3 * time.Hour,                                // Check every 3 hours by default
 			MergeBackUserCommitInterval: 30 * time.Minute,                             // Alert every 30 minutes when user has commits
 			CandidateBranches:           []string{"main", "master", "develop", "dev"}, // Default candidate branches
@@ -118,6 +197,7 @@ func DefaultConfig() *Config {
 			GitAhead:    "↑",
 			GitBehind:   "↓",
 			GitDiverged: "⇕",
+			GitConflict: "!",
 			GitUnknown:  "?",
 
 			// Section header icons
@@ -126,11 +206,24 @@ func DefaultConfig() *Config {
 			GitHeader:      "🌿",
 		},
 		Jira: ConfigJira{
-			Me: "", // Will be populated when first used
+			Me:       "", // Will be populated when first used
+			CacheTTL: DefaultJiraCacheTTL,
+		},
+		Git: ConfigGit{
+			TokenEnv: "", // Falls back to DefaultTokenEnvVars
+		},
+		Hooks: ConfigHooks{
+			PostCreate: []string{},
+			PreRemove:  []string{},
+			PostRemove: []string{},
 		},
 		FileCopy: ConfigFileCopy{
 			Rules: []FileCopyRule{},
 		},
+		Env: ConfigEnv{
+			BasePort:  DefaultEnvBasePort,
+			PortRange: DefaultEnvPortRange,
+		},
 	}
 }
 
@@ -142,13 +235,110 @@ func LoadConfig(gbmDir string) (*Config, error) {
 	}
 
 	var config Config
-	if _, err := toml.DecodeFile(configPath, &config); err != nil {
+	metadata, err := toml.DecodeFile(configPath, &config)
+	if err != nil {
 		return nil, fmt.Errorf("failed to decode config file: %w", err)
 	}
+	config.applyDefaults(metadata)
+
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
 
 	return &config, nil
 }
 
+// applyDefaults fills in keys that config.toml leaves unset but where an explicit zero value means something
+func (c *Config) applyDefaults(metadata toml.MetaData) {
+	// Config files without worktree_prefix keep worktrees in the default directory
+	if !metadata.IsDefined("settings", "worktree_prefix") {
+		c.Settings.WorktreePrefix = DefaultWorktreeDirname
+	}
+
+	// An explicit empty merge_branch_prefix disables the prefix, so only default it when unset
+	if !metadata.IsDefined("settings", "merge_branch_prefix") {
+		c.Settings.MergeBranchPrefix = DefaultMergeBranchPrefix
+	}
+
+	// fetch_retries = 0 disables retries, so only default it when unset
+	if !metadata.IsDefined("settings", "fetch_retries") {
+		c.Settings.FetchRetries = DefaultFetchRetries
+	}
+
+	// fetch_timeout = 0 disables the timeout, so only default it when unset
+	if !metadata.IsDefined("settings", "fetch_timeout") {
+		c.Settings.FetchTimeout = DefaultFetchTimeout
+	}
+
+	// Config files written before git_conflict existed would otherwise render conflicts without an icon
+	if !metadata.IsDefined("icons", "git_conflict") {
+		c.Icons.GitConflict = DefaultConfig().Icons.GitConflict
+	}
+
+	// cache_ttl = 0 disables the JIRA cache, so only default it when unset
+	if !metadata.IsDefined("jira", "cache_ttl") {
+		c.Jira.CacheTTL = DefaultJiraCacheTTL
+	}
+
+	// Config files written before [env_template] existed have no port settings
+	if !metadata.IsDefined("env_template", "base_port") {
+		c.Env.BasePort = DefaultEnvBasePort
+	}
+	if !metadata.IsDefined("env_template", "port_range") {
+		c.Env.PortRange = DefaultEnvPortRange
+	}
+}
+
+// ValidateWorktreePrefix checks a worktree directory setting. The prefix is relative to the
+// repository and may point outside it, e.g. "../gbm-worktrees" on a bigger disk.
+func ValidateWorktreePrefix(prefix string) error {
+	switch {
+	case prefix == "":
+		return fmt.Errorf("worktree prefix must not be empty")
+	case filepath.IsAbs(prefix):
+		return fmt.Errorf("worktree prefix must be relative to the repository (e.g. ../gbm-worktrees), got %s", prefix)
+	case filepath.Clean(prefix) == ".":
+		return fmt.Errorf("worktree prefix must not be the repository itself")
+	}
+	return nil
+}
+
+// ValidateMergeBranchPrefix checks that a mergeback branch prefix can be used as part of a git ref name.
+// An empty prefix is valid and means mergeback branches are created without a prefix.
+func ValidateMergeBranchPrefix(prefix string) error {
+	if prefix == "" {
+		return nil
+	}
+
+	for _, r := range prefix {
+		if r < 0x20 || r == 0x7f {
+			return fmt.Errorf("prefix %q contains control characters", prefix)
+		}
+		if strings.ContainsRune(" ~^:?*[\\", r) {
+			return fmt.Errorf("prefix %q contains character %q which is not allowed in git branch names", prefix, r)
+		}
+	}
+
+	switch {
+	case strings.Contains(prefix, ".."):
+		return fmt.Errorf("prefix %q must not contain '..'", prefix)
+	case strings.Contains(prefix, "@{"):
+		return fmt.Errorf("prefix %q must not contain '@{'", prefix)
+	case strings.Contains(prefix, "//"):
+		return fmt.Errorf("prefix %q must not contain consecutive slashes", prefix)
+	case strings.HasPrefix(prefix, "/") || strings.HasSuffix(prefix, "/"):
+		return fmt.Errorf("prefix %q must not start or end with '/'", prefix)
+	case strings.HasPrefix(prefix, ".") || strings.Contains(prefix, "/."):
+		return fmt.Errorf("prefix %q must not contain path components starting with '.'", prefix)
+	case strings.HasSuffix(prefix, ".lock") || strings.Contains(prefix, ".lock/"):
+		return fmt.Errorf("prefix %q must not contain path components ending with '.lock'", prefix)
+	case strings.HasPrefix(prefix, "-"):
+		return fmt.Errorf("prefix %q must not start with '-'", prefix)
+	}
+
+	return nil
+}
+
 // GetGBMDir returns the path to the .gbm directory for the given repository root
 func GetGBMDir(repoRoot string) string {
 	return filepath.Join(repoRoot, ".gbm")
@@ -159,16 +349,8 @@ func (c *Config) Save(gbmDir string) error {
 		return fmt.Errorf("failed to create .gbm directory: %w", err)
 	}
 
-	configPath := filepath.Join(gbmDir, DefaultConfigFilename)
-	file, err := os.Create(configPath)
-	if err != nil {
-		return fmt.Errorf("failed to create config file: %w", err)
-	}
-	defer func() { _ = file.Close() }()
-
-	encoder := toml.NewEncoder(file)
-	if err := encoder.Encode(c); err != nil {
-		return fmt.Errorf("failed to encode config: %w", err)
+	if err := writeTOMLAtomic(filepath.Join(gbmDir, DefaultConfigFilename), c); err != nil {
+		return fmt.Errorf("failed to write config file: %w", err)
 	}
 
 	return nil
@@ -195,3 +377,18 @@ func ParseGBMConfig(path string) (*GBMConfig, error) {
 
 	return &config, nil
 }
+
+// Save writes the branch config back to YAML. Worktrees are written sorted by name; comments
+// from a hand-edited file are not preserved.
+func (c *GBMConfig) Save(path string) error {
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", DefaultBranchConfigFilename, err)
+	}
+
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", DefaultBranchConfigFilename, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,193 @@
+package internal
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ConfigKeys returns the dotted keys (e.g. "settings.worktree_prefix") that can be read and
+// written with GetValue and SetValue. Table arrays such as file_copy.rules are not included.
+func ConfigKeys() []string {
+	var keys []string
+	sectionsType := reflect.TypeOf(Config{})
+	for i := range sectionsType.NumField() {
+		section := sectionsType.Field(i)
+		for j := range section.Type.NumField() {
+			field := section.Type.Field(j)
+			if isEditableConfigField(field.Type) {
+				keys = append(keys, tomlName(section)+"."+tomlName(field))
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// GetValue returns the value of a config key formatted as it would be passed to SetValue.
+// Lists are returned comma-separated.
+func (c *Config) GetValue(key string) (string, error) {
+	field, err := c.lookupField(key)
+	if err != nil {
+		return "", err
+	}
+
+	switch value := field.Interface().(type) {
+	case time.Duration:
+		return value.String(), nil
+	case []string:
+		return strings.Join(value, ","), nil
+	default:
+		return fmt.Sprint(value), nil
+	}
+}
+
+// SetValue parses value according to the key's type and stores it. The updated config goes
+// through the same validation as LoadConfig; on error the config is left unchanged.
+func (c *Config) SetValue(key, value string) error {
+	updated := *c
+	field, err := updated.lookupField(key)
+	if err != nil {
+		return err
+	}
+
+	switch field.Interface().(type) {
+	case string:
+		field.SetString(value)
+	case bool:
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("invalid value for %s: %q is not a boolean", key, value)
+		}
+		field.SetBool(parsed)
+	case int:
+		parsed, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("invalid value for %s: %q is not an integer", key, value)
+		}
+		field.SetInt(int64(parsed))
+	case time.Duration:
+		parsed, err := time.ParseDuration(value)
+		if err != nil {
+			return fmt.Errorf("invalid value for %s: %q is not a duration (e.g. 30m, 3h)", key, value)
+		}
+		field.SetInt(int64(parsed))
+	case []string:
+		var items []string
+		for item := range strings.SplitSeq(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		field.Set(reflect.ValueOf(items))
+	}
+
+	if err := updated.validate(); err != nil {
+		return err
+	}
+
+	*c = updated
+	return nil
+}
+
+// validate checks settings that LoadConfig cannot accept as-is
+func (c *Config) validate() error {
+	if issues := c.issues(); len(issues) > 0 {
+		return issues[0].err
+	}
+	return nil
+}
+
+// configIssue is an invalid value for one config key
+type configIssue struct {
+	key string // dotted key, e.g. "settings.fetch_retries"
+	err error
+}
+
+// issues returns every invalid value in the config, in a fixed order
+func (c *Config) issues() []configIssue {
+	var issues []configIssue
+
+	if err := ValidateWorktreePrefix(c.Settings.WorktreePrefix); err != nil {
+		issues = append(issues, configIssue{"settings.worktree_prefix", fmt.Errorf("invalid worktree_prefix: %w", err)})
+	}
+
+	if c.Settings.FetchRetries < 0 {
+		issues = append(issues, configIssue{"settings.fetch_retries",
+			fmt.Errorf("invalid fetch_retries: must not be negative, got %d", c.Settings.FetchRetries)})
+	}
+
+	if c.Settings.FetchTimeout < 0 {
+		issues = append(issues, configIssue{"settings.fetch_timeout",
+			fmt.Errorf("invalid fetch_timeout: must not be negative, got %s", c.Settings.FetchTimeout)})
+	}
+
+	if c.Jira.CacheTTL < 0 {
+		issues = append(issues, configIssue{"jira.cache_ttl",
+			fmt.Errorf("invalid jira.cache_ttl: must not be negative, got %s", c.Jira.CacheTTL)})
+	}
+
+	if c.Env.BasePort < 1 || c.Env.PortRange < 1 || c.Env.BasePort+c.Env.PortRange-1 > 65535 {
+		issues = append(issues, configIssue{"env_template.base_port",
+			fmt.Errorf("invalid env_template ports: base_port %d and port_range %d must stay within 1-65535", c.Env.BasePort, c.Env.PortRange)})
+	}
+
+	if c.Git.TokenEnv != "" && !envVarNamePattern.MatchString(c.Git.TokenEnv) {
+		issues = append(issues, configIssue{"git.token_env",
+			fmt.Errorf("invalid git.token_env: %q is not a valid environment variable name", c.Git.TokenEnv)})
+	}
+
+	if err := ValidateMergeBranchPrefix(c.Settings.MergeBranchPrefix); err != nil {
+		issues = append(issues, configIssue{"settings.merge_branch_prefix",
+			fmt.Errorf("invalid merge_branch_prefix: %w", err)})
+	}
+
+	if c.Jira.BaseURL != "" {
+		if u, err := url.Parse(c.Jira.BaseURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			issues = append(issues, configIssue{"jira.base_url",
+				fmt.Errorf("invalid jira.base_url: %q is not an http(s) URL", c.Jira.BaseURL)})
+		}
+	}
+
+	return issues
+}
+
+// lookupField resolves a dotted key to the addressable struct field it names
+func (c *Config) lookupField(key string) (reflect.Value, error) {
+	sectionName, fieldName, ok := strings.Cut(key, ".")
+	if ok {
+		config := reflect.ValueOf(c).Elem()
+		for i := range config.NumField() {
+			if tomlName(config.Type().Field(i)) != sectionName {
+				continue
+			}
+
+			section := config.Field(i)
+			for j := range section.NumField() {
+				field := section.Type().Field(j)
+				if tomlName(field) == fieldName && isEditableConfigField(field.Type) {
+					return section.Field(j), nil
+				}
+			}
+		}
+	}
+
+	return reflect.Value{}, fmt.Errorf("unknown config key %q; valid keys are:\n  %s", key, strings.Join(ConfigKeys(), "\n  "))
+}
+
+func tomlName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("toml"), ",")
+	return name
+}
+
+func isEditableConfigField(t reflect.Type) bool {
+	switch t {
+	case reflect.TypeOf(""), reflect.TypeOf(false), reflect.TypeOf(0), reflect.TypeOf(time.Duration(0)), reflect.TypeOf([]string{}):
+		return true
+	}
+	return false
+}
@@ -0,0 +1,315 @@
+package internal
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadConfig_MergeBranchPrefix(t *testing.T) {
+	tests := []struct {
+		name      string
+		contents  string
+		expected  string
+		expectErr bool
+	}{
+		{
+			name:     "unset defaults to merge",
+			contents: "[settings]\nworktree_prefix = \"worktrees\"\n",
+			expected: "merge",
+		},
+		{
+			name:     "custom prefix",
+			contents: "[settings]\nmerge_branch_prefix = \"team/mergeback\"\n",
+			expected: "team/mergeback",
+		},
+		{
+			name:     "explicit empty prefix is preserved",
+			contents: "[settings]\nmerge_branch_prefix = \"\"\n",
+			expected: "",
+		},
+		{
+			name:      "invalid prefix is rejected",
+			contents:  "[settings]\nmerge_branch_prefix = \"merge back\"\n",
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gbmDir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(gbmDir, DefaultConfigFilename), []byte(tt.contents), 0o644))
+
+			config, err := LoadConfig(gbmDir)
+			if tt.expectErr {
+				assert.ErrorContains(t, err, "invalid merge_branch_prefix")
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, config.Settings.MergeBranchPrefix)
+		})
+	}
+}
+
+func TestValidateMergeBranchPrefix(t *testing.T) {
+	valid := []string{"", "merge", "team/merge", "merge-back", "merge_v2"}
+	for _, prefix := range valid {
+		assert.NoError(t, ValidateMergeBranchPrefix(prefix), "prefix %q should be valid", prefix)
+	}
+
+	invalid := []string{"merge back", "merge~1", "merge^", "merge:x", "merge?", "merge*", "merge[", "merge\\x",
+		"merge..back", "merge@{1}", "merge//back", "/merge", "merge/", ".merge", "team/.merge", "merge.lock", "-merge", "merge\t"}
+	for _, prefix := range invalid {
+		assert.Error(t, ValidateMergeBranchPrefix(prefix), "prefix %q should be invalid", prefix)
+	}
+}
+
+func TestLoadConfig_FetchRetries(t *testing.T) {
+	tests := []struct {
+		name      string
+		contents  string
+		expected  int
+		expectErr bool
+	}{
+		{
+			name:     "unset defaults to 3",
+			contents: "[settings]\nworktree_prefix = \"worktrees\"\n",
+			expected: DefaultFetchRetries,
+		},
+		{
+			name:     "explicit zero disables retries",
+			contents: "[settings]\nfetch_retries = 0\n",
+			expected: 0,
+		},
+		{
+			name:     "custom value",
+			contents: "[settings]\nfetch_retries = 5\n",
+			expected: 5,
+		},
+		{
+			name:      "negative value is rejected",
+			contents:  "[settings]\nfetch_retries = -1\n",
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gbmDir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(gbmDir, DefaultConfigFilename), []byte(tt.contents), 0o644))
+
+			config, err := LoadConfig(gbmDir)
+			if tt.expectErr {
+				assert.ErrorContains(t, err, "invalid fetch_retries")
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, config.Settings.FetchRetries)
+		})
+	}
+}
+
+func TestLoadConfig_FetchTimeout(t *testing.T) {
+	tests := []struct {
+		name      string
+		contents  string
+		expected  time.Duration
+		expectErr bool
+	}{
+		{
+			name:     "unset defaults to 5m",
+			contents: "[settings]\nworktree_prefix = \"worktrees\"\n",
+			expected: DefaultFetchTimeout,
+		},
+		{
+			name:     "explicit zero disables the timeout",
+			contents: "[settings]\nfetch_timeout = 0\n",
+			expected: 0,
+		},
+		{
+			name:     "custom duration",
+			contents: "[settings]\nfetch_timeout = \"30s\"\n",
+			expected: 30 * time.Second,
+		},
+		{
+			name:      "negative value is rejected",
+			contents:  "[settings]\nfetch_timeout = \"-1s\"\n",
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gbmDir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(gbmDir, DefaultConfigFilename), []byte(tt.contents), 0o644))
+
+			config, err := LoadConfig(gbmDir)
+			if tt.expectErr {
+				assert.ErrorContains(t, err, "invalid fetch_timeout")
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, config.Settings.FetchTimeout)
+		})
+	}
+}
+
+func TestLoadConfig_JiraCacheTTL(t *testing.T) {
+	tests := []struct {
+		name      string
+		contents  string
+		expected  time.Duration
+		expectErr bool
+	}{
+		{
+			name:     "unset uses the default",
+			contents: "[jira]\nme = \"someone\"\n",
+			expected: DefaultJiraCacheTTL,
+		},
+		{
+			name:     "explicit zero disables the cache",
+			contents: "[jira]\ncache_ttl = 0\n",
+			expected: 0,
+		},
+		{
+			name:     "duration string",
+			contents: "[jira]\ncache_ttl = \"2h\"\n",
+			expected: 2 * time.Hour,
+		},
+		{
+			name:      "negative value is rejected",
+			contents:  "[jira]\ncache_ttl = \"-1m\"\n",
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gbmDir := t.TempDir()
+			require.NoError(t, os.WriteFile(filepath.Join(gbmDir, DefaultConfigFilename), []byte(tt.contents), 0o644))
+
+			config, err := LoadConfig(gbmDir)
+			if tt.expectErr {
+				assert.ErrorContains(t, err, "invalid jira.cache_ttl")
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, config.Jira.CacheTTL)
+		})
+	}
+}
+
+func TestLoadConfig_GitTokenEnv(t *testing.T) {
+	gbmDir := t.TempDir()
+	configPath := filepath.Join(gbmDir, DefaultConfigFilename)
+
+	require.NoError(t, os.WriteFile(configPath, []byte("[git]\ntoken_env = \"CI_GIT_TOKEN\"\n"), 0o644))
+	config, err := LoadConfig(gbmDir)
+	require.NoError(t, err)
+	assert.Equal(t, "CI_GIT_TOKEN", config.Git.TokenEnv)
+
+	require.NoError(t, os.WriteFile(configPath, []byte("[git]\ntoken_env = \"TOKEN; rm -rf /\"\n"), 0o644))
+	_, err = LoadConfig(gbmDir)
+	assert.ErrorContains(t, err, "invalid git.token_env")
+}
+
+func TestLoadConfig_WorktreePrefix(t *testing.T) {
+	gbmDir := t.TempDir()
+	configPath := filepath.Join(gbmDir, DefaultConfigFilename)
+
+	// Unset means the default directory
+	require.NoError(t, os.WriteFile(configPath, []byte("[settings]\nauto_fetch = true\n"), 0o644))
+	config, err := LoadConfig(gbmDir)
+	require.NoError(t, err)
+	assert.Equal(t, DefaultWorktreeDirname, config.Settings.WorktreePrefix)
+
+	require.NoError(t, os.WriteFile(configPath, []byte("[settings]\nworktree_prefix = \"../gbm-worktrees\"\n"), 0o644))
+	config, err = LoadConfig(gbmDir)
+	require.NoError(t, err)
+	assert.Equal(t, "../gbm-worktrees", config.Settings.WorktreePrefix)
+
+	require.NoError(t, os.WriteFile(configPath, []byte("[settings]\nworktree_prefix = \"/etc\"\n"), 0o644))
+	_, err = LoadConfig(gbmDir)
+	assert.ErrorContains(t, err, "invalid worktree_prefix")
+}
+
+func TestConfig_GetSetValue(t *testing.T) {
+	config := DefaultConfig()
+
+	value, err := config.GetValue("settings.worktree_prefix")
+	require.NoError(t, err)
+	assert.Equal(t, "worktrees", value)
+
+	require.NoError(t, config.SetValue("settings.worktree_prefix", "../gbm-worktrees"))
+	require.NoError(t, config.SetValue("settings.worktree_prefix", "wt"))
+	require.NoError(t, config.SetValue("settings.auto_fetch", "false"))
+	require.NoError(t, config.SetValue("settings.fetch_retries", "5"))
+	require.NoError(t, config.SetValue("settings.merge_back_check_interval", "90m"))
+	require.NoError(t, config.SetValue("settings.candidate_branches", "main, develop"))
+	require.NoError(t, config.SetValue("jira.me", "jdoe"))
+
+	assert.Equal(t, "wt", config.Settings.WorktreePrefix)
+	assert.False(t, config.Settings.AutoFetch)
+	assert.Equal(t, 5, config.Settings.FetchRetries)
+	assert.Equal(t, 90*time.Minute, config.Settings.MergeBackCheckInterval)
+	assert.Equal(t, []string{"main", "develop"}, config.Settings.CandidateBranches)
+	assert.Equal(t, "jdoe", config.Jira.Me)
+
+	value, err = config.GetValue("settings.candidate_branches")
+	require.NoError(t, err)
+	assert.Equal(t, "main,develop", value)
+
+	value, err = config.GetValue("settings.merge_back_check_interval")
+	require.NoError(t, err)
+	assert.Equal(t, "1h30m0s", value)
+
+	// Invalid values are rejected and leave the config unchanged
+	assert.ErrorContains(t, config.SetValue("settings.auto_fetch", "maybe"), "is not a boolean")
+	assert.ErrorContains(t, config.SetValue("settings.fetch_retries", "-1"), "invalid fetch_retries")
+	assert.ErrorContains(t, config.SetValue("settings.merge_branch_prefix", "bad prefix"), "invalid merge_branch_prefix")
+	assert.ErrorContains(t, config.SetValue("jira.base_url", "acme.atlassian.net"), "invalid jira.base_url")
+	assert.ErrorContains(t, config.SetValue("settings.worktree_prefix", "/etc"), "invalid worktree_prefix")
+	assert.ErrorContains(t, config.SetValue("settings.worktree_prefix", ""), "invalid worktree_prefix")
+	assert.Equal(t, 5, config.Settings.FetchRetries)
+	assert.Equal(t, "wt", config.Settings.WorktreePrefix)
+	assert.Equal(t, DefaultMergeBranchPrefix, config.Settings.MergeBranchPrefix)
+
+	// Unknown keys list the valid ones; table arrays are not editable
+	_, err = config.GetValue("settings.nope")
+	assert.ErrorContains(t, err, `unknown config key "settings.nope"`)
+	assert.ErrorContains(t, err, "settings.worktree_prefix")
+	assert.ErrorContains(t, config.SetValue("file_copy.rules", "x"), "unknown config key")
+	assert.NotContains(t, ConfigKeys(), "file_copy.rules")
+	assert.Contains(t, ConfigKeys(), "git.token_env")
+}
+
+func TestManager_BuildJiraURL(t *testing.T) {
+	manager := &Manager{config: DefaultConfig()}
+	assert.Empty(t, manager.BuildJiraURL("SHOP-123"), "no link without jira.base_url")
+
+	require.NoError(t, manager.config.SetValue("jira.base_url", "https://acme.atlassian.net/"))
+	assert.Equal(t, "https://acme.atlassian.net/browse/SHOP-123", manager.BuildJiraURL("SHOP-123"))
+}
+
+func TestGBMConfig_SaveRoundTrip(t *testing.T) {
+	configPath := filepath.Join(t.TempDir(), DefaultBranchConfigFilename)
+	config := &GBMConfig{Worktrees: map[string]WorktreeConfig{
+		"main":    {Branch: "main", Description: "Main production branch"},
+		"preview": {Branch: "preview", MergeInto: "main", BaseBranch: "main"},
+	}}
+
+	require.NoError(t, config.Save(configPath))
+
+	parsed, err := ParseGBMConfig(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, config.Worktrees, parsed.Worktrees)
+	assert.NotNil(t, parsed.Tree)
+}
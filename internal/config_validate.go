@@ -0,0 +1,280 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigProblem is one problem found in .gbm/config.toml or gbm.branchconfig.yaml
+type ConfigProblem struct {
+	File    string // path relative to the repository root
+	Line    int    // 1-based; 0 when the problem can't be tied to a line
+	Message string
+}
+
+func (p ConfigProblem) String() string {
+	if p.Line > 0 {
+		return fmt.Sprintf("%s:%d: %s", p.File, p.Line, p.Message)
+	}
+	return fmt.Sprintf("%s: %s", p.File, p.Message)
+}
+
+var (
+	tomlTableHeaderPattern = regexp.MustCompile(`^\s*\[\[?\s*([^\]]+?)\s*\]\]?`)
+	yamlErrorLinePattern   = regexp.MustCompile(`line (\d+)`)
+)
+
+// ValidateConfigFiles checks .gbm/config.toml and gbm.branchconfig.yaml together: TOML syntax, unknown
+// keys and invalid values, YAML syntax, the merge_into tree, branch existence, and file_copy rules
+// against the configured worktrees. Unlike loading the config, it reports every problem it finds
+// instead of stopping at the first.
+func ValidateConfigFiles(repoPath string) []ConfigProblem {
+	rootPath := repoPath
+	if IsBareRepository(repoPath) {
+		if bareRoot, err := BareRepositoryRoot(repoPath); err == nil {
+			rootPath = bareRoot
+		}
+	}
+
+	config, tomlLines, problems := validateConfigTOML(rootPath)
+	gbmConfig, branchProblems := validateBranchConfig(repoPath, rootPath, config)
+	problems = append(problems, branchProblems...)
+	problems = append(problems, validateFileCopyRules(rootPath, config, gbmConfig, tomlLines)...)
+
+	return problems
+}
+
+// validateConfigTOML checks config.toml, returning the config with defaults applied (or the default
+// config when the file is missing or unreadable) and the file's lines for locating later problems
+func validateConfigTOML(rootPath string) (*Config, []string, []ConfigProblem) {
+	file := filepath.Join(DefaultConfigDirname, DefaultConfigFilename)
+	data, err := os.ReadFile(filepath.Join(rootPath, file))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return DefaultConfig(), nil, nil
+		}
+		return DefaultConfig(), nil, []ConfigProblem{{File: file, Message: err.Error()}}
+	}
+	lines := strings.Split(string(data), "\n")
+
+	var config Config
+	metadata, err := toml.Decode(string(data), &config)
+	if err != nil {
+		problem := ConfigProblem{File: file, Message: err.Error()}
+		var parseErr toml.ParseError
+		if errors.As(err, &parseErr) {
+			problem.Line = parseErr.Position.Line
+			problem.Message = parseErr.Message
+		}
+		return DefaultConfig(), lines, []ConfigProblem{problem}
+	}
+	config.applyDefaults(metadata)
+
+	var problems []ConfigProblem
+	reported := make(map[string]bool)
+	for _, key := range metadata.Undecoded() {
+		// An unknown table makes every key in it unknown; report the table once
+		if reported[key.String()] || (len(key) > 1 && reported[key[:len(key)-1].String()]) {
+			reported[key.String()] = true
+			continue
+		}
+		reported[key.String()] = true
+		problems = append(problems, ConfigProblem{
+			File:    file,
+			Line:    findTOMLKeyLine(lines, key),
+			Message: fmt.Sprintf("unknown key %q", key.String()),
+		})
+	}
+
+	for _, issue := range config.issues() {
+		problems = append(problems, ConfigProblem{
+			File:    file,
+			Line:    findTOMLKeyLine(lines, strings.Split(issue.key, ".")),
+			Message: issue.err.Error(),
+		})
+	}
+
+	// Prefixes end up in paths and worktree directory names
+	for _, key := range []struct{ name, value string }{
+		{"hotfix_prefix", config.Settings.HotfixPrefix},
+		{"mergeback_prefix", config.Settings.MergebackPrefix},
+	} {
+		if strings.ContainsAny(key.value, `/\ `+"\t") {
+			problems = append(problems, ConfigProblem{
+				File:    file,
+				Line:    findTOMLKeyLine(lines, toml.Key{"settings", key.name}),
+				Message: fmt.Sprintf("invalid %s: %q must not contain slashes or whitespace", key.name, key.value),
+			})
+		}
+	}
+
+	return &config, lines, problems
+}
+
+// validateBranchConfig checks gbm.branchconfig.yaml and that every branch it names exists
+func validateBranchConfig(repoPath, rootPath string, config *Config) (*GBMConfig, []ConfigProblem) {
+	file := DefaultBranchConfigFilename
+	data, err := os.ReadFile(filepath.Join(rootPath, file))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, []ConfigProblem{{File: file, Message: "file not found; create one with `gbm init`"}}
+		}
+		return nil, []ConfigProblem{{File: file, Message: err.Error()}}
+	}
+	lines := strings.Split(string(data), "\n")
+
+	var gbmConfig GBMConfig
+	if err := yaml.Unmarshal(data, &gbmConfig); err != nil {
+		problem := ConfigProblem{File: file, Message: err.Error()}
+		if match := yamlErrorLinePattern.FindStringSubmatch(err.Error()); match != nil {
+			problem.Line, _ = strconv.Atoi(match[1])
+		}
+		return nil, []ConfigProblem{problem}
+	}
+
+	var problems []ConfigProblem
+	if tree, err := NewWorktreeManager(&gbmConfig); err != nil {
+		problems = append(problems, ConfigProblem{File: file, Message: err.Error()})
+	} else {
+		gbmConfig.Tree = tree
+	}
+
+	// The tree covers duplicate branches and merge_into; branches are checked like Manager.ValidateConfig does
+	gitManager, err := NewGitManager(repoPath, config.Settings.WorktreePrefix)
+	if err != nil {
+		return &gbmConfig, append(problems, ConfigProblem{File: file, Message: fmt.Sprintf("cannot check branches: %v", err)})
+	}
+	gitManager.SetDefaultRemote(config.Settings.DefaultRemote)
+
+	for _, name := range slices.Sorted(maps.Keys(gbmConfig.Worktrees)) {
+		if err := gitManager.validateWorktreeBranch(name, gbmConfig.Worktrees[name].Branch); err != nil {
+			problems = append(problems, ConfigProblem{File: file, Line: findYAMLWorktreeLine(lines, name), Message: err.Error()})
+		}
+	}
+
+	return &gbmConfig, problems
+}
+
+// validateFileCopyRules checks that every file_copy rule names a known source worktree and relative files
+func validateFileCopyRules(rootPath string, config *Config, gbmConfig *GBMConfig, tomlLines []string) []ConfigProblem {
+	file := filepath.Join(DefaultConfigDirname, DefaultConfigFilename)
+	ruleLines := findTOMLTableLines(tomlLines, "file_copy.rules")
+
+	var problems []ConfigProblem
+	for i, rule := range config.FileCopy.Rules {
+		line := 0
+		if i < len(ruleLines) {
+			line = ruleLines[i]
+		}
+		report := func(format string, args ...any) {
+			problems = append(problems, ConfigProblem{
+				File:    file,
+				Line:    line,
+				Message: fmt.Sprintf("file_copy rule %d: ", i+1) + fmt.Sprintf(format, args...),
+			})
+		}
+
+		switch {
+		case rule.SourceWorktree == "":
+			report("source_worktree is required")
+		case gbmConfig != nil:
+			_, tracked := gbmConfig.Worktrees[rule.SourceWorktree]
+			_, statErr := os.Stat(filepath.Join(rootPath, config.Settings.WorktreePrefix, rule.SourceWorktree))
+			if !tracked && statErr != nil {
+				report("source_worktree '%s' is not a worktree in %s", rule.SourceWorktree, DefaultBranchConfigFilename)
+			}
+		}
+
+		if len(rule.Files) == 0 {
+			report("files is empty")
+		}
+		for _, path := range rule.Files {
+			if !filepath.IsLocal(filepath.FromSlash(path)) {
+				report("file %q must be a relative path inside the worktree", path)
+			}
+		}
+	}
+
+	return problems
+}
+
+// findTOMLKeyLine returns the 1-based line defining key, or 0 when it can't be found. A key naming a
+// table is found at its header.
+func findTOMLKeyLine(lines []string, key toml.Key) int {
+	if len(key) == 0 {
+		return 0
+	}
+	table := key[:len(key)-1].String()
+	name := key[len(key)-1]
+	keyPattern := regexp.MustCompile(`^\s*"?` + regexp.QuoteMeta(name) + `"?\s*=`)
+
+	current := ""
+	for i, line := range lines {
+		if match := tomlTableHeaderPattern.FindStringSubmatch(line); match != nil {
+			current = match[1]
+			if current == key.String() {
+				return i + 1
+			}
+			continue
+		}
+		if current == table && keyPattern.MatchString(line) {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// findTOMLTableLines returns the 1-based lines of every header of the named table, in order
+func findTOMLTableLines(lines []string, table string) []int {
+	var found []int
+	for i, line := range lines {
+		if match := tomlTableHeaderPattern.FindStringSubmatch(line); match != nil && match[1] == table {
+			found = append(found, i+1)
+		}
+	}
+	return found
+}
+
+// findYAMLWorktreeLine returns the 1-based line of a worktree's entry under worktrees:, or 0
+func findYAMLWorktreeLine(lines []string, name string) int {
+	entryIndent := ""
+	inWorktrees := false
+	for i, line := range lines {
+		if strings.HasPrefix(line, "worktrees:") {
+			inWorktrees = true
+			continue
+		}
+		trimmed := strings.TrimSpace(line)
+		if !inWorktrees || trimmed == "" || strings.HasPrefix(trimmed, "#") {
+			continue
+		}
+
+		// Entries sit at the indentation of the first one; deeper lines are their fields
+		indent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		if indent == "" {
+			return 0
+		}
+		if entryIndent == "" {
+			entryIndent = indent
+		}
+		if indent != entryIndent {
+			continue
+		}
+
+		key, _, _ := strings.Cut(trimmed, ":")
+		if strings.Trim(key, `"'`) == name {
+			return i + 1
+		}
+	}
+	return 0
+}
@@ -0,0 +1,133 @@
+package internal
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gbm/internal/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateConfigFiles(t *testing.T) {
+	configFile := filepath.Join(DefaultConfigDirname, DefaultConfigFilename)
+
+	tests := []struct {
+		name         string
+		configTOML   string // written to .gbm/config.toml when not empty
+		branchConfig map[string]testutils.WorktreeConfig
+		expected     []ConfigProblem
+	}{
+		{
+			name: "valid configuration",
+			configTOML: `[settings]
+worktree_prefix = "worktrees"
+
+[[file_copy.rules]]
+source_worktree = "main"
+files = [".env"]
+`,
+			branchConfig: map[string]testutils.WorktreeConfig{
+				"main": {Branch: "main"},
+				"dev":  {Branch: "develop", MergeInto: "main"},
+			},
+		},
+		{
+			name: "every problem is reported with its line",
+			configTOML: `[settings]
+fetch_retries = -1
+hotfix_prefix = "hot fix"
+colour = "blue"
+
+[jira]
+base_url = "not a url"
+
+[[file_copy.rules]]
+source_worktree = "staging"
+files = ["/etc/passwd"]
+`,
+			branchConfig: map[string]testutils.WorktreeConfig{
+				"main": {Branch: "main"},
+				"dev":  {Branch: "develop", MergeInto: "main"},
+				"qa":   {Branch: "does-not-exist", MergeInto: "dev"},
+			},
+			expected: []ConfigProblem{
+				{File: configFile, Line: 4, Message: `unknown key "settings.colour"`},
+				{File: configFile, Line: 2, Message: "invalid fetch_retries: must not be negative, got -1"},
+				{File: configFile, Line: 7, Message: `invalid jira.base_url: "not a url" is not an http(s) URL`},
+				{File: configFile, Line: 3, Message: `invalid hotfix_prefix: "hot fix" must not contain slashes or whitespace`},
+				{File: DefaultBranchConfigFilename, Line: 10, Message: "branch 'does-not-exist' for worktree 'qa' does not exist"},
+				{File: configFile, Line: 9, Message: "file_copy rule 1: source_worktree 'staging' is not a worktree in gbm.branchconfig.yaml"},
+				{File: configFile, Line: 9, Message: `file_copy rule 1: file "/etc/passwd" must be a relative path inside the worktree`},
+			},
+		},
+		{
+			name: "duplicate branches are reported once, by the tree check",
+			branchConfig: map[string]testutils.WorktreeConfig{
+				"main":    {Branch: "main"},
+				"dev":     {Branch: "develop", MergeInto: "main"},
+				"staging": {Branch: "develop", MergeInto: "main"},
+			},
+			expected: []ConfigProblem{
+				{File: DefaultBranchConfigFilename, Message: "duplicate branch: branch 'develop' is assigned to multiple worktrees: dev, staging"},
+			},
+		},
+		{
+			name:       "toml syntax error",
+			configTOML: "[settings\nworktree_prefix = 1\n",
+			branchConfig: map[string]testutils.WorktreeConfig{
+				"main": {Branch: "main"},
+			},
+			expected: []ConfigProblem{
+				{File: configFile, Line: 2, Message: "expected '.' or ']' to end table name, but got '\\n' instead"},
+			},
+		},
+		{
+			name: "cycle in the merge_into tree",
+			branchConfig: map[string]testutils.WorktreeConfig{
+				"main": {Branch: "main", MergeInto: "dev"},
+				"dev":  {Branch: "develop", MergeInto: "main"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := testutils.NewMultiBranchRepo(t)
+			defer repo.Cleanup()
+
+			require.NoError(t, repo.CreateGBMConfig(tt.branchConfig))
+			if tt.configTOML != "" {
+				require.NoError(t, os.MkdirAll(filepath.Join(repo.GetLocalPath(), DefaultConfigDirname), 0o755))
+				require.NoError(t, os.WriteFile(filepath.Join(repo.GetLocalPath(), configFile), []byte(tt.configTOML), 0o644))
+			}
+
+			problems := ValidateConfigFiles(repo.GetLocalPath())
+
+			if tt.name == "cycle in the merge_into tree" {
+				require.NotEmpty(t, problems)
+				assert.Equal(t, DefaultBranchConfigFilename, problems[0].File)
+				assert.Contains(t, problems[0].Message, "circular dependency")
+				return
+			}
+			assert.Equal(t, tt.expected, problems)
+		})
+	}
+
+	t.Run("missing branch config", func(t *testing.T) {
+		repo := testutils.NewBasicRepo(t)
+		defer repo.Cleanup()
+
+		problems := ValidateConfigFiles(repo.GetLocalPath())
+		require.Len(t, problems, 1)
+		assert.Equal(t, DefaultBranchConfigFilename, problems[0].File)
+		assert.Contains(t, problems[0].Message, "gbm init")
+	})
+}
+
+func TestConfigProblem_String(t *testing.T) {
+	assert.Equal(t, "gbm.branchconfig.yaml:3: bad", ConfigProblem{File: "gbm.branchconfig.yaml", Line: 3, Message: "bad"}.String())
+	assert.Equal(t, "gbm.branchconfig.yaml: bad", ConfigProblem{File: "gbm.branchconfig.yaml", Message: "bad"}.String())
+}
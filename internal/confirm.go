@@ -0,0 +1,48 @@
+package internal
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// AlwaysConfirm answers yes to every question, for non-interactive runs with --yes
+func AlwaysConfirm(string) bool {
+	return true
+}
+
+// NeverConfirm answers no to every question, for dry runs and runs without a terminal
+func NeverConfirm(string) bool {
+	return false
+}
+
+// NewInteractiveConfirmation returns a ConfirmationFunc that prints the question to out and reads
+// a y/n answer from in. Anything other than "y" or "yes" (case-insensitive), including end of
+// input, counts as no.
+func NewInteractiveConfirmation(in io.Reader, out io.Writer) ConfirmationFunc {
+	return func(message string) bool {
+		_, _ = fmt.Fprintf(out, "%s ", FormatPrompt(message+" [y/N]:"))
+		response := strings.ToLower(strings.TrimSpace(readLine(in)))
+		return response == "y" || response == "yes"
+	}
+}
+
+// readLine reads up to the next newline one byte at a time, so that answers to later prompts
+// are left unread in a shared input such as stdin
+func readLine(in io.Reader) string {
+	var line strings.Builder
+	buf := make([]byte, 1)
+	for {
+		n, err := in.Read(buf)
+		if n > 0 {
+			if buf[0] == '\n' {
+				break
+			}
+			line.WriteByte(buf[0])
+		}
+		if err != nil {
+			break
+		}
+	}
+	return line.String()
+}
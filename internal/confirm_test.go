@@ -0,0 +1,52 @@
+package internal
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewInteractiveConfirmation(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected bool
+	}{
+		{"accepts y", "y\n", true},
+		{"accepts yes", "yes\n", true},
+		{"accepts uppercase", "YES\n", true},
+		{"accepts answer without newline", "y", true},
+		{"trims whitespace", "  y \r\n", true},
+		{"rejects n", "n\n", false},
+		{"rejects empty", "\n", false},
+		{"rejects end of input", "", false},
+		{"rejects random", "maybe\n", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			confirm := NewInteractiveConfirmation(strings.NewReader(tt.input), &out)
+			assert.Equal(t, tt.expected, confirm("Proceed?"))
+			assert.Contains(t, out.String(), "Proceed? [y/N]:")
+		})
+	}
+
+	t.Run("answers are read one line per question", func(t *testing.T) {
+		in := strings.NewReader("y\nn\nyes\n")
+		confirm := NewInteractiveConfirmation(in, &bytes.Buffer{})
+		assert.True(t, confirm("first?"))
+
+		// A second prompt on the same input picks up where the first stopped
+		other := NewInteractiveConfirmation(in, &bytes.Buffer{})
+		assert.False(t, other("second?"))
+		assert.True(t, confirm("third?"))
+	})
+}
+
+func TestFixedConfirmations(t *testing.T) {
+	assert.True(t, AlwaysConfirm("Remove orphaned worktree?"))
+	assert.False(t, NeverConfirm("Remove orphaned worktree?"))
+}
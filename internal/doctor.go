@@ -0,0 +1,247 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// MinGitVersion is the oldest git release gbm's worktree handling is tested against
+var MinGitVersion = [3]int{2, 20, 0}
+
+// MinSparseCheckoutGitVersion is the oldest git whose sparse-checkout keeps its settings in the
+// worktree's own config, so sparse worktrees (gbm add --sparse) leave other worktrees alone
+var MinSparseCheckoutGitVersion = [3]int{2, 36, 0}
+
+// DiagnosticSeverity ranks the outcome of a single doctor check
+type DiagnosticSeverity int
+
+const (
+	DiagnosticOK DiagnosticSeverity = iota
+	DiagnosticWarning
+	DiagnosticError
+)
+
+func (s DiagnosticSeverity) String() string {
+	switch s {
+	case DiagnosticWarning:
+		return "warning"
+	case DiagnosticError:
+		return "error"
+	default:
+		return "ok"
+	}
+}
+
+// Diagnostic is the result of one doctor check. Hint, when set, tells the user how to fix the problem.
+type Diagnostic struct {
+	Severity DiagnosticSeverity
+	Check    string
+	Message  string
+	Hint     string
+}
+
+var gitVersionPattern = regexp.MustCompile(`(\d+)\.(\d+)(?:\.(\d+))?`)
+
+// parseGitVersion extracts the numeric version from `git --version` output,
+// e.g. "git version 2.39.3 (Apple Git-145)" -> [2 39 3]
+func parseGitVersion(output string) ([3]int, error) {
+	var version [3]int
+	match := gitVersionPattern.FindStringSubmatch(output)
+	if match == nil {
+		return version, fmt.Errorf("unrecognized git version output: %q", strings.TrimSpace(output))
+	}
+	for i, part := range match[1:] {
+		if part == "" {
+			continue
+		}
+		n, err := strconv.Atoi(part)
+		if err != nil {
+			return version, fmt.Errorf("unrecognized git version output: %q", strings.TrimSpace(output))
+		}
+		version[i] = n
+	}
+	return version, nil
+}
+
+func formatGitVersion(version [3]int) string {
+	return fmt.Sprintf("%d.%d.%d", version[0], version[1], version[2])
+}
+
+// DoctorCheck runs a series of self-diagnostics against the repository, its gbm configuration
+// and the tools gbm relies on. Every check contributes at least one Diagnostic, so the result
+// doubles as a checklist of what was verified.
+func (m *Manager) DoctorCheck() []Diagnostic {
+	var diagnostics []Diagnostic
+	diagnostics = append(diagnostics, m.checkGitVersion())
+	diagnostics = append(diagnostics, m.checkRemote())
+
+	configDiagnostics, configLoaded := m.checkBranchConfig()
+	diagnostics = append(diagnostics, configDiagnostics...)
+	if configLoaded {
+		diagnostics = append(diagnostics, m.checkWorktreeDirectories())
+	}
+
+	diagnostics = append(diagnostics, m.checkOrphanedWorktreeEntries())
+	diagnostics = append(diagnostics, checkJiraCli())
+	return diagnostics
+}
+
+func (m *Manager) checkGitVersion() Diagnostic {
+	const check = "git"
+
+	output, err := ExecGitCommand(m.repoPath, "--version")
+	if err != nil {
+		return Diagnostic{
+			Severity: DiagnosticError,
+			Check:    check,
+			Message:  fmt.Sprintf("git is not available: %v", err),
+			Hint:     "install git and make sure it is on your PATH",
+		}
+	}
+
+	version, err := parseGitVersion(string(output))
+	if err != nil {
+		return Diagnostic{Severity: DiagnosticWarning, Check: check, Message: err.Error()}
+	}
+
+	if slices.Compare(version[:], MinGitVersion[:]) < 0 {
+		return Diagnostic{
+			Severity: DiagnosticError,
+			Check:    check,
+			Message:  fmt.Sprintf("git %s is older than the minimum supported %s", formatGitVersion(version), formatGitVersion(MinGitVersion)),
+			Hint:     "upgrade git",
+		}
+	}
+
+	if err := checkSparseCheckoutVersion(version); err != nil {
+		return Diagnostic{Severity: DiagnosticWarning, Check: check, Message: err.Error(), Hint: "upgrade git to use gbm add --sparse"}
+	}
+
+	return Diagnostic{Severity: DiagnosticOK, Check: check, Message: fmt.Sprintf("git %s", formatGitVersion(version))}
+}
+
+func (m *Manager) checkRemote() Diagnostic {
+	const check = "remote"
+	remote := m.gitManager.GetDefaultRemote()
+
+	remoteURL, err := m.gitManager.GetRemoteURL(remote)
+	if err != nil {
+		return Diagnostic{
+			Severity: DiagnosticError,
+			Check:    check,
+			Message:  fmt.Sprintf("remote '%s' is not configured", remote),
+			Hint:     fmt.Sprintf("add it with `git remote add %s <url>` or set settings.default_remote", remote),
+		}
+	}
+
+	return Diagnostic{
+		Severity: DiagnosticOK,
+		Check:    check,
+		Message:  fmt.Sprintf("%s -> %s", remote, remoteURL),
+	}
+}
+
+// checkBranchConfig parses gbm.branchconfig.yaml, verifies its branches exist and that the
+// merge_into links form a tree. The second result reports whether the config could be loaded.
+func (m *Manager) checkBranchConfig() ([]Diagnostic, bool) {
+	const check = "config"
+
+	// Parsing builds the worktree tree with NewWorktreeManager, so cycles surface as load errors
+	if err := m.LoadGBMConfig(""); err != nil {
+		if errors.Is(err, ErrCircularDependency) || errors.Is(err, ErrSelfMerge) || errors.Is(err, ErrAmbiguousMergeChain) || errors.Is(err, ErrDuplicateBranch) {
+			return []Diagnostic{{
+				Severity: DiagnosticError,
+				Check:    "tree",
+				Message:  err.Error(),
+				Hint:     fmt.Sprintf("fix the merge_into entries in %s", DefaultBranchConfigFilename),
+			}}, false
+		}
+		return []Diagnostic{{
+			Severity: DiagnosticError,
+			Check:    check,
+			Message:  fmt.Sprintf("failed to load %s: %v", DefaultBranchConfigFilename, err),
+			Hint:     fmt.Sprintf("create one with `gbm init` or fix the YAML in %s", DefaultBranchConfigFilename),
+		}}, false
+	}
+
+	diagnostics := []Diagnostic{{Severity: DiagnosticOK, Check: "tree", Message: "merge_into links form a tree"}}
+	if err := m.ValidateConfig(); err != nil {
+		return append(diagnostics, Diagnostic{
+			Severity: DiagnosticError,
+			Check:    check,
+			Message:  err.Error(),
+			Hint:     "run `gbm validate` for details",
+		}), true
+	}
+
+	return append(diagnostics, Diagnostic{
+		Severity: DiagnosticOK,
+		Check:    check,
+		Message:  fmt.Sprintf("%s is valid (%d worktrees)", DefaultBranchConfigFilename, len(m.gbmConfig.Worktrees)),
+	}), true
+}
+
+func (m *Manager) checkWorktreeDirectories() Diagnostic {
+	const check = "worktrees"
+
+	var missing []string
+	for _, name := range slices.Sorted(maps.Keys(m.gbmConfig.Worktrees)) {
+		worktreePath := filepath.Join(m.repoPath, m.config.Settings.WorktreePrefix, name)
+		if _, err := os.Stat(worktreePath); os.IsNotExist(err) {
+			missing = append(missing, name)
+		}
+	}
+
+	if len(missing) > 0 {
+		return Diagnostic{
+			Severity: DiagnosticWarning,
+			Check:    check,
+			Message:  fmt.Sprintf("missing worktree directories: %s", strings.Join(missing, ", ")),
+			Hint:     "run `gbm sync` to create them",
+		}
+	}
+
+	return Diagnostic{Severity: DiagnosticOK, Check: check, Message: "all tracked worktree directories exist"}
+}
+
+func (m *Manager) checkOrphanedWorktreeEntries() Diagnostic {
+	const check = "git worktrees"
+
+	stale, err := m.gitManager.PruneWorktrees(true)
+	if err != nil {
+		return Diagnostic{Severity: DiagnosticWarning, Check: check, Message: err.Error()}
+	}
+
+	if len(stale) > 0 {
+		return Diagnostic{
+			Severity: DiagnosticWarning,
+			Check:    check,
+			Message:  fmt.Sprintf("%d orphaned git worktree entries: %s", len(stale), strings.Join(stale, "; ")),
+			Hint:     "run `gbm prune` to remove them",
+		}
+	}
+
+	return Diagnostic{Severity: DiagnosticOK, Check: check, Message: "no orphaned git worktree entries"}
+}
+
+func checkJiraCli() Diagnostic {
+	const check = "jira"
+
+	if !IsJiraCliAvailable() {
+		return Diagnostic{
+			Severity: DiagnosticWarning,
+			Check:    check,
+			Message:  "jira CLI not found; ticket details and JIRA key completion are unavailable",
+			Hint:     "install https://github.com/ankitpokhrel/jira-cli for JIRA integration",
+		}
+	}
+
+	return Diagnostic{Severity: DiagnosticOK, Check: check, Message: "jira CLI is available"}
+}
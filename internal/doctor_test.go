@@ -0,0 +1,178 @@
+package internal
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gbm/internal/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGitVersion(t *testing.T) {
+	tests := []struct {
+		name      string
+		output    string
+		expected  [3]int
+		assertErr func(t *testing.T, err error)
+	}{
+		{
+			name:      "plain version",
+			output:    "git version 2.43.0\n",
+			expected:  [3]int{2, 43, 0},
+			assertErr: func(t *testing.T, err error) { assert.NoError(t, err) },
+		},
+		{
+			name:      "vendor suffix",
+			output:    "git version 2.39.3 (Apple Git-145)",
+			expected:  [3]int{2, 39, 3},
+			assertErr: func(t *testing.T, err error) { assert.NoError(t, err) },
+		},
+		{
+			name:      "windows build",
+			output:    "git version 2.45.1.windows.1",
+			expected:  [3]int{2, 45, 1},
+			assertErr: func(t *testing.T, err error) { assert.NoError(t, err) },
+		},
+		{
+			name:      "no patch version",
+			output:    "git version 2.20",
+			expected:  [3]int{2, 20, 0},
+			assertErr: func(t *testing.T, err error) { assert.NoError(t, err) },
+		},
+		{
+			name:   "unrecognized output",
+			output: "not git",
+			assertErr: func(t *testing.T, err error) {
+				assert.ErrorContains(t, err, "unrecognized git version output")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			version, err := parseGitVersion(tt.output)
+			tt.assertErr(t, err)
+			if err == nil {
+				assert.Equal(t, tt.expected, version)
+			}
+		})
+	}
+}
+
+func diagnosticsByCheck(diagnostics []Diagnostic) map[string]Diagnostic {
+	byCheck := make(map[string]Diagnostic)
+	for _, diagnostic := range diagnostics {
+		byCheck[diagnostic.Check] = diagnostic
+	}
+	return byCheck
+}
+
+func TestManager_DoctorCheck(t *testing.T) {
+	t.Run("healthy repository reports no errors", func(t *testing.T) {
+		repo := testutils.NewGitTestRepo(t,
+			testutils.WithDefaultBranch("main"),
+			testutils.WithUser("Test User", "test@example.com"),
+		)
+		must(t, repo.CreateBranch("dev", "dev content"))
+		must(t, repo.SwitchToBranch("main"))
+		must(t, repo.WriteFile(".gitignore", "worktrees/\n"))
+		must(t, repo.CreateGBMConfig(map[string]testutils.WorktreeConfig{
+			"main": {Branch: "main"},
+			"dev":  {Branch: "dev", MergeInto: "main"},
+		}))
+		must(t, repo.CommitChanges("Add gbm config"))
+
+		manager, err := NewManager(repo.GetLocalPath())
+		require.NoError(t, err)
+		must(t, manager.LoadGBMConfig(""))
+		must(t, manager.Sync(false, true))
+
+		diagnostics := diagnosticsByCheck(manager.DoctorCheck())
+		for _, check := range []string{"git", "remote", "config", "tree", "worktrees", "git worktrees"} {
+			require.Contains(t, diagnostics, check)
+			assert.Equal(t, DiagnosticOK, diagnostics[check].Severity, "%s: %s", check, diagnostics[check].Message)
+		}
+		assert.Contains(t, diagnostics, "jira")
+	})
+
+	t.Run("reports cycles and orphaned worktree entries", func(t *testing.T) {
+		repo := testutils.NewGitTestRepo(t,
+			testutils.WithDefaultBranch("main"),
+			testutils.WithUser("Test User", "test@example.com"),
+		)
+		must(t, repo.CreateBranch("dev", "dev content"))
+		must(t, repo.SwitchToBranch("main"))
+		must(t, repo.WriteFile(".gitignore", "worktrees/\n"))
+		must(t, repo.CreateGBMConfig(map[string]testutils.WorktreeConfig{
+			"main": {Branch: "main", MergeInto: "dev"},
+			"dev":  {Branch: "dev", MergeInto: "main"},
+		}))
+		must(t, repo.CommitChanges("Add gbm config"))
+
+		gitManager, err := NewGitManager(repo.GetLocalPath(), "worktrees")
+		require.NoError(t, err)
+		must(t, gitManager.AddWorktree("stale", "feature/stale", true, ""))
+		require.NoError(t, os.RemoveAll(filepath.Join(repo.GetLocalPath(), "worktrees", "stale")))
+
+		manager, err := NewManager(repo.GetLocalPath())
+		require.NoError(t, err)
+
+		diagnostics := diagnosticsByCheck(manager.DoctorCheck())
+		assert.Equal(t, DiagnosticError, diagnostics["tree"].Severity)
+		assert.Contains(t, diagnostics["tree"].Message, "circular dependency")
+		assert.NotContains(t, diagnostics, "worktrees")
+
+		assert.Equal(t, DiagnosticWarning, diagnostics["git worktrees"].Severity)
+		assert.Contains(t, diagnostics["git worktrees"].Message, "stale")
+	})
+
+	t.Run("reports missing worktree directories and unknown branches", func(t *testing.T) {
+		repo := testutils.NewGitTestRepo(t,
+			testutils.WithDefaultBranch("main"),
+			testutils.WithUser("Test User", "test@example.com"),
+		)
+		must(t, repo.CreateBranch("dev", "dev content"))
+		must(t, repo.SwitchToBranch("main"))
+		must(t, repo.CreateGBMConfig(map[string]testutils.WorktreeConfig{
+			"main":    {Branch: "main"},
+			"dev":     {Branch: "dev", MergeInto: "main"},
+			"staging": {Branch: "staging", MergeInto: "main"},
+		}))
+		must(t, repo.CommitChanges("Add gbm config"))
+
+		manager, err := NewManager(repo.GetLocalPath())
+		require.NoError(t, err)
+
+		diagnostics := diagnosticsByCheck(manager.DoctorCheck())
+		assert.Equal(t, DiagnosticOK, diagnostics["tree"].Severity)
+
+		assert.Equal(t, DiagnosticError, diagnostics["config"].Severity)
+		assert.Contains(t, diagnostics["config"].Message, "staging")
+
+		assert.Equal(t, DiagnosticWarning, diagnostics["worktrees"].Severity)
+		assert.Contains(t, diagnostics["worktrees"].Message, "dev, main, staging")
+		assert.Contains(t, diagnostics["worktrees"].Hint, "gbm sync")
+	})
+
+	t.Run("missing remote and config are errors", func(t *testing.T) {
+		repo := testutils.NewGitTestRepo(t,
+			testutils.WithDefaultBranch("main"),
+			testutils.WithUser("Test User", "test@example.com"),
+		)
+
+		manager, err := NewManager(repo.GetLocalPath())
+		require.NoError(t, err)
+		manager.gitManager.SetDefaultRemote("upstream")
+
+		diagnostics := diagnosticsByCheck(manager.DoctorCheck())
+		assert.Equal(t, DiagnosticError, diagnostics["remote"].Severity)
+		assert.Contains(t, diagnostics["remote"].Message, "upstream")
+
+		assert.Equal(t, DiagnosticError, diagnostics["config"].Severity)
+		assert.NotContains(t, diagnostics, "tree")
+		assert.NotContains(t, diagnostics, "worktrees")
+	})
+}
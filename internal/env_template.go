@@ -0,0 +1,89 @@
+package internal
+
+import (
+	"bytes"
+	"fmt"
+	"hash/fnv"
+	"os"
+	"path/filepath"
+	"text/template"
+)
+
+// EnvTemplateValues are the variables available to the [env_template] template
+type EnvTemplateValues struct {
+	WorktreeName string
+	WorktreePath string
+	Branch       string // empty for a detached HEAD
+	Port         int
+}
+
+// EnvTemplatePort derives a worktree's port from a hash of its name, so the same worktree gets the
+// same port every time it is created
+func (c ConfigEnv) EnvTemplatePort(worktreeName string) int {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(worktreeName))
+	return c.BasePort + int(hash.Sum32()%uint32(c.PortRange))
+}
+
+// GetEnvTemplateValues resolves the env template variables for an existing worktree
+func (m *Manager) GetEnvTemplateValues(worktreeName string) (*EnvTemplateValues, error) {
+	worktreePath, err := m.GetWorktreePath(worktreeName)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.envTemplateValues(worktreeName, worktreePath), nil
+}
+
+func (m *Manager) envTemplateValues(worktreeName, worktreePath string) *EnvTemplateValues {
+	branch, err := m.gitManager.GetCurrentBranchInPath(worktreePath)
+	if err != nil || branch == "HEAD" {
+		branch = "" // Detached HEAD
+	}
+
+	return &EnvTemplateValues{
+		WorktreeName: worktreeName,
+		WorktreePath: worktreePath,
+		Branch:       branch,
+		Port:         m.config.Env.EnvTemplatePort(worktreeName),
+	}
+}
+
+// renderEnvTemplate writes the configured env template into a new worktree. Nothing is written
+// unless both a template and a destination are configured.
+func (m *Manager) renderEnvTemplate(worktreeName string) error {
+	env := m.config.Env
+	if env.Template == "" || env.Destination == "" {
+		return nil
+	}
+
+	templatePath := env.Template
+	if !filepath.IsAbs(templatePath) {
+		templatePath = filepath.Join(m.repoPath, templatePath)
+	}
+	if !filepath.IsLocal(filepath.FromSlash(env.Destination)) {
+		return fmt.Errorf("env_template destination %q must be a relative path inside the worktree", env.Destination)
+	}
+
+	tmpl, err := template.New(filepath.Base(templatePath)).ParseFiles(templatePath)
+	if err != nil {
+		return fmt.Errorf("failed to parse env template: %w", err)
+	}
+
+	worktreePath := filepath.Join(m.repoPath, m.config.Settings.WorktreePrefix, worktreeName)
+	var rendered bytes.Buffer
+	if err := tmpl.Execute(&rendered, m.envTemplateValues(worktreeName, worktreePath)); err != nil {
+		return fmt.Errorf("failed to render env template: %w", err)
+	}
+
+	destPath := filepath.Join(worktreePath, filepath.FromSlash(env.Destination))
+	if err := os.MkdirAll(filepath.Dir(destPath), 0o755); err != nil {
+		return fmt.Errorf("failed to create directory for %s: %w", env.Destination, err)
+	}
+	if err := os.WriteFile(destPath, rendered.Bytes(), 0o644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", env.Destination, err)
+	}
+
+	logInfo("Rendered env template into %s", destPath)
+	return nil
+}
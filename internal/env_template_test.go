@@ -0,0 +1,46 @@
+package internal
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManager_RenderEnvTemplate(t *testing.T) {
+	manager, repoPath, _ := setupManagerForRemoverTests(t)
+	require.NoError(t, os.WriteFile(filepath.Join(repoPath, "env.template"),
+		[]byte("NAME={{.WorktreeName}}\nBRANCH={{.Branch}}\nPORT={{.Port}}\n"), 0o644))
+	manager.GetConfig().Env.Template = "env.template"
+	manager.GetConfig().Env.Destination = "config/.env"
+
+	require.NoError(t, manager.AddWorktree("feature", "feature/env", true, "main"))
+
+	port := manager.GetConfig().Env.EnvTemplatePort("feature")
+	content, err := os.ReadFile(filepath.Join(repoPath, "worktrees", "feature", "config", ".env"))
+	require.NoError(t, err)
+	assert.Equal(t, "NAME=feature\nBRANCH=feature/env\nPORT="+strconv.Itoa(port)+"\n", string(content))
+
+	values, err := manager.GetEnvTemplateValues("feature")
+	require.NoError(t, err)
+	assert.Equal(t, "feature/env", values.Branch)
+	assert.Equal(t, port, values.Port)
+
+	// A destination outside the worktree is refused but the worktree is kept
+	manager.GetConfig().Env.Destination = "../escaped.env"
+	require.NoError(t, manager.AddWorktree("escape", "feature/escape", true, "main"))
+	assert.NoFileExists(t, filepath.Join(repoPath, "worktrees", "escaped.env"))
+}
+
+func TestConfigEnv_EnvTemplatePort(t *testing.T) {
+	env := DefaultConfig().Env
+
+	port := env.EnvTemplatePort("dev")
+	assert.Equal(t, port, env.EnvTemplatePort("dev"), "ports are deterministic")
+	assert.GreaterOrEqual(t, port, DefaultEnvBasePort)
+	assert.Less(t, port, DefaultEnvBasePort+DefaultEnvPortRange)
+	assert.NotEqual(t, port, env.EnvTemplatePort("feature"))
+}
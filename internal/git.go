@@ -1,46 +1,66 @@
 package internal
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-git/go-git/v5"
-	"github.com/go-git/go-git/v5/plumbing"
-	"github.com/go-git/go-git/v5/plumbing/storer"
 )
 
 type GitManager struct {
 	repo           *git.Repository
 	repoPath       string
+	worktreeRoot   string // directory worktree paths are relative to; differs from repoPath in bare repositories
 	worktreePrefix string
+	remote         string
+	fetchRetries   int
+	fetchTimeout   time.Duration
+	tokenEnv       string
+
+	defaultBranchMu sync.Mutex
+	defaultBranch   string // cached result of GetDefaultBranch; cleared when the remote or its HEAD changes
+
+	remoteBranchesMu sync.Mutex
+	remoteBranches   []string // cached result of GetRemoteBranches; cleared when the remote or its branches change
+
+	upstreamMu sync.Mutex // serializes upstream writes to the shared .git/config during bulk push and pull
 }
 
 type WorktreeInfo struct {
 	Name       string
 	Path       string
-	Branch     string
+	Branch     string // for detached worktrees, a label of the form (detached@<shorthash>)
+	Detached   bool
+	Locked     bool
 	IsOrphaned bool
 	NeedsSync  bool
 	GitStatus  *GitStatus
 }
 
 type GitStatus struct {
-	IsDirty   bool
-	Ahead     int
-	Behind    int
-	Untracked int
-	Modified  int
-	Staged    int
+	IsDirty   bool `json:"is_dirty"`
+	Ahead     int  `json:"ahead"`
+	Behind    int  `json:"behind"`
+	Untracked int  `json:"untracked"`
+	Modified  int  `json:"modified"`
+	Staged    int  `json:"staged"`
+	Renamed   int  `json:"renamed"`
+	Copied    int  `json:"copied"`
+	Unmerged  int  `json:"unmerged"`
 }
 
 func (gs *GitStatus) HasChanges() bool {
-	return gs.IsDirty || gs.Untracked > 0 || gs.Modified > 0 || gs.Staged > 0
+	return gs.IsDirty || gs.Untracked > 0 || gs.Modified > 0 || gs.Staged > 0 || gs.Renamed > 0 || gs.Copied > 0 || gs.Unmerged > 0
 }
 
 // execCommand executes a command with debug output
@@ -77,6 +97,17 @@ func ExecGitCommandCombined(dir string, args ...string) ([]byte, error) {
 	return cmd.CombinedOutput()
 }
 
+// execGitCommandCombinedContext is ExecGitCommandCombined, killing git when ctx is done. Helpers
+// git spawns (ssh, remote-https) may keep the output open, so waiting for them is bounded too.
+func execGitCommandCombinedContext(ctx context.Context, dir string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, "git", args...)
+	if dir != "" {
+		cmd.Dir = dir
+	}
+	cmd.WaitDelay = time.Second
+	return cmd.CombinedOutput()
+}
+
 // ExecGitCommandInteractive executes a git command in the specified directory with live output to terminal
 func ExecGitCommandInteractive(dir string, args ...string) error {
 	cmd := exec.Command("git", args...)
@@ -136,6 +167,22 @@ func enhanceGitError(err error, operation string) error {
 	return fmt.Errorf("git %s failed: %w", operation, err)
 }
 
+// IsBareRepository reports whether path is (or resolves to) a bare git repository
+func IsBareRepository(path string) bool {
+	output, err := ExecGitCommand(path, "rev-parse", "--is-bare-repository")
+	return err == nil && strings.TrimSpace(string(output)) == "true"
+}
+
+// BareRepositoryRoot returns the directory containing a bare repository's git data.
+// gbm keeps its config and worktrees there, as siblings of the git data.
+func BareRepositoryRoot(path string) (string, error) {
+	output, err := ExecGitCommand(path, "rev-parse", "--absolute-git-dir")
+	if err != nil {
+		return "", enhanceGitError(err, "resolve git dir")
+	}
+	return filepath.Dir(strings.TrimSpace(string(output))), nil
+}
+
 // FindGitRoot finds the root directory of the git repository
 func FindGitRoot(startPath string) (string, error) {
 	// First, try direct git commands from the current directory
@@ -264,78 +311,86 @@ func NewGitManager(repoPath string, worktreePrefix string) (*GitManager, error)
 	return &GitManager{
 		repo:           repo,
 		repoPath:       repoPath,
+		worktreeRoot:   repoPath,
 		worktreePrefix: worktreePrefix,
+		remote:         DefaultRemoteName,
+		fetchRetries:   DefaultFetchRetries,
+		fetchTimeout:   DefaultFetchTimeout,
 	}, nil
 }
 
+// SetWorktreeRoot sets the directory that worktree paths are created under.
+// Git commands still run from the repository path.
+func (gm *GitManager) SetWorktreeRoot(root string) {
+	gm.worktreeRoot = root
+}
+
+// SetDefaultRemote sets the remote used for tracking and remote branch lookups.
+// An empty name resets it to DefaultRemoteName.
+func (gm *GitManager) SetDefaultRemote(remoteName string) {
+	if remoteName == "" {
+		remoteName = DefaultRemoteName
+	}
+	gm.remote = remoteName
+	gm.clearDefaultBranch()
+	gm.clearRemoteBranches()
+}
+
+// GetDefaultRemote returns the remote used for tracking and remote branch lookups
+func (gm *GitManager) GetDefaultRemote() string {
+	if gm.remote == "" {
+		return DefaultRemoteName
+	}
+	return gm.remote
+}
+
+// remoteBranch returns the remote branch name for a given branch on the configured default remote
+func (gm *GitManager) remoteBranch(branchName string) string {
+	return RemoteFor(gm.GetDefaultRemote(), branchName)
+}
+
 func (gm *GitManager) IsGitRepository() bool {
 	_, err := git.PlainOpen(gm.repoPath)
 	return err == nil
 }
 
+// BranchExists checks if a branch exists locally or on the default remote
 func (gm *GitManager) BranchExists(branchName string) (bool, error) {
-	refs, err := gm.repo.References()
-	if err != nil {
-		return false, err
+	exists, err := gm.BranchExistsLocal(branchName)
+	if err != nil || exists {
+		return exists, err
 	}
 
-	var found bool
-	err = refs.ForEach(func(ref *plumbing.Reference) error {
-		if ref.Name().IsBranch() {
-			if ref.Name().Short() == branchName {
-				found = true
-				return storer.ErrStop
-			}
-		}
-		// Also check remote branches
-		if ref.Name().IsRemote() {
-			remoteBranch := ref.Name().Short()
-			if strings.HasPrefix(remoteBranch, "origin/") {
-				localBranch := strings.TrimPrefix(remoteBranch, "origin/")
-				if localBranch == branchName {
-					found = true
-					return storer.ErrStop
-				}
-			}
-		}
-		return nil
-	})
-
-	if err != nil && err != storer.ErrStop {
-		return false, err
-	}
-
-	return found, nil
+	return gm.refExists("refs/remotes/" + gm.remoteBranch(branchName))
 }
 
 // BranchExistsLocal checks if a branch exists locally only (not remote)
 func (gm *GitManager) BranchExistsLocal(branchName string) (bool, error) {
-	refs, err := gm.repo.References()
-	if err != nil {
-		return false, err
-	}
+	return gm.refExists("refs/heads/" + branchName)
+}
 
-	var found bool
-	err = refs.ForEach(func(ref *plumbing.Reference) error {
-		if ref.Name().IsBranch() {
-			if ref.Name().Short() == branchName {
-				found = true
-				return storer.ErrStop
-			}
+// refExists looks up a fully qualified ref directly with show-ref rather than listing every ref,
+// which is slow in repositories with thousands of remote branches
+func (gm *GitManager) refExists(ref string) (bool, error) {
+	_, err := ExecGitCommand(gm.repoPath, "show-ref", "--verify", "--quiet", ref)
+	if err != nil {
+		// show-ref exits 1 when the ref doesn't exist - not an error
+		if exitError, ok := err.(*exec.ExitError); ok && exitError.ExitCode() == 1 {
+			return false, nil
 		}
-		return nil
-	})
-
-	if err != nil && err != storer.ErrStop {
-		return false, err
+		return false, enhanceGitError(err, "verify ref")
 	}
-
-	return found, nil
+	return true, nil
 }
 
 // Remote returns the remote branch name for a given branch (e.g., "main" -> "origin/main")
 func Remote(branchName string) string {
-	return fmt.Sprintf("origin/%s", branchName)
+	return RemoteFor(DefaultRemoteName, branchName)
+}
+
+// RemoteFor returns the remote branch name for a given remote and branch (e.g., "upstream", "main" -> "upstream/main")
+func RemoteFor(remoteName, branchName string) string {
+	return fmt.Sprintf("%s/%s", remoteName, branchName)
 }
 
 // VerifyRef verifies that a git reference (branch, tag, commit) exists and is valid.
@@ -417,6 +472,11 @@ func (gm *GitManager) buildGitLogArgs(options CommitHistoryOptions) []string {
 		args = append(args, fmt.Sprintf("-%d", options.Limit))
 	}
 
+	// Add skip
+	if options.Skip > 0 {
+		args = append(args, fmt.Sprintf("--skip=%d", options.Skip))
+	}
+
 	// Add range
 	if options.Range != "" {
 		args = append(args, options.Range)
@@ -440,6 +500,14 @@ func (gm *GitManager) buildGitLogArgs(options CommitHistoryOptions) []string {
 		args = append(args, "--grep="+options.GrepPattern)
 	}
 
+	if options.Author != "" {
+		args = append(args, "--author="+options.Author)
+	}
+
+	if options.NoMerges {
+		args = append(args, "--no-merges")
+	}
+
 	// Add format
 	format := options.CustomFormat
 	if format == "" {
@@ -514,6 +582,15 @@ func (gm *GitManager) GetFileChanges(path string, options FileChangeOptions) ([]
 
 	var allChanges []FileChange
 
+	// A range compares two commits, so the working tree and index are not involved
+	if options.Range != "" {
+		changes, err := gm.getFileChangesByType(path, false, options)
+		if err != nil {
+			return nil, enhanceGitError(err, "get file changes for "+options.Range)
+		}
+		return changes, nil
+	}
+
 	// Default to unstaged if neither is specified
 	if !options.Staged && !options.Unstaged {
 		options.Unstaged = true
@@ -569,6 +646,11 @@ func (gm *GitManager) buildGitDiffArgs(staged bool, options FileChangeOptions) [
 		args = append(args, "--numstat")
 	}
 
+	// Add range
+	if options.Range != "" {
+		args = append(args, options.Range)
+	}
+
 	// Add extra arguments
 	args = append(args, options.ExtraArgs...)
 
@@ -637,11 +719,21 @@ func (gm *GitManager) BranchExistsLocalOrRemote(branchName string) (bool, error)
 	// }
 
 	// Check if remote branch exists
-	remoteBranch := Remote(branchName)
+	remoteBranch := gm.remoteBranch(branchName)
 	_, err := ExecGitCommand(gm.repoPath, "rev-parse", "--verify", remoteBranch)
 	return err == nil, nil
 }
 
+// branchExists reports whether a branch exists on the default remote or, failing that, locally,
+// so branches created by sync --create-missing-branches count before they are pushed
+func (gm *GitManager) branchExists(branchName string) (bool, error) {
+	exists, err := gm.BranchExistsLocalOrRemote(branchName)
+	if err != nil || exists {
+		return exists, err
+	}
+	return gm.BranchExistsLocal(branchName)
+}
+
 func (gm *GitManager) IsBranchAvailable(branchName string) (bool, error) {
 	// First check if branch exists
 	exists, err := gm.BranchExists(branchName)
@@ -658,10 +750,8 @@ func (gm *GitManager) IsBranchAvailable(branchName string) (bool, error) {
 		return false, fmt.Errorf("failed to get worktrees: %w", err)
 	}
 
-	for _, wt := range worktrees {
-		if wt.Branch == branchName {
-			return false, fmt.Errorf("branch %s is already checked out in worktree %s", branchName, wt.Name)
-		}
+	if wt, exists := worktreesByBranch(worktrees)[branchName]; exists {
+		return false, fmt.Errorf("branch %s is already checked out in worktree %s", branchName, wt.Name)
 	}
 
 	return true, nil
@@ -677,6 +767,7 @@ func (gm *GitManager) GetWorktrees() ([]*WorktreeInfo, error) {
 	lines := strings.Split(string(output), "\n")
 
 	var currentWorktree *WorktreeInfo
+	var head string
 	for _, line := range lines {
 		line = strings.TrimSpace(line)
 		if line == "" {
@@ -693,10 +784,19 @@ func (gm *GitManager) GetWorktrees() ([]*WorktreeInfo, error) {
 				Name: filepath.Base(path),
 				Path: path,
 			}
-		} else if strings.HasPrefix(line, "branch ") && currentWorktree != nil {
+		} else if currentWorktree == nil {
+			continue
+		} else if strings.HasPrefix(line, "HEAD ") {
+			head = strings.TrimPrefix(line, "HEAD ")
+		} else if strings.HasPrefix(line, "branch ") {
 			branch := strings.TrimPrefix(line, "branch ")
 			branch = strings.TrimPrefix(branch, "refs/heads/")
 			currentWorktree.Branch = branch
+		} else if line == "detached" {
+			currentWorktree.Detached = true
+			currentWorktree.Branch = DetachedBranchLabel(head)
+		} else if line == "locked" || strings.HasPrefix(line, "locked ") {
+			currentWorktree.Locked = true
 		}
 	}
 
@@ -707,16 +807,67 @@ func (gm *GitManager) GetWorktrees() ([]*WorktreeInfo, error) {
 	return infos, nil
 }
 
+var (
+	ErrWorktreeNotRegistered    = errors.New("directory is not a registered git worktree")
+	ErrWorktreeDirectoryMissing = errors.New("worktree is registered but its directory is missing")
+)
+
+// WorktreeExists reports whether path is registered as a worktree in git's metadata and whether
+// it exists on disk. The two disagree when a worktree directory was created, moved or deleted
+// outside of git.
+func (gm *GitManager) WorktreeExists(path string) (registered bool, onDisk bool, err error) {
+	if _, statErr := os.Stat(path); statErr == nil {
+		onDisk = true
+	} else if !os.IsNotExist(statErr) {
+		return false, false, fmt.Errorf("failed to check worktree directory %s: %w", path, statErr)
+	}
+
+	worktrees, err := gm.GetWorktrees()
+	if err != nil {
+		return false, onDisk, err
+	}
+
+	target := resolvePath(path)
+	for _, wt := range worktrees {
+		if resolvePath(wt.Path) == target {
+			return true, onDisk, nil
+		}
+	}
+
+	return false, onDisk, nil
+}
+
+// resolvePath resolves symlinks in path (e.g. /var vs /private/var on macOS). When path itself is
+// missing, its parent directory is resolved instead.
+func resolvePath(path string) string {
+	path = filepath.Clean(path)
+	if resolved, err := filepath.EvalSymlinks(path); err == nil {
+		return resolved
+	}
+	if resolvedDir, err := filepath.EvalSymlinks(filepath.Dir(path)); err == nil {
+		return filepath.Join(resolvedDir, filepath.Base(path))
+	}
+	return path
+}
+
+// DetachedBranchLabel returns the branch label shown for a worktree with a detached HEAD at commit
+func DetachedBranchLabel(commit string) string {
+	if len(commit) > 7 {
+		commit = commit[:7]
+	}
+	return fmt.Sprintf("(detached@%s)", commit)
+}
+
 var ErrWorktreeDirectoryExists = fmt.Errorf("worktree directory already exists")
 
 func (gm *GitManager) CreateWorktree(envVar, branchName, worktreeDir string) error {
-	worktreePath := filepath.Join(gm.repoPath, worktreeDir, envVar)
+	worktreePath := filepath.Join(gm.worktreeRoot, worktreeDir, envVar)
 
 	if _, err := os.Stat(worktreePath); !os.IsNotExist(err) {
 		return fmt.Errorf("%w: %s", ErrWorktreeDirectoryExists, worktreePath)
 	}
 
-	branchExists, err := gm.BranchExistsLocalOrRemote(branchName)
+	branchExists, err := gm.branchExists(branchName)
 	if err != nil {
 		return fmt.Errorf("failed to check if branch exists: %w", err)
 	}
@@ -733,7 +884,7 @@ func (gm *GitManager) CreateWorktree(envVar, branchName, worktreeDir string) err
 	}
 
 	// Check if remote tracking branch exists
-	remoteBranch := Remote(branchName)
+	remoteBranch := gm.remoteBranch(branchName)
 	_, err = ExecGitCommand(gm.repoPath, "rev-parse", "--verify", remoteBranch)
 
 	if err == nil {
@@ -773,7 +924,7 @@ func (gm *GitManager) UpdateWorktree(worktreePath, newBranch string) error {
 
 	worktreeDir := filepath.Dir(worktreePath)
 	envVar := filepath.Base(worktreePath)
-	relativeWorktreeDir := strings.TrimPrefix(worktreeDir, gm.repoPath+string(filepath.Separator))
+	relativeWorktreeDir := strings.TrimPrefix(worktreeDir, gm.worktreeRoot+string(filepath.Separator))
 
 	return gm.CreateWorktree(envVar, newBranch, relativeWorktreeDir)
 }
@@ -790,12 +941,12 @@ func (gm *GitManager) PromoteWorktree(sourceWorktreePath, targetWorktreePath str
 	return nil
 }
 
-func (gm *GitManager) FetchAll() error {
-	cmd := exec.Command("git", "fetch", "--all")
-	cmd.Dir = gm.repoPath
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("failed to fetch from remote: %w", err)
+// FetchRemote fetches from a single named remote
+func (gm *GitManager) FetchRemote(remoteName string) error {
+	if output, err := ExecGitCommandCombined(gm.repoPath, "fetch", remoteName); err != nil {
+		return fmt.Errorf("failed to fetch from remote '%s': %s", remoteName, strings.TrimSpace(string(output)))
 	}
+	gm.clearRemoteBranches()
 	return nil
 }
 
@@ -804,27 +955,74 @@ func (gm *GitManager) GetWorktreeStatus(worktreePath string) (*GitStatus, error)
 		return nil, fmt.Errorf("worktree path does not exist: %s", worktreePath)
 	}
 
-	status := &GitStatus{}
-
-	// Get git status
-	output, err := ExecGitCommand(worktreePath, "status", "--porcelain", "--ahead-behind")
+	// Porcelain v2 includes the ahead/behind counts in its branch header, saving a separate rev-list
+	output, err := ExecGitCommand(worktreePath, "status", "--porcelain=v2", "--branch")
 	if err != nil {
 		return nil, fmt.Errorf("failed to get git status: %w", err)
 	}
 
-	statusLines := strings.SplitSeq(string(output), "\n")
-	for line := range statusLines {
-		line = strings.TrimSpace(line)
+	status, untracked := parseStatusPorcelainV2(string(output))
+
+	// git collapses an untracked directory into a single "dir/" entry, which undercounts its files
+	if slices.ContainsFunc(untracked, func(path string) bool { return strings.HasSuffix(path, "/") }) {
+		count, err := gm.CountUntrackedPrecisely(worktreePath)
+		if err != nil {
+			return nil, err
+		}
+		status.Untracked = count
+	}
+
+	return status, nil
+}
+
+// CountUntrackedPrecisely counts untracked, non-ignored files one by one, including every file
+// inside untracked directories
+func (gm *GitManager) CountUntrackedPrecisely(worktreePath string) (int, error) {
+	output, err := ExecGitCommand(worktreePath, "ls-files", "--others", "--exclude-standard", "-z")
+	if err != nil {
+		return 0, fmt.Errorf("failed to list untracked files: %w", err)
+	}
+
+	count := 0
+	for path := range strings.SplitSeq(string(output), "\x00") {
+		if path != "" {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// parseStatusPorcelainV2 parses `git status --porcelain=v2 --branch` output, returning the status and
+// the untracked paths. Ahead/behind stay 0 when the branch has no upstream, since git then omits the
+// "# branch.ab" header.
+func parseStatusPorcelainV2(output string) (*GitStatus, []string) {
+	status := &GitStatus{}
+	var untracked []string
+
+	for line := range strings.SplitSeq(output, "\n") {
+		line = strings.TrimRight(line, "\r")
 		if line == "" {
 			continue
 		}
 
-		status.IsDirty = true
-
-		// Parse git status output
-		if len(line) >= 2 {
-			indexStatus := line[0]
-			worktreeStatus := line[1]
+		// Every entry starts with a one-character type and a space; paths may contain spaces
+		kind, rest, _ := strings.Cut(line, " ")
+		switch kind {
+		case "#":
+			// Header line, e.g. "# branch.ab +1 -2"
+			if ab, ok := strings.CutPrefix(rest, "branch.ab "); ok {
+				_, _ = fmt.Sscanf(ab, "+%d -%d", &status.Ahead, &status.Behind)
+			}
+		case "1", "2":
+			// Changed entry: "<1|2> XY ...", where '.' marks an unmodified column
+			status.IsDirty = true
+			xy, _, _ := strings.Cut(rest, " ")
+			if len(xy) != 2 {
+				continue
+			}
+			indexStatus := xy[0]
+			worktreeStatus := xy[1]
 
 			switch indexStatus {
 			case 'A', 'M', 'D', 'R', 'C':
@@ -836,20 +1034,37 @@ func (gm *GitManager) GetWorktreeStatus(worktreePath string) (*GitStatus, error)
 				status.Modified++
 			}
 
-			if indexStatus == '?' && worktreeStatus == '?' {
-				status.Untracked++
+			if indexStatus == 'R' || worktreeStatus == 'R' {
+				status.Renamed++
+			}
+
+			if indexStatus == 'C' || worktreeStatus == 'C' {
+				status.Copied++
 			}
+		case "?":
+			status.IsDirty = true
+			status.Untracked++
+			untracked = append(untracked, unquotePorcelainPath(rest))
+		case "u":
+			// Unmerged entry: "u XY ...", where XY is one of DD, AU, UD, UA, DU, AA or UU
+			status.IsDirty = true
+			status.Unmerged++
 		}
 	}
 
-	// Get ahead/behind info
-	status.Ahead, status.Behind, err = gm.GetAheadBehindCount(worktreePath)
-	if err != nil {
-		// Maintain backward compatibility - use 0,0 if error occurs
-		status.Ahead, status.Behind = 0, 0
-	}
+	return status, untracked
+}
 
-	return status, nil
+// unquotePorcelainPath decodes a path git wrapped in C-style quotes because it contains special or
+// non-ASCII characters (e.g. "caf\303\251.txt"). Unquoted paths are returned as-is.
+func unquotePorcelainPath(path string) string {
+	if !strings.HasPrefix(path, `"`) {
+		return path
+	}
+	if unquoted, err := strconv.Unquote(path); err == nil {
+		return unquoted
+	}
+	return path
 }
 
 func (gm *GitManager) GetStatusIcon(gitStatus *GitStatus) string {
@@ -870,11 +1085,19 @@ func (gm *GitManager) GetStatusIcon(gitStatus *GitStatus) string {
 		icons = append(icons, iconManager.GitBehind())
 	}
 
+	// A conflicted merge or rebase needs attention before anything else in the worktree
+	if gitStatus.Unmerged > 0 {
+		icons = append(icons, iconManager.GitConflict())
+	}
+
 	// Check dirty status
 	if gitStatus.IsDirty {
 		if gitStatus.Staged > 0 {
 			icons = append(icons, "●")
 		}
+		if gitStatus.Renamed > 0 {
+			icons = append(icons, "»")
+		}
 		if gitStatus.Modified > 0 {
 			icons = append(icons, "✚")
 		}
@@ -909,14 +1132,26 @@ func (gm *GitManager) GetCurrentBranchInPath(path string) (string, error) {
 	return strings.TrimSpace(string(output)), nil
 }
 
+// GetDefaultBranch returns the default remote's HEAD branch, falling back to the first of main,
+// master and develop that exists. The answer is cached; when nothing matches, the current branch is
+// returned uncached. Use SetRemoteHead to populate a missing remote HEAD.
 func (gm *GitManager) GetDefaultBranch() (string, error) {
+	gm.defaultBranchMu.Lock()
+	defer gm.defaultBranchMu.Unlock()
+
+	if gm.defaultBranch != "" {
+		return gm.defaultBranch, nil
+	}
+
 	// Try to get the default branch from remote HEAD
-	output, err := ExecGitCommand(gm.repoPath, "symbolic-ref", "refs/remotes/origin/HEAD")
+	remoteHeadPrefix := "refs/remotes/" + gm.GetDefaultRemote() + "/"
+	output, err := ExecGitCommand(gm.repoPath, "symbolic-ref", remoteHeadPrefix+"HEAD")
 	if err == nil {
 		// Parse refs/remotes/origin/main -> main
 		defaultRef := strings.TrimSpace(string(output))
-		if strings.HasPrefix(defaultRef, "refs/remotes/origin/") {
-			return strings.TrimPrefix(defaultRef, "refs/remotes/origin/"), nil
+		if strings.HasPrefix(defaultRef, remoteHeadPrefix) {
+			gm.defaultBranch = strings.TrimPrefix(defaultRef, remoteHeadPrefix)
+			return gm.defaultBranch, nil
 		}
 	}
 
@@ -925,6 +1160,7 @@ func (gm *GitManager) GetDefaultBranch() (string, error) {
 	for _, branch := range commonDefaults {
 		exists, err := gm.BranchExists(branch)
 		if err == nil && exists {
+			gm.defaultBranch = branch
 			return branch, nil
 		}
 	}
@@ -933,13 +1169,40 @@ func (gm *GitManager) GetDefaultBranch() (string, error) {
 	return gm.GetCurrentBranch()
 }
 
+// SetRemoteHead asks the default remote for its HEAD branch and records it as refs/remotes/<remote>/HEAD,
+// so GetDefaultBranch no longer has to guess
+func (gm *GitManager) SetRemoteHead() error {
+	remote := gm.GetDefaultRemote()
+	if _, err := ExecGitCommand(gm.repoPath, "remote", "set-head", remote, "--auto"); err != nil {
+		return fmt.Errorf("failed to set %s/HEAD: %w", remote, enhanceGitError(err, "remote set-head"))
+	}
+
+	gm.clearDefaultBranch()
+	return nil
+}
+
+func (gm *GitManager) clearDefaultBranch() {
+	gm.defaultBranchMu.Lock()
+	gm.defaultBranch = ""
+	gm.defaultBranchMu.Unlock()
+}
+
+// GetRemoteBranches returns the branches of the default remote without the remote prefix.
+// The list is cached until the next fetch, so repeated lookups in one invocation run git once.
 func (gm *GitManager) GetRemoteBranches() ([]string, error) {
+	gm.remoteBranchesMu.Lock()
+	defer gm.remoteBranchesMu.Unlock()
+
+	if gm.remoteBranches != nil {
+		return slices.Clone(gm.remoteBranches), nil
+	}
+
 	output, err := ExecGitCommand(gm.repoPath, "branch", "-r")
 	if err != nil {
 		return nil, fmt.Errorf("failed to get remote branches: %w", err)
 	}
 
-	var branches []string
+	branches := []string{}
 	lines := strings.SplitSeq(string(output), "\n")
 	for line := range lines {
 		line = strings.TrimSpace(line)
@@ -947,14 +1210,22 @@ func (gm *GitManager) GetRemoteBranches() ([]string, error) {
 			continue
 		}
 
-		// Remove "origin/" prefix
-		if strings.HasPrefix(line, "origin/") {
-			branch := strings.TrimPrefix(line, "origin/")
+		// Remove the default remote prefix (e.g. "origin/")
+		remotePrefix := gm.GetDefaultRemote() + "/"
+		if strings.HasPrefix(line, remotePrefix) {
+			branch := strings.TrimPrefix(line, remotePrefix)
 			branches = append(branches, branch)
 		}
 	}
 
-	return branches, nil
+	gm.remoteBranches = branches
+	return slices.Clone(branches), nil
+}
+
+func (gm *GitManager) clearRemoteBranches() {
+	gm.remoteBranchesMu.Lock()
+	gm.remoteBranches = nil
+	gm.remoteBranchesMu.Unlock()
 }
 
 // GetUpstreamBranch returns the upstream branch name for a given worktree path.
@@ -985,6 +1256,34 @@ func (gm *GitManager) GetAheadBehindCount(worktreePath string) (int, int, error)
 		return 0, 0, enhanceGitError(err, "get ahead/behind count")
 	}
 
+	return parseAheadBehindCounts(output)
+}
+
+// GetAheadBehindAgainst returns the number of commits the worktree's HEAD has that ref does not
+// (ahead) and that ref has that HEAD does not (behind). Unlike GetAheadBehindCount it works without
+// an upstream, e.g. to compare a worktree with its base branch.
+func (gm *GitManager) GetAheadBehindAgainst(worktreePath, ref string) (int, int, error) {
+	output, err := ExecGitCommand(worktreePath, "rev-list", "--left-right", "--count", "HEAD..."+ref)
+	if err != nil {
+		return 0, 0, enhanceGitError(err, "compare HEAD with "+ref)
+	}
+
+	return parseAheadBehindCounts(output)
+}
+
+// GetAheadBehindRefs returns how many commits ref has that baseRef does not (ahead) and how many
+// commits baseRef has that ref does not (behind)
+func (gm *GitManager) GetAheadBehindRefs(ref, baseRef string) (int, int, error) {
+	output, err := ExecGitCommand(gm.repoPath, "rev-list", "--left-right", "--count", ref+"..."+baseRef)
+	if err != nil {
+		return 0, 0, enhanceGitError(err, "compare "+ref+" with "+baseRef)
+	}
+
+	return parseAheadBehindCounts(output)
+}
+
+// parseAheadBehindCounts parses the output of git rev-list --left-right --count
+func parseAheadBehindCounts(output []byte) (int, int, error) {
 	parts := strings.Fields(strings.TrimSpace(string(output)))
 	if len(parts) != 2 {
 		return 0, 0, fmt.Errorf("unexpected git rev-list output format: %s", string(output))
@@ -992,7 +1291,6 @@ func (gm *GitManager) GetAheadBehindCount(worktreePath string) (int, int, error)
 
 	ahead, err1 := strconv.Atoi(parts[0])
 	behind, err2 := strconv.Atoi(parts[1])
-
 	if err1 != nil || err2 != nil {
 		return 0, 0, fmt.Errorf("failed to parse ahead/behind counts: ahead=%s, behind=%s", parts[0], parts[1])
 	}
@@ -1000,7 +1298,87 @@ func (gm *GitManager) GetAheadBehindCount(worktreePath string) (int, int, error)
 	return ahead, behind, nil
 }
 
+// IsAncestor reports whether maybeAncestor is reachable from ref, meaning ref already contains
+// every commit of maybeAncestor
+func (gm *GitManager) IsAncestor(maybeAncestor, ref string) (bool, error) {
+	return gm.IsAncestorInPath(gm.repoPath, maybeAncestor, ref)
+}
+
+// IsAncestorInPath reports whether maybeAncestor is reachable from ref, resolving both in the given worktree path
+func (gm *GitManager) IsAncestorInPath(path, maybeAncestor, ref string) (bool, error) {
+	_, err := ExecGitCommand(path, "merge-base", "--is-ancestor", maybeAncestor, ref)
+	if err != nil {
+		// Exit code 1 means "not an ancestor" - not an error condition
+		if exitError, ok := err.(*exec.ExitError); ok && exitError.ExitCode() == 1 {
+			return false, nil
+		}
+		return false, enhanceGitError(err, "merge-base --is-ancestor")
+	}
+	return true, nil
+}
+
+// GetMergeBase returns the best common ancestor of two refs in the repository.
+// Returns an empty hash and no error when the refs share no history.
+func (gm *GitManager) GetMergeBase(ref1, ref2 string) (string, error) {
+	return gm.GetMergeBaseInPath(gm.repoPath, ref1, ref2)
+}
+
+// GetMergeBaseInPath returns the best common ancestor of two refs, resolved in the given worktree path.
+// Returns an empty hash and no error when the refs share no history.
+func (gm *GitManager) GetMergeBaseInPath(path, ref1, ref2 string) (string, error) {
+	output, err := ExecGitCommand(path, "merge-base", ref1, ref2)
+	if err != nil {
+		// Exit code 1 with no output means the refs have no common ancestor
+		if exitError, ok := err.(*exec.ExitError); ok && exitError.ExitCode() == 1 && len(exitError.Stderr) == 0 {
+			return "", nil
+		}
+		return "", enhanceGitError(err, "merge-base")
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
+
+// GetMergeBaseWithTime returns the best common ancestor of two refs and its commit time.
+// Returns an empty hash and no error when the refs share no history.
+func (gm *GitManager) GetMergeBaseWithTime(path, ref1, ref2 string) (string, time.Time, error) {
+	mergeBase, err := gm.GetMergeBaseInPath(path, ref1, ref2)
+	if err != nil || mergeBase == "" {
+		return "", time.Time{}, err
+	}
+
+	output, err := ExecGitCommand(path, "show", "-s", "--format=%ct", mergeBase)
+	if err != nil {
+		return "", time.Time{}, enhanceGitError(err, "get merge-base commit date")
+	}
+
+	timestamp, err := strconv.ParseInt(strings.TrimSpace(string(output)), 10, 64)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("failed to parse commit timestamp: %w", err)
+	}
+
+	return mergeBase, time.Unix(timestamp, 0), nil
+}
+
+// PushOptions controls how PushWorktreeWithOptions pushes a worktree's branch.
+// A plain --force is deliberately not offered so shared branches can't be overwritten blindly.
+type PushOptions struct {
+	// ForceWithLease overwrites the remote branch only if it still matches the last fetched state
+	ForceWithLease bool
+	// Tags also pushes all local tags to the default remote
+	Tags bool
+	// DryRun reports what would be pushed without updating the remote
+	DryRun bool
+
+	// noPrompt makes git fail instead of prompting for credentials, for pushes run concurrently
+	noPrompt bool
+}
+
 func (gm *GitManager) PushWorktree(worktreePath string) error {
+	return gm.PushWorktreeWithOptions(worktreePath, PushOptions{})
+}
+
+// PushWorktreeWithOptions pushes the worktree's current branch, setting upstream (-u) when it isn't set yet
+func (gm *GitManager) PushWorktreeWithOptions(worktreePath string, opts PushOptions) error {
 	if _, err := os.Stat(worktreePath); os.IsNotExist(err) {
 		return fmt.Errorf("worktree path does not exist: %s", worktreePath)
 	}
@@ -1017,18 +1395,53 @@ func (gm *GitManager) PushWorktree(worktreePath string) error {
 		return fmt.Errorf("failed to check upstream branch: %w", err)
 	}
 
-	var cmd *exec.Cmd
+	var flags []string
+	if opts.ForceWithLease {
+		flags = append(flags, "--force-with-lease")
+	}
+	if opts.DryRun {
+		flags = append(flags, "--dry-run")
+	}
+
+	args := append([]string{"push"}, flags...)
 	if upstream == "" {
 		// No upstream set, push with -u flag
-		cmd = exec.Command("git", "push", "-u", "origin", currentBranch)
-	} else {
-		// Upstream is set, simple push
-		cmd = exec.Command("git", "push")
+		args = append(args, "-u", gm.GetDefaultRemote(), currentBranch)
+		gm.upstreamMu.Lock()
+	}
+
+	err = runPushCommand(worktreePath, args, opts.noPrompt)
+	if upstream == "" {
+		gm.upstreamMu.Unlock()
+	}
+	if err != nil {
+		return err
 	}
+	gm.clearRemoteBranches()
 
+	if opts.Tags {
+		// Tags are pushed separately: `git push --tags` without a refspec would skip the branch
+		tagArgs := []string{"push"}
+		if opts.DryRun {
+			tagArgs = append(tagArgs, "--dry-run")
+		}
+		tagArgs = append(tagArgs, gm.GetDefaultRemote(), "--tags")
+		if err := runPushCommand(worktreePath, tagArgs, opts.noPrompt); err != nil {
+			return fmt.Errorf("failed to push tags: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func runPushCommand(worktreePath string, args []string, noPrompt bool) error {
+	cmd := exec.Command("git", args...)
 	cmd.Dir = worktreePath
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
+	if noPrompt {
+		cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	}
 
 	return cmd.Run()
 }
@@ -1052,7 +1465,7 @@ func (gm *GitManager) PullWorktree(worktreePath string) error {
 	}
 	if upstream == "" {
 		// No upstream set, try to set it and pull
-		remoteBranch := Remote(currentBranch)
+		remoteBranch := gm.remoteBranch(currentBranch)
 
 		// Check if remote branch exists
 		_, err = ExecGitCommand(worktreePath, "rev-parse", "--verify", remoteBranch)
@@ -1064,44 +1477,59 @@ func (gm *GitManager) PullWorktree(worktreePath string) error {
 			}
 		} else {
 			// Remote branch doesn't exist, try to pull with explicit remote and branch
-			finalArgs = append(finalArgs, "origin", currentBranch)
+			finalArgs = append(finalArgs, gm.GetDefaultRemote(), currentBranch)
 		}
 	}
 
 	return ExecGitCommandInteractive(worktreePath, finalArgs...)
 }
 
-func (gm *GitManager) IsInWorktree(currentPath string) (bool, string, error) {
-	// Check if we're in a worktree
-	cmd := exec.Command("git", "rev-parse", "--show-toplevel")
-	cmd.Dir = currentPath
-	output, err := execCommand(cmd)
-	if err != nil {
-		return false, "", fmt.Errorf("not in a git repository: %w", err)
+// MergeUpstream fast-forwards a worktree to its upstream branch as of the last fetch, setting the
+// upstream first when the remote branch exists. It doesn't fetch, so PullAllWorktreesWithResults
+// can fetch once and then run it in several worktrees at a time.
+func (gm *GitManager) MergeUpstream(worktreePath string) error {
+	if _, err := os.Stat(worktreePath); os.IsNotExist(err) {
+		return fmt.Errorf("worktree path does not exist: %s", worktreePath)
 	}
 
-	worktreePath := strings.TrimSpace(string(output))
-
-	// Check if this is a worktree by checking if it's under the worktrees directory
-	worktreePrefix := filepath.Join(gm.repoPath, "worktrees")
-
-	// Resolve symlinks to handle cases like /var vs /private/var on macOS
-	resolvedWorktreePath, err := filepath.EvalSymlinks(worktreePath)
+	currentBranch, err := gm.GetCurrentBranchInPath(worktreePath)
 	if err != nil {
-		resolvedWorktreePath = worktreePath // fallback to original if resolution fails
+		return err
 	}
 
-	resolvedWorktreePrefix, err := filepath.EvalSymlinks(worktreePrefix)
+	upstream, err := gm.GetUpstreamBranch(worktreePath)
 	if err != nil {
-		resolvedWorktreePrefix = worktreePrefix // fallback to original if resolution fails
+		return fmt.Errorf("failed to check upstream branch: %w", err)
+	}
+	if upstream == "" {
+		remoteBranch := gm.remoteBranch(currentBranch)
+		if _, err := ExecGitCommand(worktreePath, "rev-parse", "--verify", remoteBranch); err != nil {
+			return fmt.Errorf("no upstream set and remote branch '%s' does not exist", remoteBranch)
+		}
+
+		gm.upstreamMu.Lock()
+		output, err := ExecGitCommandCombined(worktreePath, "branch", "--set-upstream-to", remoteBranch)
+		gm.upstreamMu.Unlock()
+		if err != nil {
+			return fmt.Errorf("failed to set upstream: %s", strings.TrimSpace(string(output)))
+		}
+	}
+
+	if output, err := ExecGitCommandCombined(worktreePath, "merge", "--ff-only", "@{upstream}"); err != nil {
+		return fmt.Errorf("failed to fast-forward '%s': %s", currentBranch, strings.TrimSpace(string(output)))
 	}
 
-	if strings.HasPrefix(resolvedWorktreePath, resolvedWorktreePrefix) {
-		worktreeName := filepath.Base(worktreePath)
-		return true, worktreeName, nil
+	return nil
+}
+
+// GetToplevel returns the root of the worktree containing currentPath
+func (gm *GitManager) GetToplevel(currentPath string) (string, error) {
+	output, err := ExecGitCommand(currentPath, "rev-parse", "--show-toplevel")
+	if err != nil {
+		return "", fmt.Errorf("not in a git repository: %w", err)
 	}
 
-	return false, "", nil
+	return strings.TrimSpace(string(output)), nil
 }
 
 // WorktreeInfoData represents comprehensive information about a worktree
@@ -1114,6 +1542,7 @@ type WorktreeInfoData struct {
 	BaseInfo      *BranchInfo
 	Commits       []CommitInfo
 	ModifiedFiles []FileChange
+	Stashes       []StashEntry
 	JiraTicket    *JiraTicketDetails
 }
 
@@ -1142,6 +1571,9 @@ type CommitHistoryOptions struct {
 	// Limit number of commits (equivalent to -N flag)
 	Limit int
 
+	// Skip this many commits before returning any (equivalent to --skip=N); with Limit, pages through history
+	Skip int
+
 	// Range specification (e.g., "origin/main..origin/feature", "HEAD~5..HEAD")
 	Range string
 
@@ -1152,6 +1584,8 @@ type CommitHistoryOptions struct {
 	MergesOnly  bool   // --merges
 	AllBranches bool   // --all
 	GrepPattern string // --grep=pattern
+	Author      string // --author=pattern (matches name or email)
+	NoMerges    bool   // --no-merges
 
 	// Format specification - if empty, uses default: %H|%s|%an|%ae|%ct|%D
 	CustomFormat string
@@ -1165,6 +1599,10 @@ type FileChangeOptions struct {
 	// Include unstaged changes (default: true if neither Staged nor Unstaged specified)
 	Unstaged bool
 
+	// Range compares commits instead of the working tree (e.g., "main..feature", "main...feature").
+	// When set, Staged and Unstaged are ignored.
+	Range string
+
 	// Show only names (--name-only)
 	NamesOnly bool
 
@@ -1219,6 +1657,28 @@ type RecentActivity struct {
 	JiraTicket    string // Extracted JIRA ticket if found
 }
 
+// Activity types reported by GetRecentMergeableActivityWithOptions
+const (
+	ActivityTypeHotfix  = "hotfix"
+	ActivityTypeMerge   = "merge"
+	ActivityTypeFeature = "feature"
+)
+
+// ActivityOptions scopes the history searched by GetRecentMergeableActivityWithOptions
+type ActivityOptions struct {
+	// Since is a git date ("2.weeks.ago", "2025-07-01") or a ref such as a release tag, in which
+	// case only commits after it are considered. Defaults to 7 days ago.
+	Since string
+	// UntilRef limits the search to history reachable from this ref. By default merges are
+	// searched from HEAD and hotfix/feature activity across all refs.
+	UntilRef string
+	// Types restricts results to hotfix, merge and/or feature activity. Defaults to hotfix and merge.
+	Types []string
+	// JiraProjects restricts the JIRA tickets picked up from branches and messages to these
+	// project keys. Any key is accepted when empty.
+	JiraProjects []string
+}
+
 // GetRecentMergeableActivity analyzes recent git history to find hotfixes or merges
 // that might necessitate a mergeback operation
 func (gm *GitManager) GetRecentMergeableActivity(maxDays int) ([]RecentActivity, error) {
@@ -1226,35 +1686,90 @@ func (gm *GitManager) GetRecentMergeableActivity(maxDays int) ([]RecentActivity,
 		maxDays = 7 // Default to last 7 days
 	}
 
-	var activities []RecentActivity
+	return gm.GetRecentMergeableActivityWithOptions(ActivityOptions{Since: fmt.Sprintf("%d.days.ago", maxDays)})
+}
+
+// GetRecentMergeableActivityWithOptions is GetRecentMergeableActivity with a configurable time
+// window, end ref and activity types
+func (gm *GitManager) GetRecentMergeableActivityWithOptions(opts ActivityOptions) ([]RecentActivity, error) {
+	types := opts.Types
+	if len(types) == 0 {
+		types = []string{ActivityTypeMerge, ActivityTypeHotfix}
+	}
+	for _, activityType := range types {
+		switch activityType {
+		case ActivityTypeHotfix, ActivityTypeMerge, ActivityTypeFeature:
+		default:
+			return nil, fmt.Errorf("unknown activity type %q (valid types: %s, %s, %s)", activityType, ActivityTypeHotfix, ActivityTypeMerge, ActivityTypeFeature)
+		}
+	}
+
+	if opts.UntilRef != "" {
+		if exists, err := gm.VerifyRef(opts.UntilRef); err != nil || !exists {
+			return nil, fmt.Errorf("ref '%s' does not exist", opts.UntilRef)
+		}
+	}
 
-	// Get recent commits that might indicate hotfix/merge activity
-	since := fmt.Sprintf("--since=%d.days.ago", maxDays)
+	var activities []RecentActivity
 
 	// Look for merge commits first
-	mergeCommits, err := gm.getRecentMergeCommits(since)
-	if err == nil {
-		activities = append(activities, mergeCommits...)
+	if slices.Contains(types, ActivityTypeMerge) {
+		mergeCommits, err := gm.getRecentMergeCommits(gm.activityRevisions(opts, false), opts.JiraProjects)
+		if err == nil {
+			activities = append(activities, mergeCommits...)
+		}
 	}
 
 	// Look for hotfix branches that were recently created or merged
-	hotfixCommits, err := gm.getRecentHotfixActivity(since)
-	if err == nil {
-		activities = append(activities, hotfixCommits...)
+	if slices.Contains(types, ActivityTypeHotfix) {
+		hotfixCommits, err := gm.getRecentHotfixActivity(gm.activityRevisions(opts, true), opts.JiraProjects)
+		if err == nil {
+			activities = append(activities, hotfixCommits...)
+		}
 	}
 
-	// Note: Removed feature branch detection per user request
-	// Only consider hotfix and merge commits for auto-detection
+	if slices.Contains(types, ActivityTypeFeature) {
+		featureCommits, err := gm.getRecentFeatureActivity(gm.activityRevisions(opts, true), opts.JiraProjects)
+		if err == nil {
+			activities = append(activities, featureCommits...)
+		}
+	}
 
 	return activities, nil
 }
 
+// activityRevisions builds the git log revision and date arguments for an activity search
+func (gm *GitManager) activityRevisions(opts ActivityOptions, allRefs bool) []string {
+	var args []string
+	switch {
+	case opts.UntilRef != "":
+		args = append(args, opts.UntilRef)
+	case allRefs:
+		args = append(args, "--all")
+	default:
+		args = append(args, "HEAD")
+	}
+
+	since := opts.Since
+	if since == "" {
+		since = "7.days.ago"
+	}
+
+	// A ref (e.g. the last release tag) bounds the history rather than a date
+	if exists, err := gm.VerifyRef(since + "^{commit}"); err == nil && exists {
+		return append(args, "^"+since)
+	}
+
+	return append(args, "--since="+since)
+}
+
 // getRecentMergeCommits finds recent merge commits
-func (gm *GitManager) getRecentMergeCommits(since string) ([]RecentActivity, error) {
+func (gm *GitManager) getRecentMergeCommits(revisions []string, jiraProjects []string) ([]RecentActivity, error) {
 	var activities []RecentActivity
 
 	// Get merge commits with format: hash|author|date|message
-	output, err := ExecGitCommand(gm.repoPath, "log", "--merges", since, "--pretty=format:%H|%an|%at|%s")
+	args := append([]string{"log", "--merges", "--pretty=format:%H|%an|%at|%s"}, revisions...)
+	output, err := ExecGitCommand(gm.repoPath, args...)
 	if err != nil {
 		return activities, err
 	}
@@ -1286,7 +1801,7 @@ func (gm *GitManager) getRecentMergeCommits(since string) ([]RecentActivity, err
 			CommitMessage: message,
 			Author:        author,
 			Timestamp:     timestamp,
-			JiraTicket:    ExtractJiraTicket(message),
+			JiraTicket:    ExtractJiraTicketForProjects(message, jiraProjects),
 		}
 
 		// Try to extract source and target branches from merge commit
@@ -1298,7 +1813,7 @@ func (gm *GitManager) getRecentMergeCommits(since string) ([]RecentActivity, err
 		if activity.JiraTicket != "" {
 			activity.WorktreeName = activity.JiraTicket
 		} else if sourceBranch != "" {
-			activity.WorktreeName = ExtractWorktreeNameFromBranch(sourceBranch)
+			activity.WorktreeName = extractWorktreeNameFromBranch(sourceBranch, jiraProjects)
 		}
 
 		activities = append(activities, activity)
@@ -1308,11 +1823,12 @@ func (gm *GitManager) getRecentMergeCommits(since string) ([]RecentActivity, err
 }
 
 // getRecentHotfixActivity finds recent hotfix branch activity
-func (gm *GitManager) getRecentHotfixActivity(since string) ([]RecentActivity, error) {
+func (gm *GitManager) getRecentHotfixActivity(revisions []string, jiraProjects []string) ([]RecentActivity, error) {
 	var activities []RecentActivity
 
 	// Get commits on hotfix branches
-	output, err := ExecGitCommand(gm.repoPath, "log", "--all", since, "--pretty=format:%H|%an|%at|%s|%D", "--grep=hotfix")
+	args := append([]string{"log", "--pretty=format:%H|%an|%at|%s|%D", "--grep=hotfix"}, revisions...)
+	output, err := ExecGitCommand(gm.repoPath, args...)
 	if err != nil {
 		return activities, err
 	}
@@ -1353,7 +1869,7 @@ func (gm *GitManager) getRecentHotfixActivity(since string) ([]RecentActivity, e
 			CommitMessage: message,
 			Author:        author,
 			Timestamp:     timestamp,
-			JiraTicket:    ExtractJiraTicket(message),
+			JiraTicket:    ExtractJiraTicketForProjects(message, jiraProjects),
 		}
 
 		// Extract branch name from refs
@@ -1361,7 +1877,7 @@ func (gm *GitManager) getRecentHotfixActivity(since string) ([]RecentActivity, e
 			for ref := range strings.SplitSeq(refs, ", ") {
 				if strings.Contains(ref, "hotfix/") {
 					activity.BranchName = extractBranchFromRef(ref)
-					activity.WorktreeName = ExtractWorktreeNameFromBranch(activity.BranchName)
+					activity.WorktreeName = extractWorktreeNameFromBranch(activity.BranchName, jiraProjects)
 					break
 				}
 			}
@@ -1372,7 +1888,7 @@ func (gm *GitManager) getRecentHotfixActivity(since string) ([]RecentActivity, e
 			if activity.JiraTicket != "" {
 				activity.WorktreeName = activity.JiraTicket
 			} else {
-				activity.WorktreeName = ExtractWorktreeNameFromMessage(message)
+				activity.WorktreeName = extractWorktreeNameFromMessage(message, jiraProjects)
 			}
 		}
 
@@ -1382,6 +1898,47 @@ func (gm *GitManager) getRecentHotfixActivity(since string) ([]RecentActivity, e
 	return activities, nil
 }
 
+// getRecentFeatureActivity finds feature branches whose tip commit is recent
+func (gm *GitManager) getRecentFeatureActivity(revisions []string, jiraProjects []string) ([]RecentActivity, error) {
+	var activities []RecentActivity
+
+	// Only decorate with feature branches so that %D lists just those and the log is limited to their tips
+	args := append([]string{"log", "--simplify-by-decoration",
+		"--decorate-refs=refs/heads/feature/", "--decorate-refs=refs/remotes/*/feature/*",
+		"--pretty=format:%H|%an|%at|%s|%D"}, revisions...)
+	output, err := ExecGitCommand(gm.repoPath, args...)
+	if err != nil {
+		return activities, err
+	}
+
+	for line := range strings.SplitSeq(strings.TrimSpace(string(output)), "\n") {
+		parts := strings.SplitN(line, "|", 5)
+		if len(parts) != 5 || parts[4] == "" {
+			continue
+		}
+
+		timestamp, err := parseTimestamp(parts[2])
+		if err != nil {
+			continue
+		}
+
+		ref, _, _ := strings.Cut(parts[4], ", ")
+		branchName := extractBranchFromRef(strings.TrimPrefix(ref, "HEAD -> "))
+		activities = append(activities, RecentActivity{
+			Type:          ActivityTypeFeature,
+			WorktreeName:  extractWorktreeNameFromBranch(branchName, jiraProjects),
+			BranchName:    branchName,
+			CommitHash:    parts[0],
+			CommitMessage: parts[3],
+			Author:        parts[1],
+			Timestamp:     timestamp,
+			JiraTicket:    ExtractJiraTicketForProjects(branchName+" "+parts[3], jiraProjects),
+		})
+	}
+
+	return activities, nil
+}
+
 // Helper functions for parsing git data
 func parseTimestamp(timestampStr string) (time.Time, error) {
 	// Parse as Unix timestamp
@@ -1392,15 +1949,35 @@ func parseTimestamp(timestampStr string) (time.Time, error) {
 	return time.Unix(unixTime, 0), nil
 }
 
+// jiraTicketPattern matches common JIRA keys: PROJECT-123, ABC-456, etc.
+var jiraTicketPattern = regexp.MustCompile(`[A-Z]{2,}-\d+`)
+
 func ExtractJiraTicket(message string) string {
-	// Common JIRA patterns: PROJECT-123, ABC-456, etc.
-	jiraPattern := `[A-Z]{2,}-\d+`
-	re := regexp.MustCompile(jiraPattern)
-	match := re.FindString(message)
-	return match
+	return ExtractJiraTicketForProjects(message, nil)
+}
+
+// ExtractJiraTicketForProjects returns the first JIRA ticket in message whose project key is one
+// of projects, skipping look-alikes such as UTF-8 or HTTP-2. Any key is accepted when projects is empty.
+func ExtractJiraTicketForProjects(message string, projects []string) string {
+	for _, match := range jiraTicketPattern.FindAllString(message, -1) {
+		if len(projects) == 0 {
+			return match
+		}
+
+		key, _, _ := strings.Cut(match, "-")
+		if slices.ContainsFunc(projects, func(project string) bool { return strings.EqualFold(project, key) }) {
+			return match
+		}
+	}
+
+	return ""
 }
 
 func ExtractWorktreeNameFromBranch(branchName string) string {
+	return extractWorktreeNameFromBranch(branchName, nil)
+}
+
+func extractWorktreeNameFromBranch(branchName string, jiraProjects []string) string {
 	// Extract meaningful part from branch names like:
 	// hotfix/PROJECT-123_fix_auth -> PROJECT-123
 	// feature/PROJECT-456_new_api -> PROJECT-456
@@ -1420,7 +1997,7 @@ func ExtractWorktreeNameFromBranch(branchName string) string {
 	}
 
 	// If it looks like a JIRA ticket, extract just that
-	if jira := ExtractJiraTicket(branchName); jira != "" {
+	if jira := ExtractJiraTicketForProjects(branchName, jiraProjects); jira != "" {
 		return jira
 	}
 
@@ -1429,8 +2006,12 @@ func ExtractWorktreeNameFromBranch(branchName string) string {
 }
 
 func ExtractWorktreeNameFromMessage(message string) string {
+	return extractWorktreeNameFromMessage(message, nil)
+}
+
+func extractWorktreeNameFromMessage(message string, jiraProjects []string) string {
 	// Try to extract JIRA ticket first
-	if jira := ExtractJiraTicket(message); jira != "" {
+	if jira := ExtractJiraTicketForProjects(message, jiraProjects); jira != "" {
 		return jira
 	}
 
@@ -1479,14 +2060,37 @@ func (gm *GitManager) extractMergeBranches(commitHash string) (string, string) {
 	}
 
 	// For merge commits, try to extract from commit message
-	message := parts[1]
-
-	// Look for patterns like "Merge branch 'feature/xyz' into main"
-	mergePattern := `Merge branch '([^']+)' into (.+)`
-	re := regexp.MustCompile(mergePattern)
-	matches := re.FindStringSubmatch(message)
-	if len(matches) >= 3 {
-		return matches[1], matches[2] // source, target
+	return parseMergeMessage(parts[1])
+}
+
+// mergeMessagePatterns recognise the merge commit subjects written by git and common hosting
+// services, most specific first. Each has a "source" group and optionally a "target" group.
+var mergeMessagePatterns = []*regexp.Regexp{
+	// GitLab: Merge branch 'feature/x' into 'main'
+	regexp.MustCompile(`^Merge branch '(?P<source>[^']+)' into '(?P<target>[^']+)'`),
+	// GitHub: Merge pull request #123 from org/feature/x (the target isn't part of the message)
+	regexp.MustCompile(`^Merge pull request #\d+ from [^/\s]+/(?P<source>\S+)`),
+	// git: Merge branch 'feature/x' into main
+	regexp.MustCompile(`^Merge branch '(?P<source>[^']+)' into (?P<target>.+)`),
+}
+
+// parseMergeMessage extracts the source and target branches from a merge commit subject.
+// Either is empty when the message doesn't name it.
+func parseMergeMessage(message string) (string, string) {
+	for _, re := range mergeMessagePatterns {
+		matches := re.FindStringSubmatch(message)
+		if matches == nil {
+			continue
+		}
+
+		var source, target string
+		if i := re.SubexpIndex("source"); i >= 0 {
+			source = matches[i]
+		}
+		if i := re.SubexpIndex("target"); i >= 0 {
+			target = matches[i]
+		}
+		return source, target
 	}
 
 	return "", ""
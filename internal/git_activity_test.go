@@ -1,11 +1,13 @@
 package internal
 
 import (
-	"regexp"
 	"testing"
 	"time"
 
+	"gbm/internal/testutils"
+
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestParseTimestamp(t *testing.T) {
@@ -108,6 +110,45 @@ func TestExtractJiraTicket(t *testing.T) {
 	}
 }
 
+func TestExtractJiraTicketForProjects(t *testing.T) {
+	tests := []struct {
+		name     string
+		message  string
+		projects []string
+		expected string
+	}{
+		{
+			name:     "no projects accepts any key",
+			message:  "Handle UTF-8 input for SHOP-456",
+			expected: "UTF-8",
+		},
+		{
+			name:     "skips keys outside the project list",
+			message:  "Handle UTF-8 input for SHOP-456",
+			projects: []string{"SHOP"},
+			expected: "SHOP-456",
+		},
+		{
+			name:     "project keys are case-insensitive",
+			message:  "HTTP-2 support (auth-1) AUTH-789",
+			projects: []string{"auth"},
+			expected: "AUTH-789",
+		},
+		{
+			name:     "no matching project",
+			message:  "Upgrade to HTTP-2",
+			projects: []string{"SHOP", "AUTH"},
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, ExtractJiraTicketForProjects(tt.message, tt.projects))
+		})
+	}
+}
+
 func TestExtractWorktreeNameFromBranch(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -291,11 +332,7 @@ func TestExtractBranchFromRef(t *testing.T) {
 	}
 }
 
-func TestGitMergePatternRegex(t *testing.T) {
-	// Test the regex pattern used in extractMergeBranches
-	mergePattern := `Merge branch '([^']+)' into (.+)`
-	re := regexp.MustCompile(mergePattern)
-
+func TestParseMergeMessage(t *testing.T) {
 	tests := []struct {
 		name         string
 		message      string
@@ -324,6 +361,25 @@ func TestGitMergePatternRegex(t *testing.T) {
 			sourceBranch: "feature/PROJ-123_implement_auth",
 			targetBranch: "develop",
 		},
+		{
+			name:         "GitLab merge message with quoted target",
+			message:      "Merge branch 'feature/x' into 'main'",
+			expectMatch:  true,
+			sourceBranch: "feature/x",
+			targetBranch: "main",
+		},
+		{
+			name:         "GitHub pull request merge message",
+			message:      "Merge pull request #123 from org/feature/x",
+			expectMatch:  true,
+			sourceBranch: "feature/x",
+			targetBranch: "",
+		},
+		{
+			name:        "GitHub squash merge has no branch",
+			message:     "Add login flow (#124)",
+			expectMatch: false,
+		},
 		{
 			name:        "non-merge message",
 			message:     "feat: Add new user interface",
@@ -343,16 +399,14 @@ func TestGitMergePatternRegex(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			matches := re.FindStringSubmatch(tt.message)
+			source, target := parseMergeMessage(tt.message)
 
 			if tt.expectMatch {
-				assert.True(t, len(matches) >= 3, "Expected match but got none")
-				if len(matches) >= 3 {
-					assert.Equal(t, tt.sourceBranch, matches[1])
-					assert.Equal(t, tt.targetBranch, matches[2])
-				}
+				assert.Equal(t, tt.sourceBranch, source)
+				assert.Equal(t, tt.targetBranch, target)
 			} else {
-				assert.True(t, len(matches) == 0, "Expected no match but got one")
+				assert.Empty(t, source, "Expected no match but got one")
+				assert.Empty(t, target, "Expected no match but got one")
 			}
 		})
 	}
@@ -404,3 +458,58 @@ func TestMockRecentActivity(t *testing.T) {
 	// Test sorting by timestamp (most recent first)
 	assert.True(t, hotfixAndMerge[0].Timestamp.After(hotfixAndMerge[1].Timestamp))
 }
+
+func TestGitManager_GetRecentMergeableActivityWithOptions(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+	defer repo.Cleanup()
+
+	localPath := repo.GetLocalPath()
+	must(t, execGitCommandRun(localPath, "tag", "v1.0.0"))
+	must(t, repo.CreateBranch("hotfix/ABC-1", "hotfix content"))
+	must(t, execGitCommandRun(localPath, "merge", "--no-ff", "-m", "Merge branch 'hotfix/ABC-1'", "hotfix/ABC-1"))
+	must(t, repo.CreateBranch("feature/XYZ-2", "feature content"))
+	must(t, execGitCommandRun(localPath, "tag", "v1.1.0"))
+
+	gitManager, err := NewGitManager(localPath, "worktrees")
+	require.NoError(t, err)
+
+	activityTypes := func(activities []RecentActivity) []string {
+		var types []string
+		for _, activity := range activities {
+			types = append(types, activity.Type)
+		}
+		return types
+	}
+
+	// Defaults to merge and hotfix activity from the last week
+	activities, err := gitManager.GetRecentMergeableActivityWithOptions(ActivityOptions{})
+	require.NoError(t, err)
+	assert.Subset(t, activityTypes(activities), []string{ActivityTypeMerge, ActivityTypeHotfix})
+	assert.NotContains(t, activityTypes(activities), ActivityTypeFeature)
+
+	// Feature activity is opt-in and reports the branch tip
+	activities, err = gitManager.GetRecentMergeableActivityWithOptions(ActivityOptions{Types: []string{ActivityTypeFeature}})
+	require.NoError(t, err)
+	require.Len(t, activities, 1)
+	assert.Equal(t, "feature/XYZ-2", activities[0].BranchName)
+	assert.Equal(t, "XYZ-2", activities[0].WorktreeName)
+
+	// A tag as Since only considers commits after it
+	activities, err = gitManager.GetRecentMergeableActivityWithOptions(ActivityOptions{Since: "v1.0.0", UntilRef: "main"})
+	require.NoError(t, err)
+	assert.Subset(t, activityTypes(activities), []string{ActivityTypeMerge, ActivityTypeHotfix})
+	assert.NotContains(t, activityTypes(activities), ActivityTypeFeature)
+
+	activities, err = gitManager.GetRecentMergeableActivityWithOptions(ActivityOptions{Since: "v1.1.0", UntilRef: "main"})
+	require.NoError(t, err)
+	assert.Empty(t, activities)
+
+	_, err = gitManager.GetRecentMergeableActivityWithOptions(ActivityOptions{Types: []string{"release"}})
+	assert.ErrorContains(t, err, `unknown activity type "release"`)
+
+	_, err = gitManager.GetRecentMergeableActivityWithOptions(ActivityOptions{UntilRef: "does-not-exist"})
+	assert.ErrorContains(t, err, "ref 'does-not-exist' does not exist")
+}
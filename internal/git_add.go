@@ -8,7 +8,7 @@ import (
 )
 
 func (gm *GitManager) AddWorktree(worktreeName, branchName string, createBranch bool, baseBranch string) error {
-	worktreeDir := filepath.Join(gm.repoPath, gm.worktreePrefix)
+	worktreeDir := filepath.Join(gm.worktreeRoot, gm.worktreePrefix)
 	worktreePath := filepath.Join(worktreeDir, worktreeName)
 
 	// Check if worktree already exists
@@ -33,7 +33,7 @@ func (gm *GitManager) AddWorktree(worktreeName, branchName string, createBranch
 			// Branch exists, check if it's based on the correct base branch
 			if baseBranch != "" {
 				// Get the merge base between the existing branch and the base branch
-				mergeBase, err := ExecGitCommand(gm.repoPath, "merge-base", branchName, baseBranch)
+				mergeBase, err := gm.GetMergeBase(branchName, baseBranch)
 				if err != nil {
 					return fmt.Errorf("failed to get merge base: %w", err)
 				}
@@ -43,10 +43,9 @@ func (gm *GitManager) AddWorktree(worktreeName, branchName string, createBranch
 				if err != nil {
 					return fmt.Errorf("failed to get base branch commit: %w", err)
 				}
-				baseCommit := []byte(baseCommitHash)
 
 				// Check if the existing branch is based on the correct base branch
-				if strings.TrimSpace(string(mergeBase)) != strings.TrimSpace(string(baseCommit)) {
+				if mergeBase != strings.TrimSpace(baseCommitHash) {
 					return fmt.Errorf("branch '%s' exists but is not based on '%s'. Please delete the branch and try again, or use a different branch name", branchName, baseBranch)
 				}
 			}
@@ -84,7 +83,7 @@ func (gm *GitManager) AddWorktree(worktreeName, branchName string, createBranch
 			finalArgs = append(finalArgs, "worktree", "add", worktreePath, branchName)
 		} else {
 			// Branch exists only remotely, create local tracking branch first
-			remoteBranch := Remote(branchName)
+			remoteBranch := gm.remoteBranch(branchName)
 			finalArgs = append(finalArgs, "worktree", "add", "-b", branchName, worktreePath, remoteBranch)
 		}
 	}
@@ -95,3 +94,134 @@ func (gm *GitManager) AddWorktree(worktreeName, branchName string, createBranch
 
 	return nil
 }
+
+// CreateWorktreeFromRef creates a worktree starting from an arbitrary ref such as a tag or commit SHA.
+// When newBranch is empty the worktree is created with a detached HEAD at startRef.
+func (gm *GitManager) CreateWorktreeFromRef(worktreeName, newBranch, startRef string) error {
+	worktreeDir := filepath.Join(gm.worktreeRoot, gm.worktreePrefix)
+	worktreePath := filepath.Join(worktreeDir, worktreeName)
+
+	if _, err := os.Stat(worktreePath); !os.IsNotExist(err) {
+		return fmt.Errorf("worktree '%s' already exists", worktreeName)
+	}
+
+	exists, err := gm.VerifyRef(startRef + "^{commit}")
+	if err != nil {
+		return fmt.Errorf("failed to verify ref '%s': %w", startRef, err)
+	}
+	if !exists {
+		return fmt.Errorf("ref '%s' does not exist", startRef)
+	}
+
+	if err := os.MkdirAll(worktreeDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create worktrees directory: %w", err)
+	}
+
+	args := []string{"worktree", "add", "--detach", worktreePath, startRef}
+	if newBranch != "" {
+		branchExists, err := gm.BranchExists(newBranch)
+		if err != nil {
+			return fmt.Errorf("failed to check if branch exists: %w", err)
+		}
+		if branchExists {
+			return fmt.Errorf("branch '%s' already exists", newBranch)
+		}
+		args = []string{"worktree", "add", "-b", newBranch, worktreePath, startRef}
+	}
+
+	if output, err := ExecGitCommandCombined(gm.repoPath, args...); err != nil {
+		return fmt.Errorf("git worktree add failed: %s", strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
+
+// AddWorktreeTracking creates a worktree on a new local branch that tracks an existing remote branch.
+// remoteRef is given as <remote>/<branch>; a ref without a known remote prefix is looked up on the
+// default remote. When localBranch is empty it defaults to the remote branch name.
+func (gm *GitManager) AddWorktreeTracking(worktreeName, localBranch, remoteRef string) error {
+	worktreeDir := filepath.Join(gm.worktreeRoot, gm.worktreePrefix)
+	worktreePath := filepath.Join(worktreeDir, worktreeName)
+
+	if _, err := os.Stat(worktreePath); !os.IsNotExist(err) {
+		return fmt.Errorf("worktree '%s' already exists", worktreeName)
+	}
+
+	remote, branch, err := gm.splitRemoteRef(remoteRef)
+	if err != nil {
+		return err
+	}
+	remoteRef = RemoteFor(remote, branch)
+
+	exists, err := gm.VerifyRef("refs/remotes/" + remoteRef)
+	if err != nil {
+		return fmt.Errorf("failed to verify remote branch '%s': %w", remoteRef, err)
+	}
+	if !exists {
+		return fmt.Errorf("remote branch '%s' does not exist (try 'git fetch %s')", remoteRef, remote)
+	}
+
+	if localBranch == "" {
+		localBranch = branch
+	}
+
+	localExists, err := gm.BranchExistsLocal(localBranch)
+	if err != nil {
+		return fmt.Errorf("failed to check if local branch exists: %w", err)
+	}
+	if localExists {
+		return fmt.Errorf("branch '%s' already exists locally; use 'gbm add %s %s' to check it out", localBranch, worktreeName, localBranch)
+	}
+
+	if err := os.MkdirAll(worktreeDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create worktrees directory: %w", err)
+	}
+
+	if output, err := ExecGitCommandCombined(gm.repoPath, "worktree", "add", "--track", "-b", localBranch, worktreePath, remoteRef); err != nil {
+		return fmt.Errorf("git worktree add failed: %s", strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
+
+// splitRemoteRef splits <remote>/<branch> into its parts using the configured remotes,
+// falling back to the default remote when the ref doesn't start with a known remote name
+func (gm *GitManager) splitRemoteRef(ref string) (string, string, error) {
+	output, err := ExecGitCommand(gm.repoPath, "remote")
+	if err != nil {
+		return "", "", enhanceGitError(err, "list remotes")
+	}
+
+	for remote := range strings.SplitSeq(string(output), "\n") {
+		remote = strings.TrimSpace(remote)
+		if remote != "" && strings.HasPrefix(ref, remote+"/") {
+			return remote, strings.TrimPrefix(ref, remote+"/"), nil
+		}
+	}
+
+	return gm.GetDefaultRemote(), ref, nil
+}
+
+// CreateBranch creates a local branch at startPoint without checking it out. A startPoint that is
+// only a branch on the default remote is taken from there.
+func (gm *GitManager) CreateBranch(branchName, startPoint string) error {
+	local, err := gm.BranchExistsLocal(startPoint)
+	if err != nil {
+		return fmt.Errorf("failed to check branch '%s': %w", startPoint, err)
+	}
+	if !local {
+		remote, err := gm.refExists("refs/remotes/" + gm.remoteBranch(startPoint))
+		if err != nil {
+			return fmt.Errorf("failed to check branch '%s': %w", startPoint, err)
+		}
+		if remote {
+			startPoint = gm.remoteBranch(startPoint)
+		}
+	}
+
+	if output, err := ExecGitCommandCombined(gm.repoPath, "branch", "--no-track", branchName, startPoint); err != nil {
+		return fmt.Errorf("failed to create branch '%s' from '%s': %s", branchName, startPoint, strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
@@ -2,6 +2,7 @@ package internal
 
 import (
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"gbm/internal/testutils"
@@ -181,3 +182,71 @@ func TestGitManager_AddWorktree(t *testing.T) {
 		})
 	}
 }
+
+func TestManager_CreateWorktreeFromRef(t *testing.T) {
+	manager, _, repo := setupManagerForRemoverTests(t)
+
+	must(t, execGitCommandRun(repo.GetLocalPath(), "tag", "v1.0.0"))
+	must(t, repo.WriteFile("after-tag.txt", "after"))
+	must(t, repo.CommitChanges("Commit after tag"))
+
+	// New branch from a tag
+	require.NoError(t, manager.CreateWorktreeFromRef("hotfix", "hotfix/1.0.1", "v1.0.0"))
+	verifyWorktreeLinked(t, manager.GetGitManager(), "hotfix", "hotfix/1.0.1")
+	assert.NoFileExists(t, filepath.Join(repo.GetLocalPath(), "worktrees", "hotfix", "after-tag.txt"))
+
+	baseRef, exists := manager.GetState().GetWorktreeBaseBranch("hotfix")
+	assert.True(t, exists)
+	assert.Equal(t, "v1.0.0", baseRef)
+
+	// Detached HEAD when no branch is given
+	require.NoError(t, manager.CreateWorktreeFromRef("inspect", "", "v1.0.0"))
+	tagCommit, err := ExecGitCommand(repo.GetLocalPath(), "rev-parse", "v1.0.0^{commit}")
+	require.NoError(t, err)
+	verifyWorktreeLinked(t, manager.GetGitManager(), "inspect", DetachedBranchLabel(strings.TrimSpace(string(tagCommit))))
+
+	// Errors
+	err = manager.CreateWorktreeFromRef("missing", "feature/missing", "v9.9.9")
+	assert.ErrorContains(t, err, "does not exist")
+
+	err = manager.CreateWorktreeFromRef("hotfix2", "hotfix/1.0.1", "v1.0.0")
+	assert.ErrorContains(t, err, "branch 'hotfix/1.0.1' already exists")
+
+	err = manager.CreateWorktreeFromRef("hotfix", "hotfix/other", "v1.0.0")
+	assert.ErrorContains(t, err, "worktree 'hotfix' already exists")
+}
+
+func TestManager_AddWorktreeTracking(t *testing.T) {
+	manager, repoPath, repo := setupManagerForRemoverTests(t)
+
+	must(t, repo.CreateBranch("feature/x", "remote work"))
+	must(t, repo.PushBranch("feature/x"))
+	must(t, execGitCommandRun(repoPath, "checkout", "main"))
+	must(t, execGitCommandRun(repoPath, "branch", "-D", "feature/x"))
+
+	// Local branch defaults to the remote branch name and tracks it
+	require.NoError(t, manager.AddWorktreeTracking("myfeature", "", "origin/feature/x"))
+	verifyWorktreeLinked(t, manager.GetGitManager(), "myfeature", "feature/x")
+
+	upstream, err := manager.GetGitManager().GetUpstreamBranch(filepath.Join(repoPath, "worktrees", "myfeature"))
+	require.NoError(t, err)
+	assert.Equal(t, "origin/feature/x", upstream)
+
+	baseRef, exists := manager.GetState().GetWorktreeBaseBranch("myfeature")
+	assert.True(t, exists)
+	assert.Equal(t, "origin/feature/x", baseRef)
+
+	// Explicit local branch name
+	require.NoError(t, manager.AddWorktreeTracking("other", "feature/other-x", "origin/feature/x"))
+	verifyWorktreeLinked(t, manager.GetGitManager(), "other", "feature/other-x")
+
+	// Errors
+	err = manager.AddWorktreeTracking("missing", "", "origin/feature/missing")
+	assert.ErrorContains(t, err, "remote branch 'origin/feature/missing' does not exist")
+
+	err = manager.AddWorktreeTracking("again", "", "origin/feature/x")
+	assert.ErrorContains(t, err, "branch 'feature/x' already exists locally")
+
+	err = manager.AddWorktreeTracking("myfeature", "feature/y", "origin/feature/x")
+	assert.ErrorContains(t, err, "worktree 'myfeature' already exists")
+}
@@ -0,0 +1,57 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrCherryPickConflict = errors.New("cherry-pick has conflicts")
+
+// CherryPick applies commits, in order, onto the branch checked out in worktreePath. On conflicts
+// the cherry-pick is left in progress for manual resolution and ErrCherryPickConflict is returned.
+func (gm *GitManager) CherryPick(worktreePath string, commits []string) error {
+	if len(commits) == 0 {
+		return fmt.Errorf("no commits to cherry-pick")
+	}
+
+	return runCherryPickCommand(worktreePath, append([]string{"cherry-pick"}, commits...)...)
+}
+
+// ContinueCherryPick resumes an in-progress cherry-pick after conflicts have been resolved and staged
+func (gm *GitManager) ContinueCherryPick(worktreePath string) error {
+	// Keep the original commit messages instead of opening an editor
+	return runCherryPickCommand(worktreePath, "-c", "core.editor=true", "cherry-pick", "--continue")
+}
+
+// CherryPickAbort abandons an in-progress cherry-pick and restores the branch to its previous state
+func (gm *GitManager) CherryPickAbort(worktreePath string) error {
+	output, err := ExecGitCommandCombined(worktreePath, "cherry-pick", "--abort")
+	if err != nil {
+		return fmt.Errorf("git cherry-pick --abort failed: %s", strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
+
+// IsCherryPickInProgress reports whether the worktree has an unfinished cherry-pick (CHERRY_PICK_HEAD exists)
+func (gm *GitManager) IsCherryPickInProgress(worktreePath string) (bool, error) {
+	exists, err := gm.VerifyRefInPath(worktreePath, "CHERRY_PICK_HEAD")
+	if err != nil {
+		return false, fmt.Errorf("failed to check cherry-pick state in %s: %w", worktreePath, err)
+	}
+	return exists, nil
+}
+
+// runCherryPickCommand runs a git cherry-pick command and maps conflicts to ErrCherryPickConflict
+func runCherryPickCommand(worktreePath string, args ...string) error {
+	output, err := ExecGitCommandCombined(worktreePath, args...)
+	if err != nil {
+		if strings.Contains(string(output), "CONFLICT") || strings.Contains(string(output), "could not apply") {
+			return fmt.Errorf("%w: %s", ErrCherryPickConflict, worktreePath)
+		}
+		return fmt.Errorf("git cherry-pick failed: %s", strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
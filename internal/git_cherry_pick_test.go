@@ -0,0 +1,83 @@
+package internal
+
+import (
+	"strings"
+	"testing"
+
+	"gbm/internal/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitManager_CherryPick(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+	defer repo.Cleanup()
+
+	// production gets a hotfix and an unrelated change; main only wants the hotfix
+	must(t, repo.CreateBranch("production", "production content"))
+	must(t, repo.SwitchToBranch("production"))
+	must(t, repo.WriteFile("hotfix.txt", "hotfix"))
+	must(t, repo.CommitChanges("Hotfix"))
+	hotfix, err := ExecGitCommand(repo.GetLocalPath(), "rev-parse", "HEAD")
+	require.NoError(t, err)
+	must(t, repo.WriteFile("release.txt", "release only"))
+	must(t, repo.CommitChanges("Release change"))
+	must(t, repo.SwitchToBranch("main"))
+	must(t, repo.WriteFile("content.txt", "main content"))
+	must(t, repo.CommitChanges("Main content"))
+
+	gitManager, err := NewGitManager(repo.GetLocalPath(), "worktrees")
+	require.NoError(t, err)
+	path := repo.GetLocalPath()
+
+	require.NoError(t, gitManager.CherryPick(path, []string{strings.TrimSpace(string(hotfix))}))
+	assert.FileExists(t, path+"/hotfix.txt")
+	assert.NoFileExists(t, path+"/release.txt")
+
+	assert.ErrorContains(t, gitManager.CherryPick(path, nil), "no commits to cherry-pick")
+	assert.ErrorContains(t, gitManager.CherryPick(path, []string{"does-not-exist"}), "git cherry-pick failed")
+
+	// Both branches added content.txt with different contents, so picking production's first commit conflicts
+	err = gitManager.CherryPick(path, []string{"production~2"})
+	require.ErrorIs(t, err, ErrCherryPickConflict)
+
+	inProgress, err := gitManager.IsCherryPickInProgress(path)
+	require.NoError(t, err)
+	assert.True(t, inProgress)
+
+	conflicted, err := gitManager.ListConflictedFiles(path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"content.txt"}, conflicted)
+
+	t.Run("abort restores the branch", func(t *testing.T) {
+		require.NoError(t, gitManager.CherryPickAbort(path))
+
+		inProgress, err := gitManager.IsCherryPickInProgress(path)
+		require.NoError(t, err)
+		assert.False(t, inProgress)
+
+		status, err := gitManager.GetWorktreeStatus(path)
+		require.NoError(t, err)
+		assert.False(t, status.HasChanges())
+
+		assert.ErrorContains(t, gitManager.CherryPickAbort(path), "git cherry-pick --abort failed")
+	})
+
+	t.Run("continue after resolving conflicts", func(t *testing.T) {
+		require.ErrorIs(t, gitManager.CherryPick(path, []string{"production~2"}), ErrCherryPickConflict)
+
+		must(t, repo.WriteFile("content.txt", "resolved content"))
+		_, err := ExecGitCommand(path, "add", "content.txt")
+		require.NoError(t, err)
+
+		require.NoError(t, gitManager.ContinueCherryPick(path))
+
+		inProgress, err := gitManager.IsCherryPickInProgress(path)
+		require.NoError(t, err)
+		assert.False(t, inProgress)
+	})
+}
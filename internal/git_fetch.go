@@ -0,0 +1,243 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// DefaultTokenEnvVars are checked in order for an HTTPS remote token when no token_env is configured
+var DefaultTokenEnvVars = []string{"GBM_GIT_TOKEN", "GITHUB_TOKEN"}
+
+// fetchRetryBaseDelay is the wait before the first retry; it doubles on each subsequent attempt
+var fetchRetryBaseDelay = time.Second
+
+// transientFetchErrors are git/ssh messages for failures that may succeed when retried
+var transientFetchErrors = []string{
+	"could not resolve host",
+	"connection timed out",
+	"operation timed out",
+	"connection reset",
+	"connection refused",
+	"failed to connect",
+	"couldn't connect to server",
+	"connection closed",
+	"network is unreachable",
+	"the remote end hung up unexpectedly",
+	"early eof",
+	"rpc failed",
+	"ssh_exchange_identification",
+	"kex_exchange_identification",
+	"temporary failure",
+}
+
+// sshAgentFetchErrors are ssh-agent failures; ssh follows them with "Permission denied (publickey)",
+// so they are checked before definitiveFetchErrors
+var sshAgentFetchErrors = []string{
+	"agent refused operation",
+	"communication with agent failed",
+	"sign_and_send_pubkey",
+}
+
+// definitiveFetchErrors are failures that will not go away by retrying
+var definitiveFetchErrors = []string{
+	"repository not found",
+	"does not appear to be a git repository",
+	"authentication failed",
+	"permission denied",
+	"couldn't find remote ref",
+}
+
+// SetFetchRetries sets how many times FetchAll retries after a transient failure
+func (gm *GitManager) SetFetchRetries(retries int) {
+	if retries < 0 {
+		retries = 0
+	}
+	gm.fetchRetries = retries
+}
+
+// SetFetchTimeout sets how long a single fetch attempt may take before it is killed; 0 disables the timeout
+func (gm *GitManager) SetFetchTimeout(timeout time.Duration) {
+	if timeout < 0 {
+		timeout = 0
+	}
+	gm.fetchTimeout = timeout
+}
+
+// SetTokenEnv sets the environment variable holding a token for HTTPS remotes.
+// An empty name falls back to DefaultTokenEnvVars.
+func (gm *GitManager) SetTokenEnv(envVar string) {
+	gm.tokenEnv = envVar
+}
+
+// FetchAll fetches from all remotes, retrying transient network and SSH agent failures
+// with exponential backoff. When the default remote uses HTTPS and a token is available
+// in the environment, it is supplied to git as the remote password. Each attempt is
+// killed after the fetch timeout, and cancelling ctx stops the fetch altogether.
+func (gm *GitManager) FetchAll(ctx context.Context) error {
+	return gm.fetchAll(ctx, false)
+}
+
+// FetchAllPrune is FetchAll with --prune, dropping remote-tracking refs for branches
+// that were deleted on the remote
+func (gm *GitManager) FetchAllPrune(ctx context.Context) error {
+	return gm.fetchAll(ctx, true)
+}
+
+func (gm *GitManager) fetchAll(ctx context.Context, prune bool) error {
+	remoteURL := gm.defaultRemoteURL()
+	isHTTPS := strings.HasPrefix(strings.ToLower(remoteURL), "https://")
+
+	tokenEnv := ""
+	if isHTTPS {
+		tokenEnv = gm.findTokenEnv()
+	}
+
+	args := []string{"fetch", "--all"}
+	if prune {
+		args = append(args, "--prune")
+	}
+	if tokenEnv != "" {
+		logVerbose("Using token from $%s for HTTPS remote %s", tokenEnv, remoteURL)
+		args = append(tokenCredentialArgs(tokenEnv, remoteURL), args...)
+	}
+
+	delay := fetchRetryBaseDelay
+	for attempt := 0; ; attempt++ {
+		output, err := gm.fetchAttempt(ctx, args)
+		if err == nil {
+			gm.clearRemoteBranches()
+			return nil
+		}
+		if ctx.Err() != nil {
+			return fmt.Errorf("fetch cancelled: %w", ctx.Err())
+		}
+		if errors.Is(err, context.DeadlineExceeded) {
+			// A hung remote is unlikely to answer on the next attempt either
+			return fmt.Errorf("fetch timed out after %s; check the remote is reachable or raise settings.fetch_timeout", gm.fetchTimeout)
+		}
+
+		message := strings.TrimSpace(string(output))
+		if attempt >= gm.fetchRetries || !isTransientFetchError(message) {
+			if hint := gm.fetchAuthHint(message, isHTTPS, tokenEnv); hint != "" {
+				return fmt.Errorf("failed to fetch from remote: %s\n%s", message, hint)
+			}
+			return fmt.Errorf("failed to fetch from remote: %s", message)
+		}
+
+		logVerbose("Fetch failed (attempt %d of %d), retrying in %s: %s", attempt+1, gm.fetchRetries+1, delay, message)
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return fmt.Errorf("fetch cancelled: %w", ctx.Err())
+		}
+		delay *= 2
+	}
+}
+
+// fetchAttempt runs a single fetch, bounded by the fetch timeout. A timeout is reported as
+// context.DeadlineExceeded rather than git's "signal: killed".
+func (gm *GitManager) fetchAttempt(ctx context.Context, args []string) ([]byte, error) {
+	if gm.fetchTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, gm.fetchTimeout)
+		defer cancel()
+	}
+
+	output, err := execGitCommandCombinedContext(ctx, gm.repoPath, args...)
+	if err != nil && ctx.Err() != nil {
+		return output, ctx.Err()
+	}
+	return output, err
+}
+
+// defaultRemoteURL returns the fetch URL of the default remote, or "" if it cannot be determined
+func (gm *GitManager) defaultRemoteURL() string {
+	remoteURL, err := gm.GetRemoteURL("")
+	if err != nil {
+		return ""
+	}
+	return remoteURL
+}
+
+// findTokenEnv returns the first candidate environment variable that holds a token
+func (gm *GitManager) findTokenEnv() string {
+	candidates := DefaultTokenEnvVars
+	if gm.tokenEnv != "" {
+		candidates = []string{gm.tokenEnv}
+	}
+
+	for _, envVar := range candidates {
+		if os.Getenv(envVar) != "" {
+			return envVar
+		}
+	}
+	return ""
+}
+
+// tokenCredentialArgs configures a one-off credential helper that answers with the token
+// from envVar. The helper reads the variable itself so the token never appears in arguments.
+// It is scoped to the host of remoteURL so fetch --all never offers the token to other remotes.
+func tokenCredentialArgs(envVar, remoteURL string) []string {
+	parsed, err := url.Parse(remoteURL)
+	if err != nil || parsed.Host == "" {
+		return nil
+	}
+	key := fmt.Sprintf("credential.%s://%s.helper", parsed.Scheme, parsed.Host)
+	helper := fmt.Sprintf("!f() { echo username=x-access-token; echo \"password=$%s\"; }; f", envVar)
+	return []string{"-c", key + "=", "-c", key + "=" + helper}
+}
+
+// fetchAuthHint explains which authentication method is missing when a fetch fails to authenticate
+func (gm *GitManager) fetchAuthHint(output string, isHTTPS bool, tokenEnv string) string {
+	lower := strings.ToLower(output)
+
+	if isHTTPS {
+		if !strings.Contains(lower, "authentication failed") && !strings.Contains(lower, "could not read username") {
+			return ""
+		}
+		if tokenEnv != "" {
+			return fmt.Sprintf("The token in $%s was rejected by the remote.", tokenEnv)
+		}
+		candidates := DefaultTokenEnvVars
+		if gm.tokenEnv != "" {
+			candidates = []string{gm.tokenEnv}
+		}
+		return fmt.Sprintf("HTTPS remote requires a token: set $%s or configure a git credential helper.", strings.Join(candidates, " or $"))
+	}
+
+	if strings.Contains(lower, "permission denied (publickey") || strings.Contains(lower, "agent refused operation") {
+		return "SSH authentication failed: ensure an SSH agent is running with your key loaded (ssh-add -l)."
+	}
+
+	return ""
+}
+
+// isTransientFetchError reports whether git fetch output describes a failure worth retrying
+func isTransientFetchError(output string) bool {
+	output = strings.ToLower(output)
+
+	for _, pattern := range sshAgentFetchErrors {
+		if strings.Contains(output, pattern) {
+			return true
+		}
+	}
+
+	for _, pattern := range definitiveFetchErrors {
+		if strings.Contains(output, pattern) {
+			return false
+		}
+	}
+
+	for _, pattern := range transientFetchErrors {
+		if strings.Contains(output, pattern) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,204 @@
+package internal
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"gbm/internal/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsTransientFetchError(t *testing.T) {
+	tests := []struct {
+		output    string
+		transient bool
+	}{
+		{"fatal: unable to access 'https://example.com/repo.git/': Could not resolve host: example.com", true},
+		{"ssh: connect to host github.com port 22: Connection timed out\nfatal: Could not read from remote repository.", true},
+		{"sign_and_send_pubkey: signing failed for ED25519 \"key\" from agent: agent refused operation", true},
+		{"sign_and_send_pubkey: signing failed for ED25519 \"/home/u/.ssh/id_ed25519\" from agent: agent refused operation\ngit@github.com: Permission denied (publickey).\nfatal: Could not read from remote repository.", true},
+		{"git@github.com: Permission denied (publickey).\nfatal: Could not read from remote repository.", false},
+		{"fatal: the remote end hung up unexpectedly", true},
+		{"ERROR: Repository not found.\nfatal: Could not read from remote repository.", false},
+		{"fatal: '/tmp/missing' does not appear to be a git repository", false},
+		{"fatal: Authentication failed for 'https://example.com/repo.git/'", false},
+		{"error: some unexpected failure", false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.transient, isTransientFetchError(tt.output), tt.output)
+	}
+}
+
+func TestGitManager_FetchAll(t *testing.T) {
+	originalDelay := fetchRetryBaseDelay
+	fetchRetryBaseDelay = time.Millisecond
+	defer func() { fetchRetryBaseDelay = originalDelay }()
+
+	var logged []string
+	SetVerboseLogger(func(format string, args ...any) {
+		logged = append(logged, fmt.Sprintf(format, args...))
+	})
+	defer SetVerboseLogger(nil)
+
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+
+	gitManager, err := NewGitManager(repo.GetLocalPath(), "worktrees")
+	require.NoError(t, err)
+
+	require.NoError(t, gitManager.FetchAll(context.Background()))
+	assert.Empty(t, logged)
+
+	// Definitive failures are not retried
+	missing := filepath.Join(t.TempDir(), "missing.git")
+	must(t, execGitCommandRun(repo.GetLocalPath(), "remote", "add", "broken", missing))
+	err = gitManager.FetchAll(context.Background())
+	assert.ErrorContains(t, err, "does not appear to be a git repository")
+	assert.Empty(t, logged)
+	must(t, execGitCommandRun(repo.GetLocalPath(), "remote", "remove", "broken"))
+
+	// Transient failures are retried up to the configured count
+	must(t, execGitCommandRun(repo.GetLocalPath(), "remote", "add", "flaky", "http://127.0.0.1:1/repo.git"))
+	gitManager.SetFetchRetries(2)
+	err = gitManager.FetchAll(context.Background())
+	assert.ErrorContains(t, err, "failed to fetch from remote")
+	assert.Len(t, logged, 2)
+}
+
+func TestGitManager_FetchAllTimeout(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+
+	gitManager, err := NewGitManager(repo.GetLocalPath(), "worktrees")
+	require.NoError(t, err)
+
+	// An SSH remote whose connection never answers
+	must(t, execGitCommandRun(repo.GetLocalPath(), "remote", "add", "hung", "ssh://git@example.invalid/repo.git"))
+	must(t, execGitCommandRun(repo.GetLocalPath(), "config", "core.sshCommand", "sleep 30 ||"))
+
+	gitManager.SetFetchTimeout(200 * time.Millisecond)
+	start := time.Now()
+	err = gitManager.FetchAll(context.Background())
+	assert.ErrorContains(t, err, "fetch timed out after 200ms")
+	assert.Less(t, time.Since(start), 10*time.Second)
+
+	// Cancelling the context stops the fetch even without a timeout
+	gitManager.SetFetchTimeout(0)
+	ctx, cancel := context.WithTimeout(context.Background(), 200*time.Millisecond)
+	defer cancel()
+	err = gitManager.FetchAll(ctx)
+	assert.ErrorContains(t, err, "fetch cancelled")
+	assert.Less(t, time.Since(start), 20*time.Second)
+}
+
+func TestGitManager_FetchAllPrune(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+	must(t, repo.CreateBranch("feature/gone", "feature content"))
+	must(t, repo.SwitchToBranch("main"))
+
+	gitManager, err := NewGitManager(repo.GetLocalPath(), "worktrees")
+	require.NoError(t, err)
+
+	require.NoError(t, gitManager.FetchAll(context.Background()))
+	branches, err := gitManager.GetRemoteBranches()
+	require.NoError(t, err)
+	assert.Contains(t, branches, "feature/gone")
+
+	// Delete the branch on the remote behind the local clone's back
+	must(t, execGitCommandRun(repo.GetRemotePath(), "branch", "-D", "feature/gone"))
+
+	// A plain fetch keeps the stale remote-tracking ref
+	require.NoError(t, gitManager.FetchAll(context.Background()))
+	branches, err = gitManager.GetRemoteBranches()
+	require.NoError(t, err)
+	assert.Contains(t, branches, "feature/gone")
+
+	require.NoError(t, gitManager.FetchAllPrune(context.Background()))
+	branches, err = gitManager.GetRemoteBranches()
+	require.NoError(t, err)
+	assert.NotContains(t, branches, "feature/gone")
+	assert.Contains(t, branches, "main")
+
+	exists, err := gitManager.BranchExistsLocalOrRemote("feature/gone")
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestGitManager_FindTokenEnv(t *testing.T) {
+	t.Setenv("GBM_GIT_TOKEN", "")
+	t.Setenv("GITHUB_TOKEN", "")
+	t.Setenv("CUSTOM_TOKEN", "")
+
+	gitManager := &GitManager{}
+	assert.Empty(t, gitManager.findTokenEnv())
+
+	t.Setenv("GITHUB_TOKEN", "gh-token")
+	assert.Equal(t, "GITHUB_TOKEN", gitManager.findTokenEnv())
+
+	t.Setenv("GBM_GIT_TOKEN", "gbm-token")
+	assert.Equal(t, "GBM_GIT_TOKEN", gitManager.findTokenEnv())
+
+	// A configured variable replaces the defaults
+	gitManager.SetTokenEnv("CUSTOM_TOKEN")
+	assert.Empty(t, gitManager.findTokenEnv())
+
+	t.Setenv("CUSTOM_TOKEN", "custom-token")
+	assert.Equal(t, "CUSTOM_TOKEN", gitManager.findTokenEnv())
+}
+
+func TestTokenCredentialArgs(t *testing.T) {
+	t.Setenv("CUSTOM_TOKEN", "s3cret")
+
+	args := tokenCredentialArgs("CUSTOM_TOKEN", "https://user@example.com/org/repo.git")
+	require.NotEmpty(t, args)
+	for _, arg := range args {
+		assert.NotContains(t, arg, "s3cret", "token must not be passed on the command line")
+	}
+
+	cmd := exec.Command("git", append(args, "credential", "fill")...)
+	cmd.Stdin = strings.NewReader("protocol=https\nhost=example.com\n\n")
+	output, err := cmd.Output()
+	require.NoError(t, err)
+	assert.Contains(t, string(output), "username=x-access-token")
+	assert.Contains(t, string(output), "password=s3cret")
+
+	// Other remotes fetched by fetch --all never see the token
+	cmd = exec.Command("git", append(args, "credential", "fill")...)
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0", "GIT_ASKPASS=", "SSH_ASKPASS=")
+	cmd.Stdin = strings.NewReader("protocol=https\nhost=other.example.org\n\n")
+	output, _ = cmd.Output()
+	assert.NotContains(t, string(output), "s3cret")
+
+	assert.Empty(t, tokenCredentialArgs("CUSTOM_TOKEN", "not a url"))
+}
+
+func TestGitManager_FetchAuthHint(t *testing.T) {
+	gitManager := &GitManager{}
+
+	hint := gitManager.fetchAuthHint("fatal: could not read Username for 'https://github.com': terminal prompts disabled", true, "")
+	assert.Contains(t, hint, "$GBM_GIT_TOKEN or $GITHUB_TOKEN")
+
+	hint = gitManager.fetchAuthHint("fatal: Authentication failed for 'https://github.com/org/repo.git/'", true, "GITHUB_TOKEN")
+	assert.Contains(t, hint, "$GITHUB_TOKEN was rejected")
+
+	hint = gitManager.fetchAuthHint("git@github.com: Permission denied (publickey).", false, "")
+	assert.Contains(t, hint, "SSH agent")
+
+	assert.Empty(t, gitManager.fetchAuthHint("fatal: unable to access: Could not resolve host", true, ""))
+}
@@ -0,0 +1,59 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrMergeConflict = errors.New("merge has conflicts")
+
+// MergeIntoWorktree merges sourceRef into the branch checked out in worktreePath with a merge commit.
+// On conflicts the merge is left in progress for manual resolution and ErrMergeConflict is returned.
+func (gm *GitManager) MergeIntoWorktree(worktreePath, sourceRef, message string) error {
+	output, err := ExecGitCommandCombined(worktreePath, "merge", "--no-ff", "-m", message, sourceRef)
+	if err != nil {
+		if strings.Contains(string(output), "CONFLICT") || strings.Contains(string(output), "Automatic merge failed") {
+			return fmt.Errorf("%w: %s", ErrMergeConflict, worktreePath)
+		}
+		return fmt.Errorf("git merge failed: %s", strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
+
+// IsMergeInProgress reports whether the worktree has an unfinished merge (MERGE_HEAD exists)
+func (gm *GitManager) IsMergeInProgress(worktreePath string) (bool, error) {
+	exists, err := gm.VerifyRefInPath(worktreePath, "MERGE_HEAD")
+	if err != nil {
+		return false, fmt.Errorf("failed to check merge state in %s: %w", worktreePath, err)
+	}
+	return exists, nil
+}
+
+// AbortMerge abandons an in-progress merge and restores the worktree to its pre-merge state
+func (gm *GitManager) AbortMerge(worktreePath string) error {
+	output, err := ExecGitCommandCombined(worktreePath, "merge", "--abort")
+	if err != nil {
+		return fmt.Errorf("git merge --abort failed: %s", strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
+
+// ListConflictedFiles returns the paths with unresolved merge conflicts in the worktree
+func (gm *GitManager) ListConflictedFiles(worktreePath string) ([]string, error) {
+	output, err := ExecGitCommand(worktreePath, "diff", "--name-only", "--diff-filter=U")
+	if err != nil {
+		return nil, enhanceGitError(err, "list conflicted files")
+	}
+
+	var files []string
+	for _, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		if line != "" {
+			files = append(files, line)
+		}
+	}
+
+	return files, nil
+}
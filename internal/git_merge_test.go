@@ -0,0 +1,56 @@
+package internal
+
+import (
+	"testing"
+
+	"gbm/internal/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitManager_AbortMerge(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+	defer repo.Cleanup()
+
+	must(t, repo.CreateBranch("left", "left content"))
+	must(t, repo.CreateBranch("right", "right content"))
+	must(t, repo.SwitchToBranch("left"))
+
+	gitManager, err := NewGitManager(repo.GetLocalPath(), "worktrees")
+	require.NoError(t, err)
+
+	inProgress, err := gitManager.IsMergeInProgress(repo.GetLocalPath())
+	require.NoError(t, err)
+	assert.False(t, inProgress)
+
+	err = gitManager.MergeIntoWorktree(repo.GetLocalPath(), "right", "Merge right into left")
+	require.ErrorIs(t, err, ErrMergeConflict)
+
+	inProgress, err = gitManager.IsMergeInProgress(repo.GetLocalPath())
+	require.NoError(t, err)
+	assert.True(t, inProgress)
+
+	conflicted, err := gitManager.ListConflictedFiles(repo.GetLocalPath())
+	require.NoError(t, err)
+	assert.Equal(t, []string{"content.txt"}, conflicted)
+
+	require.NoError(t, gitManager.AbortMerge(repo.GetLocalPath()))
+
+	inProgress, err = gitManager.IsMergeInProgress(repo.GetLocalPath())
+	require.NoError(t, err)
+	assert.False(t, inProgress)
+
+	status, err := gitManager.GetWorktreeStatus(repo.GetLocalPath())
+	require.NoError(t, err)
+	assert.False(t, status.HasChanges())
+
+	conflicted, err = gitManager.ListConflictedFiles(repo.GetLocalPath())
+	require.NoError(t, err)
+	assert.Empty(t, conflicted)
+
+	assert.ErrorContains(t, gitManager.AbortMerge(repo.GetLocalPath()), "git merge --abort failed")
+}
@@ -0,0 +1,79 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PruneWorktrees runs `git worktree prune` and returns git's description of each
+// stale worktree entry that was pruned (or would be, when dryRun is set)
+func (gm *GitManager) PruneWorktrees(dryRun bool) ([]string, error) {
+	args := []string{"worktree", "prune", "--verbose"}
+	if dryRun {
+		args = append(args, "--dry-run")
+	}
+
+	output, err := ExecGitCommandCombined(gm.repoPath, args...)
+	if err != nil {
+		return nil, fmt.Errorf("git worktree prune failed: %s", strings.TrimSpace(string(output)))
+	}
+
+	var pruned []string
+	for line := range strings.SplitSeq(string(output), "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" {
+			pruned = append(pruned, line)
+		}
+	}
+
+	return pruned, nil
+}
+
+// GetLocalBranchesWithPrefix returns local branches whose names start with the given prefix (e.g., "merge/")
+func (gm *GitManager) GetLocalBranchesWithPrefix(prefix string) ([]string, error) {
+	pattern := "refs/heads/" + strings.TrimSuffix(prefix, "/")
+	output, err := ExecGitCommand(gm.repoPath, "for-each-ref", "--format=%(refname:short)", pattern)
+	if err != nil {
+		return nil, enhanceGitError(err, "list local branches")
+	}
+
+	var branches []string
+	for line := range strings.SplitSeq(string(output), "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" && strings.HasPrefix(line, prefix) {
+			branches = append(branches, line)
+		}
+	}
+
+	return branches, nil
+}
+
+// IsBranchMergedInto reports whether every commit on branch is reachable from target
+func (gm *GitManager) IsBranchMergedInto(branch, target string) (bool, error) {
+	return gm.IsAncestor(branch, target)
+}
+
+// DeleteLocalBranch deletes a local branch. Without force, git refuses to delete unmerged branches.
+func (gm *GitManager) DeleteLocalBranch(branchName string, force bool) error {
+	flag := "-d"
+	if force {
+		flag = "-D"
+	}
+
+	if output, err := ExecGitCommandCombined(gm.repoPath, "branch", flag, branchName); err != nil {
+		return fmt.Errorf("failed to delete branch '%s': %s", branchName, strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
+
+// DeleteRemoteBranch deletes a branch on the given remote with `git push <remote> --delete`,
+// which also drops the local remote-tracking ref
+func (gm *GitManager) DeleteRemoteBranch(remoteName, branchName string) error {
+	if output, err := ExecGitCommandCombined(gm.repoPath, "push", remoteName, "--delete", branchName); err != nil {
+		return fmt.Errorf("failed to delete remote branch '%s/%s': %s", remoteName, branchName, strings.TrimSpace(string(output)))
+	}
+
+	gm.clearRemoteBranches()
+	return nil
+}
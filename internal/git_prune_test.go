@@ -0,0 +1,140 @@
+package internal
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gbm/internal/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitManager_PruneWorktrees(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+
+	must(t, repo.WriteFile(".gitignore", "worktrees/\n"))
+	must(t, repo.CommitChanges("Add .gitignore for worktrees"))
+
+	gitManager, err := NewGitManager(repo.GetLocalPath(), "worktrees")
+	require.NoError(t, err)
+
+	must(t, gitManager.AddWorktree("stale", "feature/stale", true, ""))
+	stalePath := filepath.Join(repo.GetLocalPath(), "worktrees", "stale")
+	require.NoError(t, os.RemoveAll(stalePath))
+
+	// Dry run reports the stale entry but keeps the metadata
+	pruned, err := gitManager.PruneWorktrees(true)
+	require.NoError(t, err)
+	assert.Len(t, pruned, 1)
+	assert.Contains(t, pruned[0], "stale")
+
+	worktrees, err := gitManager.GetWorktrees()
+	require.NoError(t, err)
+	assert.Len(t, worktrees, 2)
+
+	pruned, err = gitManager.PruneWorktrees(false)
+	require.NoError(t, err)
+	assert.Len(t, pruned, 1)
+	verifyWorktreeRemoved(t, gitManager, "stale")
+}
+
+func TestGitManager_MergeBranchHelpers(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+
+	gitManager, err := NewGitManager(repo.GetLocalPath(), "worktrees")
+	require.NoError(t, err)
+
+	// merge/merged points at main, merge/unmerged has an extra commit
+	must(t, execGitCommandRun(repo.GetLocalPath(), "branch", "merge/merged_main"))
+	must(t, execGitCommandRun(repo.GetLocalPath(), "checkout", "-b", "merge/unmerged_main"))
+	must(t, repo.WriteFile("extra.txt", "extra"))
+	must(t, repo.CommitChanges("Extra commit"))
+	must(t, execGitCommandRun(repo.GetLocalPath(), "checkout", "main"))
+	must(t, execGitCommandRun(repo.GetLocalPath(), "branch", "mergeable-not-prefixed"))
+
+	branches, err := gitManager.GetLocalBranchesWithPrefix("merge/")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"merge/merged_main", "merge/unmerged_main"}, branches)
+
+	merged, err := gitManager.IsBranchMergedInto("merge/merged_main", "main")
+	require.NoError(t, err)
+	assert.True(t, merged)
+
+	merged, err = gitManager.IsBranchMergedInto("merge/unmerged_main", "main")
+	require.NoError(t, err)
+	assert.False(t, merged)
+
+	// Safe delete refuses unmerged branches, force delete succeeds
+	err = gitManager.DeleteLocalBranch("merge/unmerged_main", false)
+	assert.ErrorContains(t, err, "failed to delete branch")
+	require.NoError(t, gitManager.DeleteLocalBranch("merge/unmerged_main", true))
+	require.NoError(t, gitManager.DeleteLocalBranch("merge/merged_main", false))
+
+	branches, err = gitManager.GetLocalBranchesWithPrefix("merge/")
+	require.NoError(t, err)
+	assert.Empty(t, branches)
+}
+
+func TestGitManager_DeleteRemoteBranch(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+	must(t, repo.CreateBranch("feature", "feature content"))
+	must(t, repo.SwitchToBranch("main"))
+
+	gitManager, err := NewGitManager(repo.GetLocalPath(), "worktrees")
+	require.NoError(t, err)
+
+	require.NoError(t, gitManager.DeleteRemoteBranch("origin", "feature"))
+
+	output, err := ExecGitCommand(repo.GetRemotePath(), "branch", "--list", "feature")
+	require.NoError(t, err)
+	assert.Empty(t, strings.TrimSpace(string(output)), "branch should be gone from the remote")
+
+	exists, err := gitManager.BranchExistsLocal("feature")
+	require.NoError(t, err)
+	assert.True(t, exists, "local branch should be kept")
+
+	err = gitManager.DeleteRemoteBranch("origin", "feature")
+	assert.ErrorContains(t, err, "failed to delete remote branch 'origin/feature'")
+}
+
+func TestManager_ReconcileWorktreeState(t *testing.T) {
+	manager, repoPath, _ := setupManagerForRemoverTests(t)
+
+	// Simulate a worktree directory deleted outside of gbm
+	manager.GetState().AdHocWorktrees = append(manager.GetState().AdHocWorktrees, "gone")
+	manager.GetState().SetWorktreeBaseBranch("gone", "main")
+	require.NoError(t, manager.SaveState())
+
+	removed, err := manager.ReconcileWorktreeState(true)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"gone"}, removed)
+	assert.Contains(t, manager.GetState().AdHocWorktrees, "gone", "dry run should not modify state")
+
+	removed, err = manager.ReconcileWorktreeState(false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"gone"}, removed)
+	assert.NotContains(t, manager.GetState().AdHocWorktrees, "gone")
+	_, exists := manager.GetState().GetWorktreeBaseBranch("gone")
+	assert.False(t, exists)
+
+	// Existing worktrees are left alone
+	_, exists = manager.GetState().GetWorktreeBaseBranch("dev")
+	assert.True(t, exists)
+
+	// State is persisted
+	state, err := LoadState(filepath.Join(repoPath, DefaultConfigDirname))
+	require.NoError(t, err)
+	assert.NotContains(t, state.AdHocWorktrees, "gone")
+}
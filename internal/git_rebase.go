@@ -0,0 +1,66 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var ErrRebaseConflict = errors.New("rebase has conflicts")
+
+// RebaseWorktree rebases the branch checked out in worktreePath onto ontoRef. On conflicts the
+// rebase is left in progress for manual resolution and ErrRebaseConflict is returned.
+func (gm *GitManager) RebaseWorktree(worktreePath, ontoRef string) error {
+	return runRebaseCommand(worktreePath, "rebase", ontoRef)
+}
+
+// ContinueRebase resumes an in-progress rebase after conflicts have been resolved and staged
+func (gm *GitManager) ContinueRebase(worktreePath string) error {
+	// Keep the original commit messages instead of opening an editor
+	return runRebaseCommand(worktreePath, "-c", "core.editor=true", "rebase", "--continue")
+}
+
+// AbortRebase abandons an in-progress rebase and restores the branch to its pre-rebase state
+func (gm *GitManager) AbortRebase(worktreePath string) error {
+	output, err := ExecGitCommandCombined(worktreePath, "rebase", "--abort")
+	if err != nil {
+		return fmt.Errorf("git rebase --abort failed: %s", strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
+
+// IsRebaseInProgress reports whether the worktree has an unfinished rebase
+func (gm *GitManager) IsRebaseInProgress(worktreePath string) (bool, error) {
+	for _, name := range []string{"rebase-merge", "rebase-apply"} {
+		output, err := ExecGitCommand(worktreePath, "rev-parse", "--git-path", name)
+		if err != nil {
+			return false, fmt.Errorf("failed to check rebase state in %s: %w", worktreePath, enhanceGitError(err, "rev-parse"))
+		}
+
+		path := strings.TrimSpace(string(output))
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(worktreePath, path)
+		}
+		if _, err := os.Stat(path); err == nil {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// runRebaseCommand runs a git rebase command and maps conflicts to ErrRebaseConflict
+func runRebaseCommand(worktreePath string, args ...string) error {
+	output, err := ExecGitCommandCombined(worktreePath, args...)
+	if err != nil {
+		if strings.Contains(string(output), "CONFLICT") || strings.Contains(string(output), "could not apply") {
+			return fmt.Errorf("%w: %s", ErrRebaseConflict, worktreePath)
+		}
+		return fmt.Errorf("git rebase failed: %s", strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
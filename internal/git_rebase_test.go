@@ -0,0 +1,71 @@
+package internal
+
+import (
+	"testing"
+
+	"gbm/internal/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitManager_RebaseWorktree(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+	defer repo.Cleanup()
+
+	must(t, repo.CreateBranch("feature", "feature content"))
+	must(t, repo.WriteFile("feature.txt", "feature only"))
+	must(t, repo.CommitChanges("Add feature file"))
+	must(t, repo.CreateBranchFrom("base", "main", "base content"))
+	must(t, repo.SwitchToBranch("feature"))
+
+	gitManager, err := NewGitManager(repo.GetLocalPath(), "worktrees")
+	require.NoError(t, err)
+	path := repo.GetLocalPath()
+
+	err = gitManager.RebaseWorktree(path, "base")
+	require.ErrorIs(t, err, ErrRebaseConflict)
+
+	inProgress, err := gitManager.IsRebaseInProgress(path)
+	require.NoError(t, err)
+	assert.True(t, inProgress)
+
+	conflicted, err := gitManager.ListConflictedFiles(path)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"content.txt"}, conflicted)
+
+	t.Run("abort restores the branch", func(t *testing.T) {
+		require.NoError(t, gitManager.AbortRebase(path))
+
+		inProgress, err := gitManager.IsRebaseInProgress(path)
+		require.NoError(t, err)
+		assert.False(t, inProgress)
+
+		status, err := gitManager.GetWorktreeStatus(path)
+		require.NoError(t, err)
+		assert.False(t, status.HasChanges())
+
+		assert.ErrorContains(t, gitManager.AbortRebase(path), "git rebase --abort failed")
+	})
+
+	t.Run("continue after resolving conflicts", func(t *testing.T) {
+		require.ErrorIs(t, gitManager.RebaseWorktree(path, "base"), ErrRebaseConflict)
+
+		must(t, repo.WriteFile("content.txt", "resolved content"))
+		_, err := ExecGitCommand(path, "add", "content.txt")
+		require.NoError(t, err)
+
+		require.NoError(t, gitManager.ContinueRebase(path))
+
+		inProgress, err := gitManager.IsRebaseInProgress(path)
+		require.NoError(t, err)
+		assert.False(t, inProgress)
+
+		isAncestor, err := gitManager.IsBranchMergedInto("base", "feature")
+		require.NoError(t, err)
+		assert.True(t, isAncestor)
+	})
+}
@@ -0,0 +1,104 @@
+package internal
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// RepoIdentity is the host, owner and repository name parsed from the default remote's URL
+type RepoIdentity struct {
+	Host  string
+	Owner string // may contain slashes for nested groups (e.g. GitLab subgroups)
+	Repo  string
+}
+
+// String returns the identity as host/owner/repo
+func (r RepoIdentity) String() string {
+	return r.Host + "/" + r.Owner + "/" + r.Repo
+}
+
+// GetRemoteURL returns the fetch URL of a remote. An empty remote means the default remote.
+func (gm *GitManager) GetRemoteURL(remote string) (string, error) {
+	if remote == "" {
+		remote = gm.GetDefaultRemote()
+	}
+
+	output, err := ExecGitCommand(gm.repoPath, "remote", "get-url", remote)
+	if err != nil {
+		return "", fmt.Errorf("failed to get URL of remote '%s': %w", remote, enhanceGitError(err, "remote get-url"))
+	}
+
+	return strings.TrimSpace(string(output)), nil
+}
+
+// ParseRemoteURL splits a git remote URL into host, owner and repo. It accepts scp-style SSH
+// (git@github.com:owner/repo.git) as well as ssh://, git:// and http(s):// URLs; a trailing
+// ".git" is dropped from the repo and any port or credentials from the host.
+func ParseRemoteURL(remoteURL string) (host, owner, repo string, err error) {
+	remoteURL = strings.TrimSpace(remoteURL)
+
+	var path string
+	if strings.Contains(remoteURL, "://") {
+		parsed, parseErr := url.Parse(remoteURL)
+		if parseErr != nil {
+			return "", "", "", fmt.Errorf("invalid remote URL %q: %w", remoteURL, parseErr)
+		}
+		host, path = parsed.Hostname(), parsed.Path
+	} else {
+		// scp-like syntax: [user@]host:path
+		hostPart, pathPart, found := strings.Cut(remoteURL, ":")
+		if !found || strings.Contains(hostPart, "/") {
+			return "", "", "", fmt.Errorf("unrecognized remote URL %q", remoteURL)
+		}
+		if _, after, ok := strings.Cut(hostPart, "@"); ok {
+			hostPart = after
+		}
+		host, path = hostPart, pathPart
+	}
+
+	path = strings.TrimSuffix(strings.Trim(path, "/"), ".git")
+	slash := strings.LastIndex(path, "/")
+	if host == "" || slash <= 0 || slash == len(path)-1 {
+		return "", "", "", fmt.Errorf("remote URL %q does not name an owner and repository", remoteURL)
+	}
+
+	return strings.ToLower(host), path[:slash], path[slash+1:], nil
+}
+
+// GetRepoIdentity parses the default remote's URL into the repository's host, owner and name
+func (m *Manager) GetRepoIdentity() (*RepoIdentity, error) {
+	remoteURL, err := m.gitManager.GetRemoteURL("")
+	if err != nil {
+		return nil, err
+	}
+
+	host, owner, repo, err := ParseRemoteURL(remoteURL)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RepoIdentity{Host: host, Owner: owner, Repo: repo}, nil
+}
+
+// PullRequestURL returns the provider's "create pull request" page for merging branch into base.
+// GitHub and GitLab hosts (including self-hosted ones with the provider in their name) are supported.
+func (r RepoIdentity) PullRequestURL(base, branch string) (string, error) {
+	prURL := url.URL{Scheme: "https", Host: r.Host}
+
+	switch {
+	case strings.Contains(r.Host, "github"):
+		prURL.Path = fmt.Sprintf("/%s/%s/compare/%s...%s", r.Owner, r.Repo, base, branch)
+		prURL.RawQuery = "expand=1"
+	case strings.Contains(r.Host, "gitlab"):
+		prURL.Path = fmt.Sprintf("/%s/%s/-/merge_requests/new", r.Owner, r.Repo)
+		prURL.RawQuery = url.Values{
+			"merge_request[source_branch]": {branch},
+			"merge_request[target_branch]": {base},
+		}.Encode()
+	default:
+		return "", fmt.Errorf("don't know how to open a pull request on %s; only GitHub and GitLab are supported", r.Host)
+	}
+
+	return prURL.String(), nil
+}
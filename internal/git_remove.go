@@ -1,5 +1,15 @@
 package internal
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrWorktreeDirty is returned when git refuses to remove a worktree that has
+// modified or untracked files
+var ErrWorktreeDirty = errors.New("worktree has uncommitted changes")
+
 func (gm *GitManager) RemoveWorktree(worktreePath string) error {
 	if err := execGitCommandRun(gm.repoPath, "worktree", "remove", worktreePath, "--force"); err != nil {
 		return enhanceGitError(err, "worktree remove")
@@ -7,3 +17,17 @@ func (gm *GitManager) RemoveWorktree(worktreePath string) error {
 
 	return nil
 }
+
+// RemoveWorktreeWithoutForce removes a worktree without --force so git refuses
+// to discard uncommitted changes. Returns ErrWorktreeDirty in that case.
+func (gm *GitManager) RemoveWorktreeWithoutForce(worktreePath string) error {
+	output, err := ExecGitCommandCombined(gm.repoPath, "worktree", "remove", worktreePath)
+	if err != nil {
+		if strings.Contains(string(output), "contains modified or untracked files") {
+			return fmt.Errorf("%w: %s", ErrWorktreeDirty, worktreePath)
+		}
+		return fmt.Errorf("git worktree remove failed: %s", strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
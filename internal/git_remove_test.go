@@ -141,6 +141,63 @@ func TestGitManager_RemoveWorktree(t *testing.T) {
 	}
 }
 
+func TestGitManager_RemoveWorktreeWithoutForce(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+
+	must(t, repo.WriteFile(".gitignore", "worktrees/\n"))
+	must(t, repo.CommitChanges("Add .gitignore for worktrees"))
+
+	gitManager, err := NewGitManager(repo.GetLocalPath(), "worktrees")
+	must(t, err)
+
+	tests := []struct {
+		name         string
+		worktreeName string
+		dirty        bool
+		expect       func(t *testing.T, worktreePath string)
+		expectErr    func(t *testing.T, err error)
+	}{
+		{
+			name:         "RemoveCleanWorktree",
+			worktreeName: "clean-test",
+			expect: func(t *testing.T, worktreePath string) {
+				assert.NoDirExists(t, worktreePath)
+				verifyWorktreeRemoved(t, gitManager, "clean-test")
+			},
+			expectErr: func(t *testing.T, err error) { require.NoError(t, err) },
+		},
+		{
+			name:         "RefuseDirtyWorktree",
+			worktreeName: "dirty-safe-test",
+			dirty:        true,
+			expect: func(t *testing.T, worktreePath string) {
+				// Uncommitted work must survive the refused removal
+				assert.FileExists(t, filepath.Join(worktreePath, "uncommitted.txt"))
+			},
+			expectErr: func(t *testing.T, err error) {
+				assert.ErrorIs(t, err, ErrWorktreeDirty)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			must(t, gitManager.AddWorktree(tt.worktreeName, "feature/"+tt.worktreeName, true, ""))
+			worktreePath := filepath.Join(repo.GetLocalPath(), "worktrees", tt.worktreeName)
+			if tt.dirty {
+				must(t, os.WriteFile(filepath.Join(worktreePath, "uncommitted.txt"), []byte("uncommitted content"), 0o644))
+			}
+
+			err := gitManager.RemoveWorktreeWithoutForce(worktreePath)
+			tt.expectErr(t, err)
+			tt.expect(t, worktreePath)
+		})
+	}
+}
+
 // ============================================================================
 // INTEGRATION TESTS for worktreeRemover interface methods
 // ============================================================================
@@ -244,6 +301,59 @@ func TestManager_GetWorktreePath_Integration(t *testing.T) {
 	}
 }
 
+func TestManager_GetWorktreePath_OutOfSyncWithGit(t *testing.T) {
+	manager, repoPath, _ := setupManagerForRemoverTests(t)
+
+	// A directory that git doesn't know about
+	strayPath := filepath.Join(repoPath, "worktrees", "stray")
+	require.NoError(t, os.MkdirAll(strayPath, 0o755))
+
+	_, err := manager.GetWorktreePath("stray")
+	assert.ErrorIs(t, err, ErrWorktreeNotRegistered)
+
+	// A registered worktree whose directory was deleted by hand
+	require.NoError(t, os.RemoveAll(filepath.Join(repoPath, "worktrees", "feat")))
+
+	_, err = manager.GetWorktreePath("feat")
+	assert.ErrorIs(t, err, ErrWorktreeDirectoryMissing)
+	assert.ErrorContains(t, err, "gbm prune")
+
+	err = manager.RemoveWorktree("stray")
+	assert.ErrorIs(t, err, ErrWorktreeNotRegistered)
+	_, statErr := os.Stat(strayPath)
+	assert.NoError(t, statErr, "an unregistered directory must not be deleted")
+}
+
+func TestGitManager_WorktreeExists(t *testing.T) {
+	manager, repoPath, _ := setupManagerForRemoverTests(t)
+	gitManager := manager.GetGitManager()
+
+	require.NoError(t, os.MkdirAll(filepath.Join(repoPath, "worktrees", "stray"), 0o755))
+	require.NoError(t, os.RemoveAll(filepath.Join(repoPath, "worktrees", "feat")))
+
+	tests := []struct {
+		name           string
+		path           string
+		wantRegistered bool
+		wantOnDisk     bool
+	}{
+		{name: "registered worktree", path: filepath.Join(repoPath, "worktrees", "dev"), wantRegistered: true, wantOnDisk: true},
+		{name: "repository root", path: repoPath, wantRegistered: true, wantOnDisk: true},
+		{name: "directory that is not a worktree", path: filepath.Join(repoPath, "worktrees", "stray"), wantOnDisk: true},
+		{name: "registered worktree with missing directory", path: filepath.Join(repoPath, "worktrees", "feat"), wantRegistered: true},
+		{name: "neither", path: filepath.Join(repoPath, "worktrees", "nonexistent")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			registered, onDisk, err := gitManager.WorktreeExists(tt.path)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantRegistered, registered)
+			assert.Equal(t, tt.wantOnDisk, onDisk)
+		})
+	}
+}
+
 func TestManager_GetWorktreeStatus_Integration(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -506,3 +616,21 @@ func TestManager_GetAllWorktrees_RemoverIntegration(t *testing.T) {
 		})
 	}
 }
+
+func TestGitManager_IsBranchAvailable(t *testing.T) {
+	manager, repoPath, _ := setupManagerForRemoverTests(t)
+	must(t, execGitCommandRun(repoPath, "branch", "spare"))
+
+	available, err := manager.GetGitManager().IsBranchAvailable("spare")
+	require.NoError(t, err)
+	assert.True(t, available)
+
+	// Branches checked out in a worktree or the repository root are taken
+	_, err = manager.GetGitManager().IsBranchAvailable("feat")
+	assert.ErrorContains(t, err, "already checked out in worktree feat")
+	_, err = manager.GetGitManager().IsBranchAvailable("main")
+	assert.ErrorContains(t, err, "already checked out")
+
+	_, err = manager.GetGitManager().IsBranchAvailable("does-not-exist")
+	assert.ErrorContains(t, err, "does not exist")
+}
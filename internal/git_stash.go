@@ -0,0 +1,142 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var ErrStashConflict = errors.New("stashed changes could not be restored cleanly")
+
+// StashEntry is one entry of git stash list
+type StashEntry struct {
+	Index     int    // n in stash@{n}
+	Branch    string // branch the stash was created on; empty when created on a detached HEAD
+	Message   string
+	Timestamp time.Time
+}
+
+// Ref returns the stash@{n} name of the entry
+func (s StashEntry) Ref() string {
+	return fmt.Sprintf("stash@{%d}", s.Index)
+}
+
+// StashWorktree stashes all uncommitted changes (including untracked files) in a worktree.
+// Stashes are shared by every worktree of the repository, so the returned ref is the stash
+// commit hash rather than a stash@{n} index that later stashes would shift.
+// Returns an empty ref when there was nothing to stash.
+func (gm *GitManager) StashWorktree(worktreePath, message string) (string, error) {
+	output, err := ExecGitCommandCombined(worktreePath, "stash", "push", "-u", "-m", message)
+	if err != nil {
+		return "", fmt.Errorf("git stash push failed: %s", strings.TrimSpace(string(output)))
+	}
+
+	if strings.Contains(string(output), "No local changes to save") {
+		return "", nil
+	}
+
+	stashRef, err := ExecGitCommand(worktreePath, "rev-parse", "stash@{0}")
+	if err != nil {
+		return "", enhanceGitError(err, "resolve stash")
+	}
+
+	return strings.TrimSpace(string(stashRef)), nil
+}
+
+// PopStash restores a stash created by StashWorktree into the given worktree and drops it.
+// If the changes conflict, the stash is kept and ErrStashConflict is returned.
+func (gm *GitManager) PopStash(worktreePath, stashRef string) error {
+	stashEntry, err := gm.findStashEntry(worktreePath, stashRef)
+	if err != nil {
+		return err
+	}
+
+	output, err := ExecGitCommandCombined(worktreePath, "stash", "pop", stashEntry)
+	if err != nil {
+		if strings.Contains(string(output), "CONFLICT") || strings.Contains(string(output), "stash entry is kept") ||
+			strings.Contains(string(output), "could not restore untracked files") {
+			return fmt.Errorf("%w: %s (stash %s kept)", ErrStashConflict, worktreePath, stashRef)
+		}
+		return fmt.Errorf("git stash pop failed: %s", strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
+
+// findStashEntry maps a stash commit hash to its current stash@{n} entry
+func (gm *GitManager) findStashEntry(worktreePath, stashRef string) (string, error) {
+	output, err := ExecGitCommand(worktreePath, "stash", "list", "--format=%H")
+	if err != nil {
+		return "", enhanceGitError(err, "list stashes")
+	}
+
+	for i, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		if strings.TrimSpace(line) == stashRef {
+			return fmt.Sprintf("stash@{%d}", i), nil
+		}
+	}
+
+	return "", fmt.Errorf("stash %s not found", stashRef)
+}
+
+// GetStashList returns the stashes created on the branch checked out in worktreePath, newest first.
+// Stashes are shared by every worktree of the repository, so entries keep their repository-wide
+// stash@{n} index. A worktree with a detached HEAD has no stashes of its own.
+func (gm *GitManager) GetStashList(worktreePath string) ([]StashEntry, error) {
+	branch, err := gm.GetCurrentBranchInPath(worktreePath)
+	if err != nil {
+		return nil, err
+	}
+	if branch == "HEAD" {
+		return nil, nil
+	}
+
+	output, err := ExecGitCommand(worktreePath, "stash", "list", "--format=%gs%x00%ct")
+	if err != nil {
+		return nil, enhanceGitError(err, "list stashes")
+	}
+
+	var stashes []StashEntry
+	for i, line := range strings.Split(strings.TrimSpace(string(output)), "\n") {
+		if line == "" {
+			continue
+		}
+
+		entry := parseStashEntry(i, line)
+		if entry.Branch == branch {
+			stashes = append(stashes, entry)
+		}
+	}
+
+	return stashes, nil
+}
+
+// parseStashEntry parses a "<subject>\x00<unix time>" line of git stash list. Subjects look like
+// "WIP on <branch>: <hash> <commit subject>" or "On <branch>: <message>".
+func parseStashEntry(index int, line string) StashEntry {
+	entry := StashEntry{Index: index}
+
+	subject, timestamp, _ := strings.Cut(line, "\x00")
+	if seconds, err := strconv.ParseInt(strings.TrimSpace(timestamp), 10, 64); err == nil {
+		entry.Timestamp = time.Unix(seconds, 0)
+	}
+
+	entry.Message = subject
+	rest, ok := strings.CutPrefix(subject, "WIP on ")
+	if !ok {
+		rest, ok = strings.CutPrefix(subject, "On ")
+	}
+	if ok {
+		// Branch names cannot contain ':', so the first ": " ends the branch
+		if branch, message, found := strings.Cut(rest, ": "); found {
+			entry.Message = message
+			if branch != "(no branch)" {
+				entry.Branch = branch
+			}
+		}
+	}
+
+	return entry
+}
@@ -0,0 +1,124 @@
+package internal
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gbm/internal/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitManager_StashWorktree(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+	defer repo.Cleanup()
+
+	gitManager, err := NewGitManager(repo.GetLocalPath(), "worktrees")
+	require.NoError(t, err)
+
+	// Nothing to stash in a clean worktree
+	stashRef, err := gitManager.StashWorktree(repo.GetLocalPath(), "clean")
+	require.NoError(t, err)
+	assert.Empty(t, stashRef)
+
+	must(t, repo.WriteFile("README.md", "# Changed"))
+	must(t, repo.WriteFile("untracked.txt", "untracked"))
+
+	stashRef, err = gitManager.StashWorktree(repo.GetLocalPath(), "gbm test")
+	require.NoError(t, err)
+	assert.NotEmpty(t, stashRef)
+	assert.NoFileExists(t, filepath.Join(repo.GetLocalPath(), "untracked.txt"))
+
+	// A later stash shifts stash@{0}, but the returned ref still identifies the right one
+	must(t, repo.WriteFile("other.txt", "other"))
+	otherRef, err := gitManager.StashWorktree(repo.GetLocalPath(), "other")
+	require.NoError(t, err)
+
+	require.NoError(t, gitManager.PopStash(repo.GetLocalPath(), stashRef))
+	content, err := os.ReadFile(filepath.Join(repo.GetLocalPath(), "README.md"))
+	require.NoError(t, err)
+	assert.Equal(t, "# Changed", string(content))
+	assert.FileExists(t, filepath.Join(repo.GetLocalPath(), "untracked.txt"))
+	assert.NoFileExists(t, filepath.Join(repo.GetLocalPath(), "other.txt"))
+
+	require.NoError(t, gitManager.PopStash(repo.GetLocalPath(), otherRef))
+	assert.FileExists(t, filepath.Join(repo.GetLocalPath(), "other.txt"))
+
+	err = gitManager.PopStash(repo.GetLocalPath(), otherRef)
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestParseStashEntry(t *testing.T) {
+	tests := []struct {
+		line     string
+		expected StashEntry
+	}{
+		{
+			line:     "WIP on feature/auth: 1a2b3c4 Add login form\x001700000000",
+			expected: StashEntry{Index: 1, Branch: "feature/auth", Message: "1a2b3c4 Add login form", Timestamp: time.Unix(1700000000, 0)},
+		},
+		{
+			line:     "On main: try: a different approach\x001700000000",
+			expected: StashEntry{Index: 1, Branch: "main", Message: "try: a different approach", Timestamp: time.Unix(1700000000, 0)},
+		},
+		{
+			line:     "WIP on (no branch): 1a2b3c4 Detached work\x001700000000",
+			expected: StashEntry{Index: 1, Message: "1a2b3c4 Detached work", Timestamp: time.Unix(1700000000, 0)},
+		},
+		{
+			line:     "custom subject\x00",
+			expected: StashEntry{Index: 1, Message: "custom subject"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.line, func(t *testing.T) {
+			assert.Equal(t, tt.expected, parseStashEntry(1, tt.line))
+		})
+	}
+}
+
+func TestManager_GetWorktreeStashes(t *testing.T) {
+	manager, _, _ := setupManagerForRemoverTests(t)
+	devPath, err := manager.GetWorktreePath("dev")
+	require.NoError(t, err)
+	featPath, err := manager.GetWorktreePath("feat")
+	require.NoError(t, err)
+
+	stash := func(path, file, message string) {
+		must(t, os.WriteFile(filepath.Join(path, file), []byte(message), 0o644))
+		_, err := manager.GetGitManager().StashWorktree(path, message)
+		require.NoError(t, err)
+	}
+	stash(devPath, "first.txt", "dev first")
+	stash(featPath, "feat.txt", "feat only")
+	stash(devPath, "second.txt", "dev second")
+
+	stashes, err := manager.GetWorktreeStashes(devPath)
+	require.NoError(t, err)
+	require.Len(t, stashes, 2)
+	assert.Equal(t, "stash@{0}", stashes[0].Ref())
+	assert.Equal(t, "dev second", stashes[0].Message)
+	assert.Equal(t, "stash@{2}", stashes[1].Ref(), "indexes stay repository-wide")
+	assert.Equal(t, "dev first", stashes[1].Message)
+	assert.Equal(t, "dev", stashes[1].Branch)
+	assert.False(t, stashes[1].Timestamp.IsZero())
+
+	stashes, err = manager.GetWorktreeStashes(featPath)
+	require.NoError(t, err)
+	require.Len(t, stashes, 1)
+	assert.Equal(t, "feat only", stashes[0].Message)
+
+	// A detached worktree doesn't claim anyone's stashes
+	_, err = ExecGitCommand(featPath, "checkout", "--detach")
+	require.NoError(t, err)
+	stashes, err = manager.GetWorktreeStashes(featPath)
+	require.NoError(t, err)
+	assert.Empty(t, stashes)
+}
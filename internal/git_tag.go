@@ -0,0 +1,34 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ListTags returns all tags, newest first by creation date
+func (gm *GitManager) ListTags() ([]string, error) {
+	return gm.ListTagsMatching("")
+}
+
+// ListTagsMatching returns the tags matching a git glob pattern (e.g. "v1.*"), newest first by
+// creation date. An empty pattern matches every tag.
+func (gm *GitManager) ListTagsMatching(pattern string) ([]string, error) {
+	args := []string{"tag", "--list", "--sort=-creatordate"}
+	if pattern != "" {
+		args = append(args, pattern)
+	}
+
+	output, err := ExecGitCommand(gm.repoPath, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", enhanceGitError(err, "tag"))
+	}
+
+	var tags []string
+	for line := range strings.SplitSeq(string(output), "\n") {
+		if tag := strings.TrimSpace(line); tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+
+	return tags, nil
+}
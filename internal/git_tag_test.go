@@ -0,0 +1,48 @@
+package internal
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"testing"
+
+	"gbm/internal/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGitManager_ListTags(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+	defer repo.Cleanup()
+
+	gitManager, err := NewGitManager(repo.GetLocalPath(), "worktrees")
+	require.NoError(t, err)
+
+	tags, err := gitManager.ListTags()
+	require.NoError(t, err)
+	assert.Empty(t, tags)
+
+	// Create annotated tags out of name order with increasing tagger dates
+	for i, tag := range []string{"v1.10.0", "v1.2.0", "release-2025-01", "v2.0.0"} {
+		cmd := exec.Command("git", "tag", "-a", tag, "-m", tag)
+		cmd.Dir = repo.GetLocalPath()
+		cmd.Env = append(os.Environ(), fmt.Sprintf("GIT_COMMITTER_DATE=2025-01-%02dT12:00:00Z", i+1))
+		require.NoError(t, cmd.Run())
+	}
+
+	tags, err = gitManager.ListTags()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"v2.0.0", "release-2025-01", "v1.2.0", "v1.10.0"}, tags)
+
+	tags, err = gitManager.ListTagsMatching("v1.*")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"v1.2.0", "v1.10.0"}, tags)
+
+	tags, err = gitManager.ListTagsMatching("nothing-*")
+	require.NoError(t, err)
+	assert.Empty(t, tags)
+}
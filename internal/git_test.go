@@ -1,9 +1,12 @@
 package internal
 
 import (
+	"fmt"
+	"os/exec"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"gbm/internal/testutils"
 
@@ -720,6 +723,111 @@ func TestGitManager_GetCommitHistory(t *testing.T) {
 	}
 }
 
+func TestGitManager_GetCommitHistory_AuthorFilters(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+
+	gitManager, err := NewGitManager(repo.GetLocalPath(), "worktrees")
+	require.NoError(t, err)
+
+	must(t, repo.WriteFile("mine.txt", "mine"))
+	must(t, repo.CommitChanges("My commit"))
+
+	must(t, execGitCommandRun(repo.GetLocalPath(), "checkout", "-b", "other"))
+	must(t, repo.WriteFile("theirs.txt", "theirs"))
+	must(t, execGitCommandRun(repo.GetLocalPath(), "add", "."))
+	must(t, execGitCommandRun(repo.GetLocalPath(), "commit", "--author", "Other Dev <other@example.com>", "-m", "Their commit"))
+	must(t, execGitCommandRun(repo.GetLocalPath(), "checkout", "main"))
+	must(t, execGitCommandRun(repo.GetLocalPath(), "merge", "--no-ff", "-m", "Merge other", "other"))
+
+	commits, err := gitManager.GetCommitHistory("", CommitHistoryOptions{Author: "other@example.com"})
+	require.NoError(t, err)
+	require.Len(t, commits, 1)
+	assert.Equal(t, "Their commit", commits[0].Message)
+
+	commits, err = gitManager.GetCommitHistory("", CommitHistoryOptions{Author: "Test User", NoMerges: true})
+	require.NoError(t, err)
+	for _, commit := range commits {
+		assert.Equal(t, "Test User", commit.Author)
+		assert.NotEqual(t, "Merge other", commit.Message)
+	}
+	assert.Equal(t, "My commit", commits[0].Message)
+
+	args := gitManager.buildGitLogArgs(CommitHistoryOptions{Author: "me@example.com", NoMerges: true})
+	assert.Contains(t, args, "--author=me@example.com")
+	assert.Contains(t, args, "--no-merges")
+}
+
+func TestGitManager_GetCommitHistory_Skip(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+
+	gitManager, err := NewGitManager(repo.GetLocalPath(), "worktrees")
+	require.NoError(t, err)
+
+	for i := 1; i <= 5; i++ {
+		must(t, repo.WriteFile(fmt.Sprintf("file%d.txt", i), "content"))
+		must(t, repo.CommitChanges(fmt.Sprintf("Commit %d", i)))
+	}
+
+	// Newest first: the second page of two is commits 3 and 2
+	commits, err := gitManager.GetCommitHistory("", CommitHistoryOptions{Limit: 2, Skip: 2})
+	require.NoError(t, err)
+	require.Len(t, commits, 2)
+	assert.Equal(t, "Commit 3", commits[0].Message)
+	assert.Equal(t, "Commit 2", commits[1].Message)
+
+	// Skipping past the end returns no commits rather than an error
+	commits, err = gitManager.GetCommitHistory("", CommitHistoryOptions{Limit: 2, Skip: 100})
+	require.NoError(t, err)
+	assert.Empty(t, commits)
+
+	assert.Contains(t, gitManager.buildGitLogArgs(CommitHistoryOptions{Skip: 4}), "--skip=4")
+	assert.NotContains(t, gitManager.buildGitLogArgs(CommitHistoryOptions{}), "--skip=0")
+}
+
+func TestGitManager_GetWorktrees_DetachedAndLocked(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+
+	must(t, repo.WriteFile(".gitignore", "worktrees/\n"))
+	must(t, repo.CommitChanges("Add .gitignore for worktrees"))
+
+	gitManager, err := NewGitManager(repo.GetLocalPath(), "worktrees")
+	require.NoError(t, err)
+
+	must(t, gitManager.AddWorktree("feat", "feature/x", true, ""))
+	featPath := filepath.Join(repo.GetLocalPath(), "worktrees", "feat")
+	must(t, execGitCommandRun(featPath, "checkout", "--detach"))
+	must(t, execGitCommandRun(repo.GetLocalPath(), "worktree", "lock", "--reason", "on a usb drive", featPath))
+
+	head, err := gitManager.GetCommitHashInPath(featPath, "HEAD")
+	require.NoError(t, err)
+
+	worktrees, err := gitManager.GetWorktrees()
+	require.NoError(t, err)
+
+	var feat *WorktreeInfo
+	for _, wt := range worktrees {
+		if wt.Name == "feat" {
+			feat = wt
+		} else {
+			assert.False(t, wt.Detached)
+			assert.False(t, wt.Locked)
+		}
+	}
+	require.NotNil(t, feat)
+	assert.True(t, feat.Detached)
+	assert.True(t, feat.Locked)
+	assert.Equal(t, "(detached@"+head[:7]+")", feat.Branch)
+}
+
 func TestGitManager_GetFileChanges(t *testing.T) {
 	repo := testutils.NewGitTestRepo(t,
 		testutils.WithDefaultBranch("main"),
@@ -823,3 +931,563 @@ func TestGitManager_GetFileChanges(t *testing.T) {
 		})
 	}
 }
+
+func TestGitManager_GetFileChanges_Range(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+	defer repo.Cleanup()
+
+	must(t, repo.CreateBranch("feature", "feature content"))
+	must(t, repo.SwitchToBranch("feature"))
+	must(t, repo.WriteFile("added.txt", "one\ntwo\n"))
+	must(t, repo.CommitChanges("Add file on feature"))
+	must(t, repo.SwitchToBranch("main"))
+
+	// Uncommitted work in the working tree must not show up in a range diff
+	must(t, repo.WriteFile("README.md", "# Modified README"))
+	must(t, repo.WriteFile("staged.txt", "staged"))
+	must(t, execGitCommandRun(repo.GetLocalPath(), "add", "staged.txt"))
+
+	gitManager, err := NewGitManager(repo.GetLocalPath(), "worktrees")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		options FileChangeOptions
+		expect  map[string]string
+	}{
+		{
+			name:    "range diff of incoming commits",
+			options: FileChangeOptions{Range: "main...feature"},
+			expect:  map[string]string{"content.txt": "added", "added.txt": "added"},
+		},
+		{
+			name:    "staged and unstaged are ignored with a range",
+			options: FileChangeOptions{Range: "main...feature", Staged: true, Unstaged: true},
+			expect:  map[string]string{"content.txt": "added", "added.txt": "added"},
+		},
+		{
+			name:    "empty range",
+			options: FileChangeOptions{Range: "feature...feature"},
+			expect:  map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes, err := gitManager.GetFileChanges("", tt.options)
+			require.NoError(t, err)
+
+			got := make(map[string]string)
+			for _, change := range changes {
+				got[change.Path] = change.Status
+			}
+			assert.Equal(t, tt.expect, got)
+		})
+	}
+
+	t.Run("line counts", func(t *testing.T) {
+		changes, err := gitManager.GetFileChanges("", FileChangeOptions{Range: "main..feature"})
+		require.NoError(t, err)
+		for _, change := range changes {
+			if change.Path == "added.txt" {
+				assert.Equal(t, 2, change.Additions)
+				assert.Equal(t, 0, change.Deletions)
+			}
+		}
+	})
+
+	t.Run("invalid range", func(t *testing.T) {
+		_, err := gitManager.GetFileChanges("", FileChangeOptions{Range: "main..does-not-exist"})
+		assert.Error(t, err)
+	})
+}
+
+func TestGitManager_GetAheadBehindRefs(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+	defer repo.Cleanup()
+
+	must(t, repo.CreateBranch("feature", "feature content"))
+	must(t, repo.WriteFile("main.txt", "main content"))
+	must(t, repo.CommitChanges("Advance main"))
+
+	gitManager, err := NewGitManager(repo.GetLocalPath(), "worktrees")
+	require.NoError(t, err)
+
+	ahead, behind, err := gitManager.GetAheadBehindRefs("feature", "main")
+	require.NoError(t, err)
+	assert.Equal(t, 1, ahead)
+	assert.Equal(t, 1, behind)
+
+	ahead, behind, err = gitManager.GetAheadBehindRefs("main", "main")
+	require.NoError(t, err)
+	assert.Equal(t, 0, ahead)
+	assert.Equal(t, 0, behind)
+
+	_, _, err = gitManager.GetAheadBehindRefs("does-not-exist", "main")
+	assert.Error(t, err)
+}
+
+func TestGitManager_GetAheadBehindAgainst(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+	defer repo.Cleanup()
+
+	must(t, repo.CreateBranch("feature", "feature content"))
+	must(t, repo.WriteFile("main.txt", "main content"))
+	must(t, repo.CommitChanges("Advance main"))
+	must(t, repo.WriteFile("more.txt", "more main content"))
+	must(t, repo.CommitChanges("Advance main again"))
+	must(t, repo.SwitchToBranch("feature"))
+
+	gitManager, err := NewGitManager(repo.GetLocalPath(), "worktrees")
+	require.NoError(t, err)
+
+	// No upstream is configured, which GetAheadBehindCount would report as 0/0
+	ahead, behind, err := gitManager.GetAheadBehindAgainst(repo.GetLocalPath(), "main")
+	require.NoError(t, err)
+	assert.Equal(t, 1, ahead)
+	assert.Equal(t, 2, behind)
+
+	ahead, behind, err = gitManager.GetAheadBehindAgainst(repo.GetLocalPath(), "feature")
+	require.NoError(t, err)
+	assert.Equal(t, 0, ahead)
+	assert.Equal(t, 0, behind)
+
+	_, _, err = gitManager.GetAheadBehindAgainst(repo.GetLocalPath(), "does-not-exist")
+	assert.Error(t, err)
+}
+
+func TestGitManager_IsAncestor(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+	defer repo.Cleanup()
+
+	must(t, repo.CreateBranch("feature", "feature content"))
+
+	gitManager, err := NewGitManager(repo.GetLocalPath(), "worktrees")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name          string
+		maybeAncestor string
+		ref           string
+		expected      bool
+	}{
+		{name: "base is an ancestor of a branch built on it", maybeAncestor: "main", ref: "feature", expected: true},
+		{name: "branch with new commits is not an ancestor", maybeAncestor: "feature", ref: "main", expected: false},
+		{name: "ref is its own ancestor", maybeAncestor: "main", ref: "main", expected: true},
+		{name: "remote-tracking refs", maybeAncestor: "origin/main", ref: "origin/feature", expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			isAncestor, err := gitManager.IsAncestor(tt.maybeAncestor, tt.ref)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, isAncestor)
+
+			isAncestor, err = gitManager.IsAncestorInPath(repo.GetLocalPath(), tt.maybeAncestor, tt.ref)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, isAncestor)
+		})
+	}
+
+	t.Run("unknown ref is an error", func(t *testing.T) {
+		_, err := gitManager.IsAncestor("does-not-exist", "main")
+		assert.Error(t, err)
+	})
+}
+
+func TestGitManager_DefaultRemote(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+	defer repo.Cleanup()
+
+	// Set up a second "upstream" remote holding a branch that origin does not have
+	upstreamDir := filepath.Join(t.TempDir(), "upstream.git")
+	must(t, execGitCommandRun("", "init", "--bare", upstreamDir))
+	must(t, execGitCommandRun(repo.GetLocalPath(), "remote", "add", "upstream", upstreamDir))
+	must(t, execGitCommandRun(repo.GetLocalPath(), "branch", "feature/upstream-only"))
+	must(t, execGitCommandRun(repo.GetLocalPath(), "push", "upstream", "feature/upstream-only"))
+	must(t, execGitCommandRun(repo.GetLocalPath(), "branch", "-D", "feature/upstream-only"))
+
+	gitManager, err := NewGitManager(repo.GetLocalPath(), "worktrees")
+	require.NoError(t, err)
+
+	assert.Equal(t, "origin", gitManager.GetDefaultRemote())
+	assert.Equal(t, "upstream/main", RemoteFor("upstream", "main"))
+	assert.Equal(t, "origin/main", Remote("main"))
+
+	require.NoError(t, gitManager.FetchRemote("upstream"))
+
+	exists, err := gitManager.BranchExistsLocalOrRemote("feature/upstream-only")
+	require.NoError(t, err)
+	assert.False(t, exists, "branch should not be found on origin")
+
+	gitManager.SetDefaultRemote("upstream")
+	exists, err = gitManager.BranchExistsLocalOrRemote("feature/upstream-only")
+	require.NoError(t, err)
+	assert.True(t, exists, "branch should be found on configured upstream remote")
+
+	gitManager.SetDefaultRemote("")
+	assert.Equal(t, "origin", gitManager.GetDefaultRemote())
+
+	err = gitManager.FetchRemote("does-not-exist")
+	assert.ErrorContains(t, err, "failed to fetch from remote 'does-not-exist'")
+}
+
+func TestGitManager_GetWorktreeStatus_Renames(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+	defer repo.Cleanup()
+
+	gitManager, err := NewGitManager(repo.GetLocalPath(), "worktrees")
+	require.NoError(t, err)
+
+	must(t, execGitCommandRun(repo.GetLocalPath(), "mv", "README.md", "DOCS.md"))
+	must(t, repo.WriteFile("notes.txt", "scratch"))
+
+	status, err := gitManager.GetWorktreeStatus(repo.GetLocalPath())
+	require.NoError(t, err)
+
+	assert.True(t, status.IsDirty)
+	assert.Equal(t, 1, status.Renamed)
+	assert.Equal(t, 1, status.Staged)
+	assert.Equal(t, 0, status.Modified)
+	assert.Equal(t, 1, status.Untracked)
+	assert.True(t, status.HasChanges())
+	assert.Contains(t, gitManager.GetStatusIcon(status), "»")
+	assert.Contains(t, FormatGitStatus(status), "»")
+
+	assert.True(t, (&GitStatus{Copied: 1}).HasChanges())
+}
+
+func TestGitManager_GetWorktreeStatus_Conflicts(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+	defer repo.Cleanup()
+
+	gitManager, err := NewGitManager(repo.GetLocalPath(), "worktrees")
+	require.NoError(t, err)
+
+	// Both branches change the same file, and both add a file with different contents
+	must(t, repo.CreateBranch("feature", "feature content"))
+	must(t, repo.SwitchToBranch("feature"))
+	must(t, repo.WriteFile("README.md", "feature readme"))
+	must(t, repo.WriteFile("both.txt", "feature"))
+	must(t, repo.CommitChanges("Feature changes"))
+	must(t, repo.SwitchToBranch("main"))
+	must(t, repo.WriteFile("README.md", "main readme"))
+	must(t, repo.WriteFile("both.txt", "main"))
+	must(t, repo.CommitChanges("Main changes"))
+
+	err = execGitCommandRun(repo.GetLocalPath(), "merge", "feature")
+	require.Error(t, err, "merge should stop on conflicts")
+
+	status, err := gitManager.GetWorktreeStatus(repo.GetLocalPath())
+	require.NoError(t, err)
+
+	assert.Equal(t, 2, status.Unmerged)
+	assert.True(t, status.IsDirty)
+	assert.True(t, status.HasChanges())
+	assert.Contains(t, gitManager.GetStatusIcon(status), GetGlobalIconManager().GitConflict())
+	assert.Contains(t, FormatGitStatus(status), GetGlobalIconManager().GitConflict())
+
+	assert.True(t, (&GitStatus{Unmerged: 1}).HasChanges())
+}
+
+func TestParseStatusPorcelainV2(t *testing.T) {
+	tests := []struct {
+		name              string
+		output            string
+		expected          GitStatus
+		expectedUntracked []string
+	}{
+		{
+			name: "clean branch without upstream",
+			output: "# branch.oid 1234567890abcdef\n" +
+				"# branch.head main\n",
+			expected: GitStatus{},
+		},
+		{
+			name: "ahead and behind upstream",
+			output: "# branch.oid 1234567890abcdef\n" +
+				"# branch.head feature\n" +
+				"# branch.upstream origin/feature\n" +
+				"# branch.ab +3 -2\n",
+			expected: GitStatus{Ahead: 3, Behind: 2},
+		},
+		{
+			name: "changes of every kind",
+			output: "# branch.oid 1234567890abcdef\n" +
+				"# branch.head main\n" +
+				"# branch.upstream origin/main\n" +
+				"# branch.ab +0 -1\n" +
+				"1 .M N... 100644 100644 100644 abc abc modified.go\n" +
+				"1 A. N... 000000 100644 100644 000 abc added.go\n" +
+				"1 MD N... 100644 100644 000000 abc def staged-then-deleted.go\n" +
+				"2 R. N... 100644 100644 100644 abc abc R100 new.go\told.go\n" +
+				"2 C. N... 100644 100644 100644 abc abc C75 copy.go\tsource.go\n" +
+				"u UU N... 100644 100644 100644 100644 abc def ghi conflict.go\n" +
+				"? untracked.txt\n",
+			expected:          GitStatus{IsDirty: true, Behind: 1, Untracked: 1, Modified: 2, Staged: 4, Renamed: 1, Copied: 1, Unmerged: 1},
+			expectedUntracked: []string{"untracked.txt"},
+		},
+		{
+			name: "paths with spaces, quotes and unicode",
+			output: "# branch.oid 1234567890abcdef\n" +
+				"1 .M N... 100644 100644 100644 abc abc my notes.md\n" +
+				"2 R. N... 100644 100644 100644 abc abc R100 new name.go\told name.go\n" +
+				"? \"caf\\303\\251 menu.txt\"\n" +
+				"? \"say \\\"hi\\\".txt\"\n" +
+				"? build output/\n",
+			expected:          GitStatus{IsDirty: true, Untracked: 3, Modified: 1, Staged: 1, Renamed: 1},
+			expectedUntracked: []string{"café menu.txt", `say "hi".txt`, "build output/"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, untracked := parseStatusPorcelainV2(tt.output)
+			assert.Equal(t, &tt.expected, status)
+			assert.Equal(t, tt.expectedUntracked, untracked)
+		})
+	}
+}
+
+func TestGitManager_GetWorktreeStatus_UntrackedPaths(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+	defer repo.Cleanup()
+
+	gitManager, err := NewGitManager(repo.GetLocalPath(), "worktrees")
+	require.NoError(t, err)
+
+	must(t, repo.WriteFile("my notes.txt", "spaces"))
+	must(t, repo.WriteFile("café.txt", "unicode"))
+	must(t, repo.WriteFile("new dir/one.txt", "1"))
+	must(t, repo.WriteFile("new dir/nested/two.txt", "2"))
+	must(t, repo.WriteFile("README.md", "changed"))
+
+	count, err := gitManager.CountUntrackedPrecisely(repo.GetLocalPath())
+	require.NoError(t, err)
+	assert.Equal(t, 4, count)
+
+	// The untracked directory counts each file inside it, not as a single entry
+	status, err := gitManager.GetWorktreeStatus(repo.GetLocalPath())
+	require.NoError(t, err)
+	assert.Equal(t, 4, status.Untracked)
+	assert.Equal(t, 1, status.Modified)
+	assert.True(t, status.IsDirty)
+}
+
+func TestGitManager_GetWorktreeStatus_AheadBehind(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+	defer repo.Cleanup()
+
+	gitManager, err := NewGitManager(repo.GetLocalPath(), "worktrees")
+	require.NoError(t, err)
+
+	must(t, repo.WriteFile("ahead.txt", "ahead"))
+	must(t, repo.CommitChanges("Local commit"))
+
+	status, err := gitManager.GetWorktreeStatus(repo.GetLocalPath())
+	require.NoError(t, err)
+
+	ahead, behind, err := gitManager.GetAheadBehindCount(repo.GetLocalPath())
+	require.NoError(t, err)
+	assert.Equal(t, 1, ahead)
+	assert.Equal(t, ahead, status.Ahead)
+	assert.Equal(t, behind, status.Behind)
+	assert.False(t, status.HasChanges())
+}
+
+func TestGitManager_GetMergeBase(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+	defer repo.Cleanup()
+
+	gitManager, err := NewGitManager(repo.GetLocalPath(), "worktrees")
+	require.NoError(t, err)
+
+	mainHash, err := gitManager.GetCommitHash("main")
+	require.NoError(t, err)
+
+	must(t, execGitCommandRun(repo.GetLocalPath(), "checkout", "-b", "feature/diverged"))
+	must(t, repo.WriteFile("feature.txt", "feature"))
+	must(t, repo.CommitChanges("Feature commit"))
+
+	mergeBase, err := gitManager.GetMergeBase("feature/diverged", "main")
+	require.NoError(t, err)
+	assert.Equal(t, mainHash, mergeBase)
+
+	mergeBase, err = gitManager.GetMergeBaseInPath(repo.GetLocalPath(), "HEAD", "main")
+	require.NoError(t, err)
+	assert.Equal(t, mainHash, mergeBase)
+
+	mergeBase, divergedAt, err := gitManager.GetMergeBaseWithTime(repo.GetLocalPath(), "HEAD", "main")
+	require.NoError(t, err)
+	assert.Equal(t, mainHash, mergeBase)
+	assert.WithinDuration(t, time.Now(), divergedAt, time.Hour)
+
+	_, err = gitManager.GetMergeBase("HEAD", "does-not-exist")
+	assert.Error(t, err)
+
+	// Unrelated history has no common ancestor
+	must(t, execGitCommandRun(repo.GetLocalPath(), "checkout", "--orphan", "unrelated"))
+	must(t, repo.WriteFile("unrelated.txt", "unrelated"))
+	must(t, repo.CommitChanges("Unrelated commit"))
+
+	mergeBase, err = gitManager.GetMergeBase("unrelated", "main")
+	require.NoError(t, err)
+	assert.Empty(t, mergeBase)
+
+	mergeBase, divergedAt, err = gitManager.GetMergeBaseWithTime(repo.GetLocalPath(), "HEAD", "main")
+	require.NoError(t, err)
+	assert.Empty(t, mergeBase)
+	assert.True(t, divergedAt.IsZero())
+}
+
+func TestGitManager_BranchExists(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+	defer repo.Cleanup()
+
+	must(t, repo.CreateBranch("feature/remote-only", "remote content"))
+	must(t, execGitCommandRun(repo.GetLocalPath(), "checkout", "main"))
+	must(t, execGitCommandRun(repo.GetLocalPath(), "branch", "-D", "feature/remote-only"))
+	must(t, execGitCommandRun(repo.GetLocalPath(), "branch", "local-only"))
+
+	gitManager, err := NewGitManager(repo.GetLocalPath(), "worktrees")
+	require.NoError(t, err)
+
+	tests := []struct {
+		branch      string
+		expectAny   bool
+		expectLocal bool
+	}{
+		{branch: "main", expectAny: true, expectLocal: true},
+		{branch: "local-only", expectAny: true, expectLocal: true},
+		{branch: "feature/remote-only", expectAny: true, expectLocal: false},
+		{branch: "feature", expectAny: false, expectLocal: false},
+		{branch: "does-not-exist", expectAny: false, expectLocal: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.branch, func(t *testing.T) {
+			exists, err := gitManager.BranchExists(tt.branch)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectAny, exists)
+
+			exists, err = gitManager.BranchExistsLocal(tt.branch)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectLocal, exists)
+		})
+	}
+}
+
+func TestGitManager_GetDefaultBranch_CacheAndSetRemoteHead(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+	defer repo.Cleanup()
+
+	// The remote's default branch is develop, but the clone has no origin/HEAD
+	must(t, repo.CreateBranch("develop", "develop content"))
+	must(t, execGitCommandRun(repo.GetRemotePath(), "symbolic-ref", "HEAD", "refs/heads/develop"))
+	must(t, execGitCommandRun(repo.GetLocalPath(), "remote", "set-head", "origin", "--delete"))
+
+	gitManager, err := NewGitManager(repo.GetLocalPath(), "worktrees")
+	require.NoError(t, err)
+
+	defaultBranch, err := gitManager.GetDefaultBranch()
+	require.NoError(t, err)
+	assert.Equal(t, "main", defaultBranch, "falls back to probing common names")
+
+	// The probed answer is cached, so a later change to origin/HEAD isn't seen...
+	must(t, execGitCommandRun(repo.GetLocalPath(), "symbolic-ref", "refs/remotes/origin/HEAD", "refs/remotes/origin/develop"))
+	defaultBranch, err = gitManager.GetDefaultBranch()
+	require.NoError(t, err)
+	assert.Equal(t, "main", defaultBranch)
+
+	// ...until SetRemoteHead refreshes it from the remote
+	must(t, execGitCommandRun(repo.GetLocalPath(), "remote", "set-head", "origin", "--delete"))
+	require.NoError(t, gitManager.SetRemoteHead())
+
+	output, err := ExecGitCommand(repo.GetLocalPath(), "symbolic-ref", "refs/remotes/origin/HEAD")
+	require.NoError(t, err)
+	assert.Equal(t, "refs/remotes/origin/develop", strings.TrimSpace(string(output)))
+
+	defaultBranch, err = gitManager.GetDefaultBranch()
+	require.NoError(t, err)
+	assert.Equal(t, "develop", defaultBranch)
+
+	gitManager.SetDefaultRemote("missing")
+	assert.ErrorContains(t, gitManager.SetRemoteHead(), "failed to set missing/HEAD")
+}
+
+// BenchmarkGitManager_BranchExists looks up a branch in a repository with thousands of remote refs
+func BenchmarkGitManager_BranchExists(b *testing.B) {
+	repoPath := b.TempDir()
+	for _, args := range [][]string{
+		{"init", "--initial-branch=main"},
+		{"-c", "user.name=Bench", "-c", "user.email=bench@example.com", "commit", "--allow-empty", "-m", "initial"},
+	} {
+		if err := execGitCommandRun(repoPath, args...); err != nil {
+			b.Fatal(err)
+		}
+	}
+
+	head, err := ExecGitCommand(repoPath, "rev-parse", "HEAD")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	var updates strings.Builder
+	for i := range 5000 {
+		fmt.Fprintf(&updates, "create refs/remotes/origin/feature/branch-%d %s\n", i, strings.TrimSpace(string(head)))
+	}
+	cmd := exec.Command("git", "update-ref", "--stdin")
+	cmd.Dir = repoPath
+	cmd.Stdin = strings.NewReader(updates.String())
+	if err := cmd.Run(); err != nil {
+		b.Fatal(err)
+	}
+
+	gitManager, err := NewGitManager(repoPath, "worktrees")
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for range b.N {
+		if _, err := gitManager.BranchExists("does-not-exist"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package internal
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+)
+
+// SetSkipHooks disables the [hooks] commands for worktrees created or removed by this manager
+func (m *Manager) SetSkipHooks(skip bool) {
+	m.skipHooks = skip
+}
+
+// runPostCreateHooks runs the post_create commands in a new worktree. A failing hook is reported
+// as a warning, but the worktree itself is kept.
+func (m *Manager) runPostCreateHooks(worktreeName string) {
+	worktreePath := filepath.Join(m.repoPath, m.config.Settings.WorktreePrefix, worktreeName)
+
+	branch, err := m.gitManager.GetCurrentBranchInPath(worktreePath)
+	if err != nil || branch == "HEAD" {
+		branch = "" // Detached HEAD
+	}
+
+	env := hookEnv(worktreeName, worktreePath, "GBM_BRANCH="+branch)
+	if err := m.runHooks("post_create", m.config.Hooks.PostCreate, worktreePath, env); err != nil {
+		logWarn("%v", err)
+	}
+}
+
+// runPreRemoveHooks runs the pre_remove commands in a worktree that is about to be removed
+func (m *Manager) runPreRemoveHooks(worktreeName, worktreePath string) error {
+	return m.runHooks("pre_remove", m.config.Hooks.PreRemove, worktreePath, hookEnv(worktreeName, worktreePath))
+}
+
+// runPostRemoveHooks runs the post_remove commands from the repository root, since the worktree
+// directory no longer exists. Failures are reported as warnings.
+func (m *Manager) runPostRemoveHooks(worktreeName, worktreePath string) {
+	if err := m.runHooks("post_remove", m.config.Hooks.PostRemove, m.repoPath, hookEnv(worktreeName, worktreePath)); err != nil {
+		logWarn("%v", err)
+	}
+}
+
+func hookEnv(worktreeName, worktreePath string, extra ...string) []string {
+	env := append(os.Environ(),
+		"GBM_WORKTREE_NAME="+worktreeName,
+		"GBM_WORKTREE_PATH="+worktreePath,
+	)
+	return append(env, extra...)
+}
+
+// runHooks runs each command through the shell in dir. Output is streamed to stderr so stdout
+// stays clean for scripting. The first failing command stops the remaining ones.
+func (m *Manager) runHooks(kind string, commands []string, dir string, env []string) error {
+	if m.skipHooks {
+		return nil
+	}
+
+	for i, command := range commands {
+		logInfo("Running %s hook: %s", kind, command)
+
+		cmd := exec.Command("sh", "-c", command)
+		cmd.Dir = dir
+		cmd.Env = env
+		cmd.Stdout = os.Stderr
+		cmd.Stderr = os.Stderr
+
+		if err := cmd.Run(); err != nil {
+			if remaining := len(commands) - i - 1; remaining > 0 {
+				return fmt.Errorf("%s hook '%s' failed: %w (skipped %d remaining hook(s))", kind, command, err, remaining)
+			}
+			return fmt.Errorf("%s hook '%s' failed: %w", kind, command, err)
+		}
+	}
+
+	return nil
+}
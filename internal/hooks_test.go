@@ -0,0 +1,68 @@
+package internal
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManager_PostCreateHooks(t *testing.T) {
+	manager, repoPath, _ := setupManagerForRemoverTests(t)
+	manager.GetConfig().Hooks.PostCreate = []string{
+		`echo "$GBM_WORKTREE_NAME $GBM_BRANCH $(basename "$GBM_WORKTREE_PATH")" > hook.txt`,
+		"exit 3",
+		"touch never.txt",
+	}
+
+	// A failing hook warns, skips the rest and keeps the worktree
+	require.NoError(t, manager.AddWorktree("hooked", "feature/hooked", true, "main"))
+
+	worktreePath := filepath.Join(repoPath, "worktrees", "hooked")
+	content, err := os.ReadFile(filepath.Join(worktreePath, "hook.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "hooked feature/hooked hooked\n", string(content))
+	assert.NoFileExists(t, filepath.Join(worktreePath, "never.txt"))
+	verifyWorktreeLinked(t, manager.GetGitManager(), "hooked", "feature/hooked")
+
+	// Hooks can be skipped
+	manager.SetSkipHooks(true)
+	require.NoError(t, manager.AddWorktree("unhooked", "feature/unhooked", true, "main"))
+	assert.NoFileExists(t, filepath.Join(repoPath, "worktrees", "unhooked", "hook.txt"))
+}
+
+func TestManager_RemoveHooks(t *testing.T) {
+	manager, repoPath, _ := setupManagerForRemoverTests(t)
+	manager.GetConfig().Hooks.PreRemove = []string{`echo "$GBM_WORKTREE_NAME" > "$GBM_WORKTREE_PATH.pre"`, "exit 1"}
+	manager.GetConfig().Hooks.PostRemove = []string{`echo "$GBM_WORKTREE_NAME $(basename "$GBM_WORKTREE_PATH")" > post.txt`}
+	devPath := filepath.Join(repoPath, "worktrees", "dev")
+
+	// A failing pre_remove hook aborts a normal removal
+	err := manager.RemoveWorktreeWithoutForce("dev")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "pre_remove hook 'exit 1' failed")
+	assert.DirExists(t, devPath)
+	assert.FileExists(t, devPath+".pre")
+	assert.NoFileExists(t, filepath.Join(repoPath, "post.txt"))
+
+	// Archived removal discards changes but still respects the hook
+	require.NoError(t, os.WriteFile(filepath.Join(devPath, "dirty.txt"), []byte("dirty"), 0o644))
+	err = manager.RemoveArchivedWorktree("dev")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "pre_remove hook 'exit 1' failed")
+	assert.DirExists(t, devPath)
+
+	// Forced removal proceeds and runs post_remove from the repository root
+	require.NoError(t, manager.RemoveWorktree("dev"))
+	assert.NoDirExists(t, devPath)
+	content, err := os.ReadFile(filepath.Join(repoPath, "post.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "dev dev\n", string(content))
+
+	// Hooks can be skipped
+	manager.SetSkipHooks(true)
+	require.NoError(t, manager.RemoveWorktreeWithoutForce("feat"))
+	assert.NoFileExists(t, filepath.Join(repoPath, "worktrees", "feat.pre"))
+}
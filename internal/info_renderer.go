@@ -216,12 +216,17 @@ func (r *InfoRenderer) renderGitSection(data *WorktreeInfoData) string {
 		if data.BaseInfo.Name != "" {
 			content.WriteString(r.renderKeyValue("Base Branch", data.BaseInfo.Name))
 		}
+		if data.BaseInfo.Name != "" && data.BaseInfo.DivergedAt != "" {
+			diverged := fmt.Sprintf("diverged from %s %s (%s)",
+				data.BaseInfo.Name, r.formatDaysAgo(data.BaseInfo.DaysAgo), data.BaseInfo.DivergedAt)
+			content.WriteString(r.renderKeyValue("Diverged", diverged))
+		}
 		if data.BaseInfo.Upstream != "" {
 			content.WriteString(r.renderKeyValue("Upstream", data.BaseInfo.Upstream))
 		}
 		if data.BaseInfo.AheadBy > 0 || data.BaseInfo.BehindBy > 0 {
-			position := fmt.Sprintf("↑ %d commits ahead, ↓ %d commits behind",
-				data.BaseInfo.AheadBy, data.BaseInfo.BehindBy)
+			position := fmt.Sprintf("↑ %d commits ahead of %s, ↓ %d commits behind",
+				data.BaseInfo.AheadBy, data.BaseInfo.Name, data.BaseInfo.BehindBy)
 			content.WriteString(r.renderKeyValue("Position", position))
 		}
 	}
@@ -267,6 +272,18 @@ func (r *InfoRenderer) renderGitSection(data *WorktreeInfoData) string {
 		}
 	}
 
+	// Stashes made on this worktree's branch
+	if len(data.Stashes) > 0 {
+		content.WriteString("Stashes:\n")
+		for _, stash := range data.Stashes {
+			line := fmt.Sprintf("  %s %s", stash.Ref(), stash.Message)
+			if !stash.Timestamp.IsZero() {
+				line += fmt.Sprintf(" (%s ago)", FormatDuration(time.Since(stash.Timestamp)))
+			}
+			content.WriteString(line + "\n")
+		}
+	}
+
 	// Recent commits list
 	if len(data.Commits) > 1 {
 		content.WriteString("Recent Commits:\n")
@@ -311,6 +328,17 @@ func (r *InfoRenderer) formatGitStatus(status *GitStatus) string {
 	return "🟢 CLEAN"
 }
 
+func (r *InfoRenderer) formatDaysAgo(days int) string {
+	switch days {
+	case 0:
+		return "today"
+	case 1:
+		return "1 day ago"
+	default:
+		return fmt.Sprintf("%d days ago", days)
+	}
+}
+
 func (r *InfoRenderer) getStatusIcon(status string) string {
 	switch status {
 	case "A":
@@ -108,7 +108,7 @@ func getJiraUser(manager *Manager) (string, error) {
 	// Save the updated config
 	if saveErr := manager.SaveConfig(); saveErr != nil {
 		// Log warning but don't fail the operation
-		fmt.Printf("Warning: failed to save JIRA user to config: %v\n", saveErr)
+		logWarn("failed to save JIRA user to config: %v", saveErr)
 	}
 
 	return user, nil
@@ -413,7 +413,12 @@ func formatJiraURL(selfURL, key string) string {
 	// Self URL format: https://company.atlassian.net/rest/api/2/issue/12345
 	if strings.Contains(selfURL, "/rest/api/") {
 		baseURL := strings.Split(selfURL, "/rest/api/")[0]
-		return fmt.Sprintf("%s/browse/%s", baseURL, key)
+		return jiraBrowseURL(baseURL, key)
 	}
 	return ""
 }
+
+// jiraBrowseURL returns the web link for a ticket on the JIRA site at baseURL
+func jiraBrowseURL(baseURL, key string) string {
+	return fmt.Sprintf("%s/browse/%s", strings.TrimRight(baseURL, "/"), key)
+}
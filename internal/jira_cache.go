@@ -0,0 +1,70 @@
+package internal
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// jiraCacheEntry is the on-disk form of a cached ticket, one file per ticket key
+type jiraCacheEntry struct {
+	FetchedAt time.Time          `json:"fetched_at"`
+	Ticket    *JiraTicketDetails `json:"ticket"`
+}
+
+func jiraCachePath(cacheDir, jiraKey string) string {
+	return filepath.Join(cacheDir, jiraKey+".json")
+}
+
+// readJiraCache returns the cached ticket for jiraKey if it was fetched less than ttl ago
+func readJiraCache(cacheDir, jiraKey string, ttl time.Duration, now time.Time) (*JiraTicketDetails, bool) {
+	data, err := os.ReadFile(jiraCachePath(cacheDir, jiraKey))
+	if err != nil {
+		return nil, false
+	}
+
+	var entry jiraCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil || entry.Ticket == nil {
+		logVerbose("Ignoring unreadable JIRA cache entry for %s", jiraKey)
+		return nil, false
+	}
+
+	if now.Sub(entry.FetchedAt) >= ttl {
+		return nil, false
+	}
+	return entry.Ticket, true
+}
+
+// writeJiraCache stores ticket under jiraKey. The file is written to a temporary name and
+// renamed so concurrent `gbm info --all` lookups never read a partial entry.
+func writeJiraCache(cacheDir, jiraKey string, ticket *JiraTicketDetails, now time.Time) error {
+	if err := os.MkdirAll(cacheDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create JIRA cache directory: %w", err)
+	}
+
+	data, err := json.Marshal(jiraCacheEntry{FetchedAt: now, Ticket: ticket})
+	if err != nil {
+		return fmt.Errorf("failed to encode JIRA cache entry: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(cacheDir, jiraKey+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to write JIRA cache entry: %w", err)
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("failed to write JIRA cache entry: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write JIRA cache entry: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), jiraCachePath(cacheDir, jiraKey)); err != nil {
+		return fmt.Errorf("failed to write JIRA cache entry: %w", err)
+	}
+	return nil
+}
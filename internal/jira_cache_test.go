@@ -0,0 +1,71 @@
+package internal
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gbm/internal/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJiraCache(t *testing.T) {
+	cacheDir := filepath.Join(t.TempDir(), DefaultJiraCacheDirname)
+	fetchedAt := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	ticket := &JiraTicketDetails{
+		Key:     "PROJ-123",
+		Summary: "Fix login",
+		Status:  "In Progress",
+		Created: time.Date(2025, 2, 1, 9, 0, 0, 0, time.UTC),
+		LatestComment: &Comment{
+			Author:  "Jane",
+			Content: "Looks good",
+		},
+	}
+
+	_, ok := readJiraCache(cacheDir, "PROJ-123", time.Hour, fetchedAt)
+	assert.False(t, ok, "missing entry is a miss")
+
+	require.NoError(t, writeJiraCache(cacheDir, "PROJ-123", ticket, fetchedAt))
+
+	cached, ok := readJiraCache(cacheDir, "PROJ-123", time.Hour, fetchedAt.Add(59*time.Minute))
+	require.True(t, ok)
+	assert.Equal(t, ticket, cached)
+
+	_, ok = readJiraCache(cacheDir, "PROJ-123", time.Hour, fetchedAt.Add(time.Hour))
+	assert.False(t, ok, "entry older than the TTL is a miss")
+
+	_, ok = readJiraCache(cacheDir, "PROJ-456", time.Hour, fetchedAt)
+	assert.False(t, ok, "entries are keyed by ticket")
+
+	entries, err := os.ReadDir(cacheDir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "no temporary files are left behind")
+
+	require.NoError(t, os.WriteFile(jiraCachePath(cacheDir, "PROJ-789"), []byte("not json"), 0o644))
+	_, ok = readJiraCache(cacheDir, "PROJ-789", time.Hour, fetchedAt)
+	assert.False(t, ok, "corrupt entry is a miss")
+}
+
+func TestManager_GetJiraTicketDetails_UsesCache(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+
+	manager, err := NewManager(repo.GetLocalPath())
+	require.NoError(t, err)
+	manager.GetConfig().Jira.BaseURL = "https://acme.atlassian.net"
+
+	cacheDir := filepath.Join(manager.gbmDir, DefaultJiraCacheDirname)
+	require.NoError(t, writeJiraCache(cacheDir, "PROJ-123", &JiraTicketDetails{Key: "PROJ-123", Summary: "Cached"}, time.Now()))
+
+	// A fresh entry is served without calling the JIRA CLI
+	ticket, err := manager.GetJiraTicketDetails("PROJ-123")
+	require.NoError(t, err)
+	assert.Equal(t, "Cached", ticket.Summary)
+	assert.Equal(t, "https://acme.atlassian.net/browse/PROJ-123", ticket.URL)
+}
@@ -1,6 +1,8 @@
 package internal
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"gbm/internal/testutils"
@@ -89,3 +91,86 @@ func TestManager_GetSyncStatus_Integration(t *testing.T) {
 	assert.Empty(t, status.OrphanedWorktrees)
 	assert.Empty(t, status.BranchChanges)
 }
+
+func TestManager_GetAllWorktreesWithConcurrency_Integration(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+
+	require.NoError(t, repo.WriteFile(".gitignore", "worktrees/\n"))
+	require.NoError(t, repo.CommitChanges("Add .gitignore for worktrees"))
+
+	manager, err := NewManager(repo.GetLocalPath())
+	require.NoError(t, err)
+
+	names := []string{"wt-a", "wt-b", "wt-c", "wt-d", "wt-e"}
+	for _, name := range names {
+		require.NoError(t, manager.AddWorktree(name, "feature/"+name, true, ""))
+	}
+
+	// Make one worktree dirty so statuses can be told apart
+	require.NoError(t, os.WriteFile(filepath.Join(repo.GetLocalPath(), "worktrees", "wt-c", "new.txt"), []byte("x"), 0o644))
+
+	// Remove one directory behind git's back so its status lookup fails
+	require.NoError(t, os.RemoveAll(filepath.Join(repo.GetLocalPath(), "worktrees", "wt-e")))
+
+	for _, concurrency := range []int{0, 1, 2, len(names) * 2} {
+		worktrees, err := manager.GetAllWorktreesWithConcurrency(concurrency)
+		require.NoError(t, err)
+		require.Len(t, worktrees, len(names))
+
+		for _, name := range names[:4] {
+			info := worktrees[name]
+			require.NotNil(t, info, "worktree %s missing", name)
+			assert.Equal(t, "feature/"+name, info.CurrentBranch)
+			assert.NoError(t, info.GitStatusError)
+			require.NotNil(t, info.GitStatus)
+			assert.Equal(t, name == "wt-c", info.GitStatus.Untracked > 0)
+		}
+
+		// Per-worktree failure is recorded without aborting the whole call
+		assert.Nil(t, worktrees["wt-e"].GitStatus)
+		assert.Error(t, worktrees["wt-e"].GitStatusError)
+	}
+}
+
+func TestManager_FindBranchConflicts(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+
+	require.NoError(t, repo.WriteFile(".gitignore", "worktrees/\n"))
+	require.NoError(t, repo.CommitChanges("Add .gitignore for worktrees"))
+
+	manager, err := NewManager(repo.GetLocalPath())
+	require.NoError(t, err)
+
+	require.NoError(t, manager.AddWorktree("first", "feature/shared", true, ""))
+	require.NoError(t, manager.AddWorktree("other", "feature/other", true, ""))
+	require.NoError(t, manager.AddWorktree("detached-a", "feature/a", true, ""))
+	require.NoError(t, manager.AddWorktree("detached-b", "feature/b", true, ""))
+	for _, name := range []string{"detached-a", "detached-b"} {
+		require.NoError(t, execGitCommandRun(filepath.Join(repo.GetLocalPath(), "worktrees", name), "checkout", "--detach", "main"))
+	}
+
+	conflicts, err := manager.FindBranchConflicts()
+	require.NoError(t, err)
+	assert.Empty(t, conflicts)
+
+	// git only allows a second worktree on the same branch when forced
+	secondPath := filepath.Join(repo.GetLocalPath(), "worktrees", "second")
+	require.NoError(t, execGitCommandRun(repo.GetLocalPath(), "worktree", "add", "--force", secondPath, "feature/shared"))
+
+	conflicts, err = manager.FindBranchConflicts()
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]string{"feature/shared": {"first", "second"}}, conflicts)
+
+	worktrees, err := manager.GetAllWorktrees()
+	require.NoError(t, err)
+	assert.True(t, worktrees["first"].Conflicted)
+	assert.True(t, worktrees["second"].Conflicted)
+	assert.False(t, worktrees["other"].Conflicted)
+	assert.False(t, worktrees["detached-a"].Conflicted, "detached worktrees at the same commit don't conflict")
+}
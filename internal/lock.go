@@ -0,0 +1,126 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/BurntSushi/toml"
+)
+
+// DefaultLockFilename is the advisory lock in .gbm held while worktrees, state or config change
+const DefaultLockFilename = ".lock"
+
+// ErrRepoLocked is returned when another gbm process holds the repository lock for longer than the timeout
+var ErrRepoLocked = errors.New("another gbm process is running in this repository")
+
+// repoLockTimeout is how long a command waits for another gbm process to finish
+var repoLockTimeout = 10 * time.Second
+
+// repoLockPollInterval is how often a waiting command retries the lock
+const repoLockPollInterval = 100 * time.Millisecond
+
+// repoLock is an acquired advisory lock on .gbm/.lock
+type repoLock struct {
+	file *os.File
+}
+
+// acquireRepoLock takes the exclusive lock on gbmDir's lock file, waiting up to timeout for
+// another process to release it
+func acquireRepoLock(gbmDir string, timeout time.Duration) (*repoLock, error) {
+	if err := os.MkdirAll(gbmDir, 0o755); err != nil {
+		return nil, fmt.Errorf("failed to create .gbm directory: %w", err)
+	}
+
+	lockPath := filepath.Join(gbmDir, DefaultLockFilename)
+	file, err := os.OpenFile(lockPath, os.O_CREATE|os.O_RDWR, 0o644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open lock file: %w", err)
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		locked, err := tryLockFile(file)
+		if err != nil {
+			_ = file.Close()
+			return nil, fmt.Errorf("failed to lock %s: %w", lockPath, err)
+		}
+		if locked {
+			return &repoLock{file: file}, nil
+		}
+
+		if time.Now().After(deadline) {
+			_ = file.Close()
+			return nil, fmt.Errorf("%w (waited %s for %s); try again once it has finished", ErrRepoLocked, timeout, lockPath)
+		}
+		time.Sleep(repoLockPollInterval)
+	}
+}
+
+// release unlocks and closes the lock file
+func (l *repoLock) release() {
+	_ = unlockFile(l.file)
+	_ = l.file.Close()
+}
+
+// lockRepo takes the repository lock and returns the function that releases it. Nested calls share
+// the outermost lock. Operations that change state pass reloadState so the outermost lock rereads
+// state from disk, and changes another gbm process saved in the meantime are not overwritten.
+func (m *Manager) lockRepo(reloadState bool) (func(), error) {
+	m.lockMu.Lock()
+	defer m.lockMu.Unlock()
+
+	if m.lockDepth == 0 {
+		lock, err := acquireRepoLock(m.gbmDir, repoLockTimeout)
+		if err != nil {
+			return nil, err
+		}
+		m.lock = lock
+
+		if reloadState {
+			if state, err := LoadState(m.gbmDir); err != nil {
+				logWarn("Failed to reload state: %v", err)
+			} else {
+				*m.state = *state
+			}
+		}
+	}
+	m.lockDepth++
+
+	return func() {
+		m.lockMu.Lock()
+		defer m.lockMu.Unlock()
+
+		m.lockDepth--
+		if m.lockDepth == 0 {
+			m.lock.release()
+			m.lock = nil
+		}
+	}, nil
+}
+
+// writeTOMLAtomic encodes v into path through a temporary file that is renamed into place, so
+// readers never see a partially written file
+func writeTOMLAtomic(path string, v any) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if err := toml.NewEncoder(tmp).Encode(v); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Chmod(0o644); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
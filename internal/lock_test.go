@@ -0,0 +1,79 @@
+package internal
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAcquireRepoLock(t *testing.T) {
+	gbmDir := filepath.Join(t.TempDir(), ".gbm")
+
+	lock, err := acquireRepoLock(gbmDir, time.Second)
+	require.NoError(t, err)
+	assert.FileExists(t, filepath.Join(gbmDir, DefaultLockFilename))
+
+	// A second holder (another open of the file, as another process would have) times out
+	start := time.Now()
+	_, err = acquireRepoLock(gbmDir, 200*time.Millisecond)
+	assert.ErrorIs(t, err, ErrRepoLocked)
+	assert.GreaterOrEqual(t, time.Since(start), 200*time.Millisecond)
+
+	lock.release()
+	lock, err = acquireRepoLock(gbmDir, 200*time.Millisecond)
+	require.NoError(t, err)
+	lock.release()
+}
+
+func TestManager_LockRepo(t *testing.T) {
+	originalTimeout := repoLockTimeout
+	repoLockTimeout = 200 * time.Millisecond
+	defer func() { repoLockTimeout = originalTimeout }()
+
+	manager, repoPath, sourceRepo := setupManagerForRemoverTests(t)
+	must(t, sourceRepo.CreateBranch("qa", "qa content"))
+
+	// Nested operations share the lock
+	unlock, err := manager.lockRepo(false)
+	require.NoError(t, err)
+	require.NoError(t, manager.SaveState())
+	unlock()
+
+	// Another gbm process holding the lock makes state-changing operations fail clearly
+	other, err := acquireRepoLock(filepath.Join(repoPath, ".gbm"), time.Second)
+	require.NoError(t, err)
+	err = manager.AddWorktree("qa", "qa", false, "qa")
+	assert.ErrorIs(t, err, ErrRepoLocked)
+	assert.ErrorContains(t, err, "another gbm process is running")
+	assert.NoDirExists(t, filepath.Join(repoPath, "worktrees", "qa"))
+	other.release()
+
+	// A manager with stale state picks up what another process saved before changing it
+	stale, err := NewManager(repoPath)
+	require.NoError(t, err)
+	require.NoError(t, manager.AddWorktree("qa", "qa", false, "qa"))
+	require.NoError(t, stale.RemoveWorktree("feat"))
+
+	state, err := LoadState(filepath.Join(repoPath, ".gbm"))
+	require.NoError(t, err)
+	_, hasQA := state.GetWorktreeBaseBranch("qa")
+	_, hasFeat := state.GetWorktreeBaseBranch("feat")
+	assert.True(t, hasQA, "base branch recorded by the other manager was lost")
+	assert.False(t, hasFeat)
+
+	// Renaming and reconciling state reload it under the lock too
+	stale, err = NewManager(repoPath)
+	require.NoError(t, err)
+	manager.GetState().PreviousWorktree = "qa"
+	require.NoError(t, manager.SaveState())
+	require.NoError(t, stale.RenameWorktree("dev", "development"))
+	_, err = stale.ReconcileWorktreeState(false)
+	require.NoError(t, err)
+
+	state, err = LoadState(filepath.Join(repoPath, ".gbm"))
+	require.NoError(t, err)
+	assert.Equal(t, "qa", state.PreviousWorktree, "state saved by the other manager was lost")
+}
@@ -0,0 +1,52 @@
+package internal
+
+import (
+	"fmt"
+	"os"
+)
+
+// LogFunc receives a printf-style message at a single log level
+type LogFunc func(format string, args ...any)
+
+// Logger routes messages from this package to the caller's output. Messages never go to
+// stdout so command output stays clean for scripting; levels left nil fall back to plain
+// stderr (Info, Warn) or are dropped (Debug).
+type Logger struct {
+	Debug LogFunc
+	Info  LogFunc
+	Warn  LogFunc
+}
+
+var logger Logger
+
+// SetLogger sets the functions used to report diagnostics, progress and warnings from this package
+func SetLogger(l Logger) {
+	logger = l
+}
+
+// SetVerboseLogger sets only the function used to report debug diagnostics
+func SetVerboseLogger(debug LogFunc) {
+	logger.Debug = debug
+}
+
+func logVerbose(format string, args ...any) {
+	if logger.Debug != nil {
+		logger.Debug(format, args...)
+	}
+}
+
+func logInfo(format string, args ...any) {
+	if logger.Info != nil {
+		logger.Info(format, args...)
+		return
+	}
+	fmt.Fprintf(os.Stderr, format+"\n", args...)
+}
+
+func logWarn(format string, args ...any) {
+	if logger.Warn != nil {
+		logger.Warn(format, args...)
+		return
+	}
+	fmt.Fprintf(os.Stderr, "Warning: "+format+"\n", args...)
+}
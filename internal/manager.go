@@ -1,31 +1,50 @@
 package internal
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"os"
 	"path/filepath"
+	"runtime"
 	"slices"
 	"sort"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
 type Manager struct {
-	config     *Config
-	state      *State
-	gitManager *GitManager
-	gbmConfig  *GBMConfig
-	repoPath   string
-	gbmDir     string
+	config      *Config
+	state       *State
+	gitManager  *GitManager
+	gbmConfig   *GBMConfig
+	repoPath    string
+	gitPath     string // where git commands run; the bare repository itself when repoPath is its parent
+	gbmDir      string
+	skipHooks   bool
+	excludeMain bool
+
+	// sparsePatterns are applied to worktrees created by this manager; empty means a full checkout
+	sparsePatterns []string
+
+	// lockMu guards the repository lock, which nested operations share
+	lockMu    sync.Mutex
+	lockDepth int
+	lock      *repoLock
 }
 
 type WorktreeListInfo struct {
-	Path           string
-	ExpectedBranch string
-	CurrentBranch  string
-	GitStatus      *GitStatus
+	Path           string     `json:"path"`
+	ExpectedBranch string     `json:"expected_branch"`
+	CurrentBranch  string     `json:"current_branch"`
+	GitStatus      *GitStatus `json:"git_status"`
+	GitStatusError error      `json:"-"`
+	// Conflicted is set when another worktree reports the same branch, which git normally forbids
+	Conflicted bool `json:"conflicted"`
 }
 
 type SyncStatus struct {
@@ -34,8 +53,41 @@ type SyncStatus struct {
 	OrphanedWorktrees  []string
 	BranchChanges      map[string]BranchChange
 	WorktreePromotions []WorktreePromotion
+	// DirtyWorktrees lists worktrees with uncommitted changes that a sync would recreate
+	// (branch changes and promotions)
+	DirtyWorktrees []string
 }
 
+// SyncOptions controls how SyncWithConfirmation reconciles worktrees
+type SyncOptions struct {
+	DryRun bool
+	// Force skips the confirmation prompt for removing orphaned worktrees
+	Force         bool
+	RemoveOrphans bool
+	// StashDirty stashes uncommitted changes in worktrees changing branch and restores them afterwards
+	StashDirty bool
+	// ResetDirty discards uncommitted changes in worktrees that are recreated
+	ResetDirty bool
+	// NoFetch skips fetching from the remote, so remote branches are as of the last fetch
+	NoFetch bool
+	// PruneRemote fetches with --prune so branches deleted on the remote drop out of the remote-tracking refs
+	PruneRemote bool
+	// CreateMissingBranches creates configured branches that don't exist yet from the worktree's
+	// base_branch (or the default branch), asking before each one. settings.create_missing_branches
+	// turns this on for every sync.
+	CreateMissingBranches bool
+	// Progress is notified before each step; nil reports nothing
+	Progress SyncProgress
+	// Context cancels a fetch in progress (e.g. on Ctrl-C); nil means context.Background()
+	Context context.Context
+	// AfterFetch, when set, is called once the fetch step is over (or skipped), so callers can release
+	// whatever they set up to cancel it
+	AfterFetch func()
+}
+
+// ErrDirtyWorktrees is returned by SyncWithConfirmation when it would discard uncommitted changes
+var ErrDirtyWorktrees = errors.New("worktrees have uncommitted changes that sync would discard")
+
 type BranchChange struct {
 	OldBranch string
 	NewBranch string
@@ -52,7 +104,17 @@ type WorktreePromotion struct {
 type ConfirmationFunc func(message string) bool
 
 func NewManager(repoPath string) (*Manager, error) {
-	gbmDir := filepath.Join(repoPath, DefaultConfigDirname)
+	// In a bare repository the config dir and worktrees live next to the git data, not inside it
+	rootPath := repoPath
+	if IsBareRepository(repoPath) {
+		bareRoot, err := BareRepositoryRoot(repoPath)
+		if err != nil {
+			return nil, err
+		}
+		rootPath = bareRoot
+	}
+
+	gbmDir := filepath.Join(rootPath, DefaultConfigDirname)
 	config, err := LoadConfig(gbmDir)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load config: %w", err)
@@ -67,6 +129,11 @@ func NewManager(repoPath string) (*Manager, error) {
 	if err != nil {
 		return nil, err
 	}
+	gitManager.SetDefaultRemote(config.Settings.DefaultRemote)
+	gitManager.SetWorktreeRoot(rootPath)
+	gitManager.SetFetchRetries(config.Settings.FetchRetries)
+	gitManager.SetFetchTimeout(config.Settings.FetchTimeout)
+	gitManager.SetTokenEnv(config.Git.TokenEnv)
 
 	// Initialize the global icon manager with the loaded config
 	iconManager := NewIconManager(config)
@@ -76,7 +143,8 @@ func NewManager(repoPath string) (*Manager, error) {
 		config:     config,
 		state:      state,
 		gitManager: gitManager,
-		repoPath:   repoPath,
+		repoPath:   rootPath,
+		gitPath:    repoPath,
 		gbmDir:     gbmDir,
 	}, nil
 }
@@ -104,6 +172,11 @@ func (m *Manager) LoadGBMConfig(configPath string) error {
 	return nil
 }
 
+// CheckMergeBackStatus reports tracked branches with commits that still need to be merged back
+func (m *Manager) CheckMergeBackStatus() (*MergeBackStatus, error) {
+	return CheckMergeBackStatus(filepath.Join(m.repoPath, DefaultBranchConfigFilename))
+}
+
 func (m *Manager) GetSyncStatus() (*SyncStatus, error) {
 	if m.gbmConfig == nil {
 		if err := m.LoadGBMConfig(""); err != nil {
@@ -124,26 +197,20 @@ func (m *Manager) GetSyncStatus() (*SyncStatus, error) {
 		return nil, fmt.Errorf("failed to get worktrees: %w", err)
 	}
 
+	managed := m.filterWorktreesUnderPrefix(worktrees, m.config.Settings.WorktreePrefix)
 	worktreeMap := make(map[string]*WorktreeInfo)
-	// Resolve symlinks for robust prefix checks on systems where /var -> /private/var
-	prefix := filepath.Join(m.repoPath, m.config.Settings.WorktreePrefix)
-	resolvedPrefix, err := filepath.EvalSymlinks(prefix)
-	if err != nil {
-		resolvedPrefix = prefix
-	}
-	for _, wt := range worktrees {
-		resolvedPath, err := filepath.EvalSymlinks(wt.Path)
-		if err != nil {
-			resolvedPath = wt.Path
-		}
-		if strings.HasPrefix(resolvedPath, resolvedPrefix) {
-			worktreeMap[wt.Name] = wt
+	for _, wt := range managed {
+		// A worktree whose directory was deleted by hand is missing, even though git still lists it
+		if _, err := os.Stat(wt.Path); os.IsNotExist(err) {
+			continue
 		}
+		worktreeMap[wt.Name] = wt
 	}
 
 	for worktreeName, worktreeConfig := range m.gbmConfig.Worktrees {
 		if wt, exists := worktreeMap[worktreeName]; exists {
-			if wt.Branch != worktreeConfig.Branch {
+			// A detached HEAD is deliberate (e.g. inspecting a tag), not a branch mismatch
+			if !wt.Detached && wt.Branch != worktreeConfig.Branch {
 				status.BranchChanges[worktreeName] = BranchChange{
 					OldBranch: wt.Branch,
 					NewBranch: worktreeConfig.Branch,
@@ -163,41 +230,58 @@ func (m *Manager) GetSyncStatus() (*SyncStatus, error) {
 	}
 
 	// Detect worktree promotions: when a branch moves from one worktree to another
-	status.WorktreePromotions = m.detectWorktreePromotions(status.BranchChanges, worktrees)
+	status.WorktreePromotions = detectWorktreePromotions(status.BranchChanges, worktreesByBranch(managed))
+
+	status.DirtyWorktrees = m.findDirtyRecreatedWorktrees(status)
 
 	return status, nil
 }
 
-func (m *Manager) detectWorktreePromotions(branchChanges map[string]BranchChange, allWorktrees []*WorktreeInfo) []WorktreePromotion {
-	var promotions []WorktreePromotion
-
-	// Create a map of branch -> worktree for existing worktrees
-	branchToWorktree := make(map[string]string)
-	expectedPrefix := filepath.Join(m.repoPath, m.config.Settings.WorktreePrefix)
-
-	// Resolve symlinks for consistent path comparison (e.g., /var vs /private/var on macOS)
-	resolvedExpectedPrefix, err := filepath.EvalSymlinks(expectedPrefix)
-	if err != nil {
-		resolvedExpectedPrefix = expectedPrefix // fallback to original if resolution fails
+// findDirtyRecreatedWorktrees returns the sorted names of worktrees with uncommitted changes that
+// a sync would remove and recreate
+func (m *Manager) findDirtyRecreatedWorktrees(status *SyncStatus) []string {
+	recreated := make(map[string]bool)
+	for worktreeName := range status.BranchChanges {
+		recreated[worktreeName] = true
+	}
+	for _, promotion := range status.WorktreePromotions {
+		recreated[promotion.SourceWorktree] = true
+		recreated[promotion.TargetWorktree] = true
 	}
 
-	for _, wt := range allWorktrees {
-		resolvedWorktreePath, err := filepath.EvalSymlinks(wt.Path)
-		if err != nil {
-			resolvedWorktreePath = wt.Path // fallback to original if resolution fails
+	var dirty []string
+	for worktreeName := range recreated {
+		worktreePath := filepath.Join(m.repoPath, m.config.Settings.WorktreePrefix, worktreeName)
+		gitStatus, err := m.gitManager.GetWorktreeStatus(worktreePath)
+		if err == nil && gitStatus.HasChanges() {
+			dirty = append(dirty, worktreeName)
 		}
+	}
+	sort.Strings(dirty)
+
+	return dirty
+}
 
-		if strings.HasPrefix(resolvedWorktreePath, resolvedExpectedPrefix) {
-			branchToWorktree[wt.Branch] = wt.Name
+// worktreesByBranch maps each checked-out branch to its worktree, skipping detached worktrees
+func worktreesByBranch(worktrees []*WorktreeInfo) map[string]*WorktreeInfo {
+	byBranch := make(map[string]*WorktreeInfo, len(worktrees))
+	for _, wt := range worktrees {
+		if !wt.Detached {
+			byBranch[wt.Branch] = wt
 		}
 	}
+	return byBranch
+}
+
+func detectWorktreePromotions(branchChanges map[string]BranchChange, branchToWorktree map[string]*WorktreeInfo) []WorktreePromotion {
+	var promotions []WorktreePromotion
 
 	// Check each branch change to see if the new branch is currently checked out elsewhere
 	for targetWorktree, change := range branchChanges {
-		if sourceWorktree, exists := branchToWorktree[change.NewBranch]; exists {
+		if source, exists := branchToWorktree[change.NewBranch]; exists {
 			// This is a promotion: the new branch is currently in another worktree
 			promotion := WorktreePromotion{
-				SourceWorktree: sourceWorktree,
+				SourceWorktree: source.Name,
 				TargetWorktree: targetWorktree,
 				Branch:         change.NewBranch,
 				SourceBranch:   change.NewBranch,
@@ -211,20 +295,58 @@ func (m *Manager) detectWorktreePromotions(branchChanges map[string]BranchChange
 }
 
 func (m *Manager) Sync(dryRun, force bool) error {
-	return m.SyncWithConfirmation(dryRun, force, false, nil)
+	return m.SyncWithConfirmation(SyncOptions{DryRun: dryRun, Force: force}, nil)
 }
 
-func (m *Manager) SyncWithConfirmation(dryRun, force bool, removeOrphans bool, confirmFunc ConfirmationFunc) error {
+// SyncWithConfirmation brings worktrees in line with gbm.branchconfig.yaml. Worktrees that are
+// recreated with uncommitted changes make it fail with ErrDirtyWorktrees unless opts.StashDirty
+// (branch changes only) or opts.ResetDirty is set.
+func (m *Manager) SyncWithConfirmation(opts SyncOptions, confirmFunc ConfirmationFunc) error {
+	if !opts.DryRun {
+		unlock, err := m.lockRepo(true)
+		if err != nil {
+			return err
+		}
+		defer unlock()
+	}
+
+	progress := opts.Progress
+	if progress == nil {
+		progress = NopSyncProgress{}
+	}
+
+	// Fetch first so branches that only exist on the remote aren't mistaken for missing ones
+	var fetchErr error
+	if !opts.NoFetch {
+		progress.SyncStep(SyncStep{Kind: SyncStepFetch})
+		fetch := m.gitManager.FetchAll
+		if opts.PruneRemote {
+			fetch = m.gitManager.FetchAllPrune
+		}
+		ctx := opts.Context
+		if ctx == nil {
+			ctx = context.Background()
+		}
+		fetchErr = fetch(ctx)
+	}
+	if opts.AfterFetch != nil {
+		opts.AfterFetch()
+	}
+	if fetchErr != nil {
+		return fmt.Errorf("failed to fetch: %w", fetchErr)
+	}
+
+	if !opts.DryRun && (opts.CreateMissingBranches || m.config.Settings.CreateMissingBranches) {
+		if err := m.createMissingBranches(confirmFunc, progress); err != nil {
+			return err
+		}
+	}
+
 	// Validate all branches exist before performing any operations
 	if err := m.ValidateConfig(); err != nil {
 		return err
 	}
 
-	// Always fetch from remote before sync
-	if err := m.gitManager.FetchAll(); err != nil {
-		return fmt.Errorf("failed to fetch: %w", err)
-	}
-
 	status, err := m.GetSyncStatus()
 	if err != nil {
 		return err
@@ -234,10 +356,14 @@ func (m *Manager) SyncWithConfirmation(dryRun, force bool, removeOrphans bool, c
 		return nil
 	}
 
-	if dryRun {
+	if opts.DryRun {
 		return nil
 	}
 
+	if blocked := blockedDirtyWorktrees(status, opts); len(blocked) > 0 {
+		return fmt.Errorf("%w: %s; commit them, use --stash to carry them over to the new branch, or --reset-dirty to discard them", ErrDirtyWorktrees, strings.Join(blocked, ", "))
+	}
+
 	// Ensure worktrees directory exists before creating/moving worktrees
 	worktreesDir := filepath.Join(m.repoPath, m.config.Settings.WorktreePrefix)
 	if err := os.MkdirAll(worktreesDir, 0o755); err != nil {
@@ -245,9 +371,9 @@ func (m *Manager) SyncWithConfirmation(dryRun, force bool, removeOrphans bool, c
 	}
 
 	// Remove orphaned worktrees first (if --remove-orphans is used) to free up branches
-	if removeOrphans && len(status.OrphanedWorktrees) > 0 {
+	if opts.RemoveOrphans && len(status.OrphanedWorktrees) > 0 {
 		// Ask for confirmation unless --force is used
-		if !force && confirmFunc != nil {
+		if !opts.Force && confirmFunc != nil {
 			message := "The following worktrees will be PERMANENTLY DELETED:\n"
 			for _, envVar := range status.OrphanedWorktrees {
 				worktreePath := filepath.Join(m.repoPath, m.config.Settings.WorktreePrefix, envVar)
@@ -262,6 +388,7 @@ func (m *Manager) SyncWithConfirmation(dryRun, force bool, removeOrphans bool, c
 
 		for _, envVar := range status.OrphanedWorktrees {
 			worktreePath := filepath.Join(m.repoPath, m.config.Settings.WorktreePrefix, envVar)
+			progress.SyncStep(SyncStep{Kind: SyncStepRemoveOrphan, Worktree: envVar})
 			err := m.gitManager.RemoveWorktree(worktreePath)
 			if err != nil {
 				return fmt.Errorf("failed to remove orphaned worktree %s: %w", envVar, err)
@@ -271,17 +398,27 @@ func (m *Manager) SyncWithConfirmation(dryRun, force bool, removeOrphans bool, c
 
 	for _, worktreeName := range status.MissingWorktrees {
 		worktreeConfig := m.gbmConfig.Worktrees[worktreeName]
-		// If the directory exists but is empty (e.g., created by .gitignore), remove it first
 		worktreePath := filepath.Join(m.repoPath, m.config.Settings.WorktreePrefix, worktreeName)
-		if stat, err := os.Stat(worktreePath); err == nil && stat.IsDir() {
-			// Check if directory is empty
+		registered, onDisk, err := m.gitManager.WorktreeExists(worktreePath)
+		if err != nil {
+			return err
+		}
+		switch {
+		case registered && !onDisk:
+			// The directory was deleted by hand; drop git's stale registration so it can be recreated
+			if _, err := m.gitManager.PruneWorktrees(false); err != nil {
+				return fmt.Errorf("failed to prune stale registration of %s: %w", worktreeName, err)
+			}
+		case !registered && onDisk:
+			// If the directory exists but is empty (e.g., created by .gitignore), remove it first
 			entries, readErr := os.ReadDir(worktreePath)
 			if readErr == nil && len(entries) == 0 {
 				_ = os.Remove(worktreePath)
 			}
 		}
 
-		err := m.gitManager.CreateWorktree(worktreeName, worktreeConfig.Branch, m.config.Settings.WorktreePrefix)
+		progress.SyncStep(SyncStep{Kind: SyncStepCreate, Worktree: worktreeName, Branch: worktreeConfig.Branch})
+		err = m.gitManager.CreateWorktree(worktreeName, worktreeConfig.Branch, m.config.Settings.WorktreePrefix)
 		if err != nil {
 			// Special case: if creating a worktree fails because directory already exists,
 			// check if this is the main worktree already present in repository root
@@ -293,6 +430,7 @@ func (m *Manager) SyncWithConfirmation(dryRun, force bool, removeOrphans bool, c
 			}
 			return fmt.Errorf("failed to create worktree for %s: %w", worktreeName, err)
 		}
+		m.reapplySparseCheckout(worktreeName)
 	}
 
 	// Handle worktree promotions with confirmation (always required for destructive operations)
@@ -317,6 +455,7 @@ func (m *Manager) SyncWithConfirmation(dryRun, force bool, removeOrphans bool, c
 	for _, promotion := range status.WorktreePromotions {
 		sourceWorktreePath := filepath.Join(m.repoPath, m.config.Settings.WorktreePrefix, promotion.SourceWorktree)
 		targetWorktreePath := filepath.Join(m.repoPath, m.config.Settings.WorktreePrefix, promotion.TargetWorktree)
+		progress.SyncStep(SyncStep{Kind: SyncStepPromote, Worktree: promotion.SourceWorktree, Branch: promotion.Branch, Target: promotion.TargetWorktree})
 
 		// Remove both worktrees to free up branches
 		if err := m.gitManager.RemoveWorktree(sourceWorktreePath); err != nil {
@@ -333,18 +472,45 @@ func (m *Manager) SyncWithConfirmation(dryRun, force bool, removeOrphans bool, c
 		if err := m.gitManager.CreateWorktree(promotion.SourceWorktree, promotion.TargetBranch, m.config.Settings.WorktreePrefix); err != nil {
 			return fmt.Errorf("failed to create source worktree %s with branch %s: %w", promotion.SourceWorktree, promotion.TargetBranch, err)
 		}
+		m.reapplySparseCheckout(promotion.TargetWorktree)
+		m.reapplySparseCheckout(promotion.SourceWorktree)
 
 		// Remove from regular branch changes since already handled
 		delete(status.BranchChanges, promotion.TargetWorktree)
 		delete(status.BranchChanges, promotion.SourceWorktree)
 	}
 
+	var unrestored []string
 	for worktreeName, change := range status.BranchChanges {
 		worktreePath := filepath.Join(m.repoPath, m.config.Settings.WorktreePrefix, worktreeName)
+
+		stashRef := ""
+		if opts.StashDirty {
+			var err error
+			stashRef, err = m.stashIfDirty(worktreeName, worktreePath)
+			if err != nil {
+				return err
+			}
+		}
+
+		progress.SyncStep(SyncStep{Kind: SyncStepUpdate, Worktree: worktreeName, Branch: change.NewBranch})
 		err := m.gitManager.UpdateWorktree(worktreePath, change.NewBranch)
 		if err != nil {
+			if stashRef != "" {
+				return fmt.Errorf("failed to update worktree for %s (local changes saved in stash %s): %w", worktreeName, stashRef, err)
+			}
 			return fmt.Errorf("failed to update worktree for %s: %w", worktreeName, err)
 		}
+		m.reapplySparseCheckout(worktreeName)
+
+		if stashRef != "" {
+			if err := m.gitManager.PopStash(worktreePath, stashRef); err != nil {
+				if !errors.Is(err, ErrStashConflict) {
+					return fmt.Errorf("failed to restore local changes for %s (stash %s): %w", worktreeName, stashRef, err)
+				}
+				unrestored = append(unrestored, worktreeName)
+			}
+		}
 	}
 
 	var trackedWorktrees []string
@@ -354,29 +520,132 @@ func (m *Manager) SyncWithConfirmation(dryRun, force bool, removeOrphans bool, c
 	m.state.TrackedVars = trackedWorktrees
 	m.state.LastSync = time.Now()
 
-	return m.SaveState()
+	if err := m.SaveState(); err != nil {
+		return err
+	}
+
+	if len(unrestored) > 0 {
+		sort.Strings(unrestored)
+		return fmt.Errorf("%w; resolve conflicts manually in: %s", ErrStashConflict, strings.Join(unrestored, ", "))
+	}
+
+	return nil
 }
 
-func (m *Manager) ValidateConfig() error {
+// stashIfDirty stashes uncommitted changes in a worktree before it is recreated.
+// Returns the stash ref, or an empty string if the worktree was clean.
+func (m *Manager) stashIfDirty(worktreeName, worktreePath string) (string, error) {
+	gitStatus, err := m.gitManager.GetWorktreeStatus(worktreePath)
+	if err != nil || !gitStatus.HasChanges() {
+		return "", nil
+	}
+
+	stashRef, err := m.gitManager.StashWorktree(worktreePath, "gbm sync: "+worktreeName)
+	if err != nil {
+		return "", fmt.Errorf("failed to stash local changes in %s: %w", worktreeName, err)
+	}
+
+	return stashRef, nil
+}
+
+// blockedDirtyWorktrees returns the dirty worktrees whose changes a sync with opts would discard.
+// Stashing only covers plain branch changes; promoted worktrees are removed outright.
+func blockedDirtyWorktrees(status *SyncStatus, opts SyncOptions) []string {
+	if opts.ResetDirty {
+		return nil
+	}
+
+	promoted := make(map[string]bool)
+	for _, promotion := range status.WorktreePromotions {
+		promoted[promotion.SourceWorktree] = true
+		promoted[promotion.TargetWorktree] = true
+	}
+
+	var blocked []string
+	for _, worktreeName := range status.DirtyWorktrees {
+		if opts.StashDirty && !promoted[worktreeName] {
+			continue
+		}
+		blocked = append(blocked, worktreeName)
+	}
+	return blocked
+}
+
+// createMissingBranches creates each configured branch that exists neither locally nor on the
+// remote, starting from the worktree's base_branch or the default branch
+func (m *Manager) createMissingBranches(confirmFunc ConfirmationFunc, progress SyncProgress) error {
 	if m.gbmConfig == nil {
 		if err := m.LoadGBMConfig(""); err != nil {
 			return fmt.Errorf("no %s loaded", DefaultBranchConfigFilename)
 		}
 	}
 
-	for worktreeName, worktreeConfig := range m.gbmConfig.Worktrees {
-		exists, err := m.gitManager.BranchExistsLocalOrRemote(worktreeConfig.Branch)
+	for _, worktreeName := range slices.Sorted(maps.Keys(m.gbmConfig.Worktrees)) {
+		worktreeConfig := m.gbmConfig.Worktrees[worktreeName]
+		exists, err := m.gitManager.branchExists(worktreeConfig.Branch)
 		if err != nil {
 			return fmt.Errorf("failed to check branch %s for %s: %w", worktreeConfig.Branch, worktreeName, err)
 		}
-		if !exists {
-			return fmt.Errorf("branch '%s' does not exist", worktreeConfig.Branch)
+		if exists {
+			continue
+		}
+
+		base := worktreeConfig.BaseBranch
+		if base == "" {
+			if base, err = m.gitManager.GetDefaultBranch(); err != nil {
+				return fmt.Errorf("failed to determine base for branch '%s': %w", worktreeConfig.Branch, err)
+			}
+		}
+
+		if confirmFunc == nil {
+			return fmt.Errorf("creating branch '%s' requires confirmation, but no confirmation function provided", worktreeConfig.Branch)
+		}
+		if !confirmFunc(fmt.Sprintf("Branch '%s' for worktree %s does not exist. Create it from '%s'?", worktreeConfig.Branch, worktreeName, base)) {
+			return fmt.Errorf("creation of branch '%s' cancelled by user", worktreeConfig.Branch)
+		}
+
+		progress.SyncStep(SyncStep{Kind: SyncStepCreateBranch, Worktree: worktreeName, Branch: worktreeConfig.Branch, Base: base})
+		if err := m.gitManager.CreateBranch(worktreeConfig.Branch, base); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Manager) ValidateConfig() error {
+	if m.gbmConfig == nil {
+		if err := m.LoadGBMConfig(""); err != nil {
+			return fmt.Errorf("no %s loaded", DefaultBranchConfigFilename)
+		}
+	}
+
+	// Duplicate branches were already rejected when the worktree tree was built
+	for _, worktreeName := range slices.Sorted(maps.Keys(m.gbmConfig.Worktrees)) {
+		if err := m.gitManager.validateWorktreeBranch(worktreeName, m.gbmConfig.Worktrees[worktreeName].Branch); err != nil {
+			return err
 		}
 	}
 
 	return nil
 }
 
+// validateWorktreeBranch checks that the branch configured for a worktree is set and exists
+func (gm *GitManager) validateWorktreeBranch(worktreeName, branch string) error {
+	if branch == "" {
+		return fmt.Errorf("worktree '%s' has no branch", worktreeName)
+	}
+
+	exists, err := gm.branchExists(branch)
+	if err != nil {
+		return fmt.Errorf("failed to check branch '%s' for worktree '%s': %w", branch, worktreeName, err)
+	}
+	if !exists {
+		return fmt.Errorf("branch '%s' for worktree '%s' does not exist", branch, worktreeName)
+	}
+	return nil
+}
+
 func (m *Manager) GetWorktreeMapping() (map[string]string, error) {
 	if m.gbmConfig == nil {
 		if err := m.LoadGBMConfig(""); err != nil {
@@ -405,6 +674,25 @@ func (m *Manager) GetDefaultBranch() (string, error) {
 	return m.gitManager.GetDefaultBranch()
 }
 
+// SetRemoteHead records the default remote's HEAD branch so default branch lookups stop guessing
+func (m *Manager) SetRemoteHead() error {
+	return m.gitManager.SetRemoteHead()
+}
+
+// ResolveCommitInPath returns the commit hash that ref (HEAD, a tag or a SHA) points to when
+// evaluated in path, so that HEAD refers to the worktree the command was run from
+func (m *Manager) ResolveCommitInPath(path, ref string) (string, error) {
+	exists, err := m.gitManager.VerifyRefInPath(path, ref+"^{commit}")
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return "", fmt.Errorf("'%s' is not a branch, tag or commit", ref)
+	}
+
+	return m.gitManager.GetCommitHashInPath(path, ref+"^{commit}")
+}
+
 // GetJiraIssues returns JIRA issues for the current user
 func (m *Manager) GetJiraIssues() ([]JiraIssue, error) {
 	return GetJiraIssues(m)
@@ -463,14 +751,35 @@ func (m *Manager) GetStatusIcon(gitStatus *GitStatus) string {
 func (m *Manager) GetWorktreePath(worktreeName string) (string, error) {
 	worktreePath := filepath.Join(m.repoPath, m.config.Settings.WorktreePrefix, worktreeName)
 
-	if _, err := os.Stat(worktreePath); os.IsNotExist(err) {
+	registered, onDisk, err := m.gitManager.WorktreeExists(worktreePath)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case !registered && !onDisk:
 		return "", fmt.Errorf("worktree directory '%s' does not exist", worktreeName)
+	case !registered:
+		return "", fmt.Errorf("worktree '%s': %w: %s", worktreeName, ErrWorktreeNotRegistered, worktreePath)
+	case !onDisk:
+		return "", fmt.Errorf("worktree '%s': %w: %s (run 'gbm prune' to clean it up)", worktreeName, ErrWorktreeDirectoryMissing, worktreePath)
 	}
 
 	return worktreePath, nil
 }
 
 func (m *Manager) GetAllWorktrees() (map[string]*WorktreeListInfo, error) {
+	return m.GetAllWorktreesWithConcurrency(runtime.NumCPU())
+}
+
+// GetAllWorktreesWithConcurrency collects worktree information, fetching git status for up to
+// maxConcurrency worktrees at a time. A non-positive maxConcurrency defaults to runtime.NumCPU().
+// Status failures are recorded on the individual WorktreeListInfo rather than failing the call.
+func (m *Manager) GetAllWorktreesWithConcurrency(maxConcurrency int) (map[string]*WorktreeListInfo, error) {
+	if maxConcurrency <= 0 {
+		maxConcurrency = runtime.NumCPU()
+	}
+
 	result := make(map[string]*WorktreeListInfo)
 
 	// Get all actual worktrees from git
@@ -479,58 +788,185 @@ func (m *Manager) GetAllWorktrees() (map[string]*WorktreeListInfo, error) {
 		return nil, fmt.Errorf("failed to get worktrees: %w", err)
 	}
 
-	worktreePrefix := filepath.Join(m.repoPath, m.config.Settings.WorktreePrefix)
-
-	// Resolve symlinks to handle cases like /var vs /private/var on macOS
-	resolvedWorktreePrefix, err := filepath.EvalSymlinks(worktreePrefix)
-	if err != nil {
-		resolvedWorktreePrefix = worktreePrefix // fallback to original if resolution fails
-	}
+	worktrees = m.filterWorktreesUnderPrefix(worktrees, m.config.Settings.WorktreePrefix)
+	conflicts := findBranchConflicts(worktrees)
 
+	pending := make(map[string]*WorktreeListInfo)
 	for _, wt := range worktrees {
-		// Resolve symlinks for worktree path as well
-		resolvedWtPath, err := filepath.EvalSymlinks(wt.Path)
-		if err != nil {
-			resolvedWtPath = wt.Path // fallback to original if resolution fails
-		}
+		// Extract worktree name from path
+		worktreeName := filepath.Base(wt.Path)
 
-		if strings.HasPrefix(resolvedWtPath, resolvedWorktreePrefix) {
-			// Extract worktree name from path
-			worktreeName := filepath.Base(wt.Path)
+		info := &WorktreeListInfo{
+			Path:          wt.Path,
+			CurrentBranch: wt.Branch,
+			Conflicted:    !wt.Detached && len(conflicts[wt.Branch]) > 1,
+		}
 
-			info := &WorktreeListInfo{
-				Path:          wt.Path,
-				CurrentBranch: wt.Branch,
+		// Set expected branch if it's tracked in gbm.branchconfig.yaml
+		if m.gbmConfig != nil {
+			if worktreeConfig, exists := m.gbmConfig.Worktrees[worktreeName]; exists {
+				info.ExpectedBranch = worktreeConfig.Branch
+			} else {
+				info.ExpectedBranch = wt.Branch // Use current branch as expected for ad hoc worktrees
 			}
+		} else {
+			info.ExpectedBranch = wt.Branch
+		}
+
+		pending[worktreeName] = info
+	}
+
+	// Fan git status collection out across a bounded pool of workers
+	jobs := make(chan string)
+	var mu sync.Mutex
+	var wg sync.WaitGroup
 
-			// Set expected branch if it's tracked in gbm.branchconfig.yaml
-			if m.gbmConfig != nil {
-				if worktreeConfig, exists := m.gbmConfig.Worktrees[worktreeName]; exists {
-					info.ExpectedBranch = worktreeConfig.Branch
+	for range min(maxConcurrency, len(pending)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for worktreeName := range jobs {
+				info := pending[worktreeName]
+				gitStatus, err := m.gitManager.GetWorktreeStatus(info.Path)
+
+				mu.Lock()
+				if err != nil {
+					info.GitStatusError = err
 				} else {
-					info.ExpectedBranch = wt.Branch // Use current branch as expected for ad hoc worktrees
+					info.GitStatus = gitStatus
 				}
-			} else {
-				info.ExpectedBranch = wt.Branch
+				result[worktreeName] = info
+				mu.Unlock()
 			}
+		}()
+	}
 
-			// Get git status for the worktree
-			if gitStatus, err := m.gitManager.GetWorktreeStatus(wt.Path); err == nil {
-				info.GitStatus = gitStatus
-			}
+	for worktreeName := range pending {
+		jobs <- worktreeName
+	}
+	close(jobs)
+	wg.Wait()
+
+	return result, nil
+}
+
+// FindBranchConflicts returns the branches checked out in more than one managed worktree, mapped
+// to those worktrees' names. Git refuses to create such worktrees, but manual git operations can
+// still leave them behind.
+func (m *Manager) FindBranchConflicts() (map[string][]string, error) {
+	worktrees, err := m.gitManager.GetWorktrees()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get worktrees: %w", err)
+	}
+
+	return findBranchConflicts(m.filterWorktreesUnderPrefix(worktrees, m.config.Settings.WorktreePrefix)), nil
+}
 
-			result[worktreeName] = info
+// findBranchConflicts groups worktrees by branch, keeping only branches shared by several
+// worktrees. Detached worktrees have no branch and never conflict.
+func findBranchConflicts(worktrees []*WorktreeInfo) map[string][]string {
+	byBranch := make(map[string][]string)
+	for _, wt := range worktrees {
+		if wt.Detached || wt.Branch == "" {
+			continue
 		}
+		byBranch[wt.Branch] = append(byBranch[wt.Branch], filepath.Base(wt.Path))
 	}
 
-	return result, nil
+	conflicts := make(map[string][]string)
+	for branch, names := range byBranch {
+		if len(names) > 1 {
+			slices.Sort(names)
+			conflicts[branch] = names
+		}
+	}
+
+	return conflicts
+}
+
+// ValidateWorktreeName checks that a worktree name is a single directory name inside the worktree
+// directory. Worktree names are used as-is for paths, so separators or ".." would escape it.
+func ValidateWorktreeName(name string) error {
+	switch {
+	case name == "":
+		return fmt.Errorf("worktree name must not be empty")
+	case name == "." || name == "..":
+		return fmt.Errorf("invalid worktree name '%s'", name)
+	case strings.ContainsAny(name, `/\`):
+		return fmt.Errorf("invalid worktree name '%s': must not contain path separators", name)
+	}
+	return nil
 }
 
 func (m *Manager) AddWorktree(worktreeName, branchName string, createBranch bool, baseBranch string) error {
-	err := m.gitManager.AddWorktree(worktreeName, branchName, createBranch, baseBranch)
+	if err := ValidateWorktreeName(worktreeName); err != nil {
+		return err
+	}
+
+	unlock, err := m.lockRepo(true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if err := m.gitManager.AddWorktree(worktreeName, branchName, createBranch, baseBranch); err != nil {
+		return err
+	}
+
+	m.trackNewWorktree(worktreeName, baseBranch)
+	return nil
+}
+
+// AddWorktreeTracking creates a worktree on a new local branch tracking an existing remote branch.
+// The remote branch is recorded as the worktree's base.
+func (m *Manager) AddWorktreeTracking(worktreeName, localBranch, remoteRef string) error {
+	if err := ValidateWorktreeName(worktreeName); err != nil {
+		return err
+	}
+
+	unlock, err := m.lockRepo(true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if err := m.gitManager.AddWorktreeTracking(worktreeName, localBranch, remoteRef); err != nil {
+		return err
+	}
+
+	m.trackNewWorktree(worktreeName, remoteRef)
+	return nil
+}
+
+// CreateWorktreeFromRef creates a worktree starting at a tag or commit, on a new branch or
+// detached when newBranch is empty. The start ref is recorded as the worktree's base.
+func (m *Manager) CreateWorktreeFromRef(worktreeName, newBranch, startRef string) error {
+	if err := ValidateWorktreeName(worktreeName); err != nil {
+		return err
+	}
+
+	unlock, err := m.lockRepo(true)
 	if err != nil {
 		return err
 	}
+	defer unlock()
+
+	if err := m.gitManager.CreateWorktreeFromRef(worktreeName, newBranch, startRef); err != nil {
+		return err
+	}
+
+	m.trackNewWorktree(worktreeName, startRef)
+	return nil
+}
+
+// trackNewWorktree copies configured files into a newly created worktree, renders its env template,
+// runs post_create hooks and records it in state
+func (m *Manager) trackNewWorktree(worktreeName, baseBranch string) {
+	if len(m.sparsePatterns) > 0 {
+		if err := m.applySparseCheckout(worktreeName, m.sparsePatterns); err != nil {
+			logWarn("%v", err)
+		}
+	}
 
 	// Check if this is an ad-hoc worktree (not tracked in gbm.branchconfig.yaml)
 	isAdHoc := true
@@ -543,10 +979,17 @@ func (m *Manager) AddWorktree(worktreeName, branchName string, createBranch bool
 	// Only copy files for ad-hoc worktrees
 	if isAdHoc {
 		if err := m.copyFilesToWorktree(worktreeName); err != nil {
-			fmt.Printf("Warning: failed to copy files to worktree: %v\n", err)
+			logWarn("failed to copy files to worktree: %v", err)
 		}
 	}
 
+	// Every worktree gets its own env file, since the values differ per worktree
+	if err := m.renderEnvTemplate(worktreeName); err != nil {
+		logWarn("%v", err)
+	}
+
+	m.runPostCreateHooks(worktreeName)
+
 	// Store the base branch information for this worktree
 	m.state.SetWorktreeBaseBranch(worktreeName, baseBranch)
 
@@ -563,46 +1006,132 @@ func (m *Manager) AddWorktree(worktreeName, branchName string, createBranch bool
 	// Save the updated state
 	if saveErr := m.SaveState(); saveErr != nil {
 		// Log warning but don't fail the operation
-		fmt.Printf("Warning: failed to save state: %v\n", saveErr)
+		logWarn("failed to save state: %v", saveErr)
 	}
+}
 
-	return nil
+// FileCopyResult lists the files handled by a file copy run, as paths relative to the target worktree
+type FileCopyResult struct {
+	Copied  []string
+	Skipped []string
+}
+
+// fileCopier copies files for a single file copy rule
+type fileCopier struct {
+	excludes  []string
+	overwrite bool
+	result    *FileCopyResult
 }
 
 // copyFilesToWorktree copies files from source worktrees to the newly created worktree
 func (m *Manager) copyFilesToWorktree(targetWorktreeName string) error {
+	result, err := m.runFileCopyRules(targetWorktreeName, false)
+	if err != nil {
+		return err
+	}
+
+	for _, skipped := range result.Skipped {
+		logInfo("File '%s' already exists in target worktree, skipping", filepath.Base(skipped))
+	}
+
+	return nil
+}
+
+// CopyFilesToWorktree re-runs the configured file copy rules against an existing worktree.
+// Existing files in the target are skipped unless overwrite is set.
+func (m *Manager) CopyFilesToWorktree(worktreeName string, overwrite bool) (*FileCopyResult, error) {
+	// Copying only needs the directory, so it works on worktrees git has lost track of too
+	worktreePath := filepath.Join(m.repoPath, m.config.Settings.WorktreePrefix, worktreeName)
+	if _, err := os.Stat(worktreePath); os.IsNotExist(err) {
+		return nil, fmt.Errorf("worktree directory '%s' does not exist", worktreeName)
+	}
+
+	return m.runFileCopyRules(worktreeName, overwrite)
+}
+
+// runFileCopyRules applies every file copy rule to the target worktree
+func (m *Manager) runFileCopyRules(targetWorktreeName string, overwrite bool) (*FileCopyResult, error) {
+	result := &FileCopyResult{}
 	if len(m.config.FileCopy.Rules) == 0 {
-		return nil
+		return result, nil
 	}
 
 	targetWorktreePath := filepath.Join(m.repoPath, m.config.Settings.WorktreePrefix, targetWorktreeName)
 
 	for _, rule := range m.config.FileCopy.Rules {
+		// A worktree is never its own copy target
+		if rule.SourceWorktree == targetWorktreeName {
+			continue
+		}
+
 		sourceWorktreePath := filepath.Join(m.repoPath, m.config.Settings.WorktreePrefix, rule.SourceWorktree)
 
 		// Check if source worktree exists
 		if _, err := os.Stat(sourceWorktreePath); os.IsNotExist(err) {
-			fmt.Printf("Warning: source worktree '%s' does not exist, skipping file copy rule\n", rule.SourceWorktree)
+			logWarn("source worktree '%s' does not exist, skipping file copy rule", rule.SourceWorktree)
 			continue
 		}
 
+		copier := &fileCopier{excludes: rule.Exclude, overwrite: overwrite, result: result}
+
 		for _, filePattern := range rule.Files {
-			if err := m.copyFileOrDirectory(sourceWorktreePath, targetWorktreePath, filePattern); err != nil {
-				fmt.Printf("Warning: failed to copy '%s' from '%s': %v\n", filePattern, rule.SourceWorktree, err)
+			paths, err := expandFilePattern(sourceWorktreePath, filePattern)
+			if err != nil {
+				logWarn("invalid file pattern '%s': %v", filePattern, err)
+				continue
+			}
+			if len(paths) == 0 {
+				logWarn("pattern '%s' matched no files in '%s', skipping", filePattern, rule.SourceWorktree)
+				continue
+			}
+
+			for _, filePath := range paths {
+				if err := copier.copyFileOrDirectory(sourceWorktreePath, targetWorktreePath, filePath); err != nil {
+					logWarn("failed to copy '%s' from '%s': %v", filePath, rule.SourceWorktree, err)
+				}
 			}
 		}
 	}
 
-	return nil
+	return result, nil
 }
 
-// copyFileOrDirectory copies a file or directory from source to target
-func (m *Manager) copyFileOrDirectory(sourceWorktreePath, targetWorktreePath, filePattern string) error {
-	sourcePath := filepath.Join(sourceWorktreePath, filePattern)
-	targetPath := filepath.Join(targetWorktreePath, filePattern)
+// expandFilePattern resolves a file copy entry to paths relative to the source worktree.
+// Entries without glob characters are returned unchanged so missing literal paths are still reported.
+func expandFilePattern(sourceWorktreePath, filePattern string) ([]string, error) {
+	if !strings.ContainsAny(filePattern, "*?[") {
+		return []string{filePattern}, nil
+	}
 
-	sourceInfo, err := os.Stat(sourcePath)
-	if os.IsNotExist(err) {
+	matches, err := filepath.Glob(filepath.Join(sourceWorktreePath, filePattern))
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(matches))
+	for _, match := range matches {
+		relPath, err := filepath.Rel(sourceWorktreePath, match)
+		if err != nil {
+			return nil, err
+		}
+		paths = append(paths, relPath)
+	}
+
+	return paths, nil
+}
+
+// copyFileOrDirectory copies a file or directory from source to target, skipping paths matched by excludes
+func (c *fileCopier) copyFileOrDirectory(sourceWorktreePath, targetWorktreePath, filePattern string) error {
+	sourcePath := filepath.Join(sourceWorktreePath, filePattern)
+	targetPath := filepath.Join(targetWorktreePath, filePattern)
+
+	relPath := filepath.Clean(filePattern)
+	if isExcludedPath(relPath, c.excludes) {
+		return nil
+	}
+
+	sourceInfo, err := os.Stat(sourcePath)
+	if os.IsNotExist(err) {
 		return fmt.Errorf("source file/directory '%s' does not exist", sourcePath)
 	}
 	if err != nil {
@@ -610,13 +1139,13 @@ func (m *Manager) copyFileOrDirectory(sourceWorktreePath, targetWorktreePath, fi
 	}
 
 	if sourceInfo.IsDir() {
-		return m.copyDirectory(sourcePath, targetPath)
+		return c.copyDirectory(sourcePath, targetPath, relPath)
 	}
-	return m.copyFile(sourcePath, targetPath)
+	return c.copyFile(sourcePath, targetPath, relPath)
 }
 
-// copyFile copies a single file from source to target
-func (m *Manager) copyFile(sourcePath, targetPath string) error {
+// copyFile copies a single file from source to target. relPath is recorded in the copy result.
+func (c *fileCopier) copyFile(sourcePath, targetPath, relPath string) error {
 	// Create target directory if it doesn't exist
 	targetDir := filepath.Dir(targetPath)
 	if err := os.MkdirAll(targetDir, 0o755); err != nil {
@@ -624,8 +1153,8 @@ func (m *Manager) copyFile(sourcePath, targetPath string) error {
 	}
 
 	// Check if target file already exists
-	if _, err := os.Stat(targetPath); err == nil {
-		fmt.Printf("File '%s' already exists in target worktree, skipping\n", filepath.Base(targetPath))
+	if _, err := os.Stat(targetPath); err == nil && !c.overwrite {
+		c.result.Skipped = append(c.result.Skipped, relPath)
 		return nil
 	}
 
@@ -657,11 +1186,13 @@ func (m *Manager) copyFile(sourcePath, targetPath string) error {
 		return fmt.Errorf("failed to set file permissions: %w", err)
 	}
 
+	c.result.Copied = append(c.result.Copied, relPath)
 	return nil
 }
 
-// copyDirectory recursively copies a directory from source to target
-func (m *Manager) copyDirectory(sourcePath, targetPath string) error {
+// copyDirectory recursively copies a directory from source to target. relPath is the directory's
+// path relative to the source worktree, used to match exclude patterns.
+func (c *fileCopier) copyDirectory(sourcePath, targetPath, relPath string) error {
 	// Create target directory
 	if err := os.MkdirAll(targetPath, 0o755); err != nil {
 		return fmt.Errorf("failed to create target directory: %w", err)
@@ -676,13 +1207,18 @@ func (m *Manager) copyDirectory(sourcePath, targetPath string) error {
 	for _, entry := range entries {
 		sourceEntryPath := filepath.Join(sourcePath, entry.Name())
 		targetEntryPath := filepath.Join(targetPath, entry.Name())
+		entryRelPath := filepath.Join(relPath, entry.Name())
+
+		if isExcludedPath(entryRelPath, c.excludes) {
+			continue
+		}
 
 		if entry.IsDir() {
-			if err := m.copyDirectory(sourceEntryPath, targetEntryPath); err != nil {
+			if err := c.copyDirectory(sourceEntryPath, targetEntryPath, entryRelPath); err != nil {
 				return err
 			}
 		} else {
-			if err := m.copyFile(sourceEntryPath, targetEntryPath); err != nil {
+			if err := c.copyFile(sourceEntryPath, targetEntryPath, entryRelPath); err != nil {
 				return err
 			}
 		}
@@ -691,6 +1227,24 @@ func (m *Manager) copyDirectory(sourcePath, targetPath string) error {
 	return nil
 }
 
+// isExcludedPath reports whether relPath matches any exclude glob. Like .gitignore, a pattern
+// containing a slash is matched against the path relative to the worktree root, while a pattern
+// without one is matched against the last path element at any depth.
+func isExcludedPath(relPath string, excludes []string) bool {
+	for _, pattern := range excludes {
+		pattern = strings.TrimSuffix(filepath.ToSlash(pattern), "/")
+		target := filepath.Base(relPath)
+		if strings.Contains(pattern, "/") {
+			pattern = strings.TrimPrefix(pattern, "/")
+			target = relPath
+		}
+		if matched, _ := filepath.Match(filepath.FromSlash(pattern), target); matched {
+			return true
+		}
+	}
+	return false
+}
+
 // Helper function to check if a slice contains a string
 func contains(slice []string, item string) bool {
 	return slices.Contains(slice, item)
@@ -700,6 +1254,21 @@ func (m *Manager) GetRemoteBranches() ([]string, error) {
 	return m.gitManager.GetRemoteBranches()
 }
 
+// GetAheadBehindRefs compares ref with baseRef, returning the commits unique to each side
+func (m *Manager) GetAheadBehindRefs(ref, baseRef string) (int, int, error) {
+	return m.gitManager.GetAheadBehindRefs(ref, baseRef)
+}
+
+// ListTags returns all tags, newest first
+func (m *Manager) ListTags() ([]string, error) {
+	return m.gitManager.ListTags()
+}
+
+// GetDefaultRemote returns the remote used for tracking and remote branch lookups
+func (m *Manager) GetDefaultRemote() string {
+	return m.gitManager.GetDefaultRemote()
+}
+
 func (m *Manager) GetCurrentBranch() (string, error) {
 	return m.gitManager.GetCurrentBranch()
 }
@@ -713,8 +1282,12 @@ func (m *Manager) GetGBMConfig() *GBMConfig {
 }
 
 func (m *Manager) PushWorktree(worktreeName string) error {
+	return m.PushWorktreeWithOptions(worktreeName, PushOptions{})
+}
+
+func (m *Manager) PushWorktreeWithOptions(worktreeName string, opts PushOptions) error {
 	worktreePath := filepath.Join(m.repoPath, m.config.Settings.WorktreePrefix, worktreeName)
-	return m.gitManager.PushWorktree(worktreePath)
+	return m.gitManager.PushWorktreeWithOptions(worktreePath, opts)
 }
 
 func (m *Manager) PullWorktree(worktreeName string) error {
@@ -722,54 +1295,205 @@ func (m *Manager) PullWorktree(worktreeName string) error {
 	return m.gitManager.PullWorktree(worktreePath)
 }
 
+// IsInWorktree reports whether currentPath is inside a worktree under the worktree prefix, and
+// if so which one
 func (m *Manager) IsInWorktree(currentPath string) (bool, string, error) {
-	return m.gitManager.IsInWorktree(currentPath)
+	toplevel, err := m.gitManager.GetToplevel(currentPath)
+	if err != nil {
+		return false, "", err
+	}
+
+	if !isWorktreeInDir(toplevel, m.resolvedWorktreePrefix()) {
+		return false, "", nil
+	}
+
+	return true, filepath.Base(toplevel), nil
+}
+
+// bulkConcurrency bounds how many worktrees a bulk push, pull or exec --parallel handles at once
+const bulkConcurrency = 4
+
+// ErrSkipped marks worktrees a fail-fast bulk operation didn't start after an earlier failure
+var ErrSkipped = errors.New("skipped after an earlier failure")
+
+// WorktreeResult is the outcome of a bulk operation on a single worktree
+type WorktreeResult struct {
+	Name string
+	Err  error
 }
 
 func (m *Manager) PushAllWorktrees() error {
-	worktrees, err := m.GetAllWorktrees()
+	_, err := m.PushAllWorktreesWithResults(PushOptions{}, false)
+	return err
+}
+
+// PushAllWorktreesWithResults pushes every worktree concurrently and returns a result per worktree,
+// sorted by name. The returned error aggregates all failures. Git is told not to prompt for
+// credentials, since concurrent prompts would interleave on the terminal.
+func (m *Manager) PushAllWorktreesWithResults(opts PushOptions, failFast bool) ([]WorktreeResult, error) {
+	opts.noPrompt = true
+	return m.forEachWorktree("push", bulkConcurrency, failFast, func(info *WorktreeListInfo) error {
+		return m.gitManager.PushWorktreeWithOptions(info.Path, opts)
+	})
+}
+
+func (m *Manager) PullAllWorktrees() error {
+	_, err := m.PullAllWorktreesWithResults(false)
+	return err
+}
+
+// PullAllWorktreesWithResults fetches once and then fast-forwards every worktree concurrently,
+// returning a result per worktree sorted by name. The returned error aggregates all failures.
+// Fetching up front keeps the worktrees from racing on the shared remote-tracking refs.
+func (m *Manager) PullAllWorktreesWithResults(failFast bool) ([]WorktreeResult, error) {
+	if err := m.gitManager.FetchAll(context.Background()); err != nil {
+		return nil, fmt.Errorf("failed to fetch: %w", err)
+	}
+
+	return m.forEachWorktree("pull", bulkConcurrency, failFast, func(info *WorktreeListInfo) error {
+		return m.gitManager.MergeUpstream(info.Path)
+	})
+}
+
+// SetExcludeMain leaves the main worktree out of PushAllWorktrees and PullAllWorktrees
+func (m *Manager) SetExcludeMain(exclude bool) {
+	m.excludeMain = exclude
+}
+
+// IsMainWorktree reports whether a worktree is the main one: a root of the merge_into tree in
+// gbm.branchconfig.yaml, or named after or configured on the default branch
+func (m *Manager) IsMainWorktree(name string) bool {
+	if m.gbmConfig != nil && m.gbmConfig.Tree != nil {
+		if node := m.gbmConfig.Tree.GetNode(name); node != nil && node.IsRoot() {
+			return true
+		}
+	}
+
+	defaultBranch, err := m.gitManager.GetDefaultBranch()
 	if err != nil {
-		return fmt.Errorf("failed to get worktrees: %w", err)
+		return false
 	}
 
-	for name, info := range worktrees {
-		fmt.Printf("Pushing worktree '%s'...\n", name)
-		if err := m.gitManager.PushWorktree(info.Path); err != nil {
-			fmt.Printf("Failed to push worktree '%s': %v\n", name, err)
-			continue
+	if m.gbmConfig != nil {
+		if wt, exists := m.gbmConfig.Worktrees[name]; exists && wt.Branch == defaultBranch {
+			return true
 		}
-		fmt.Printf("Successfully pushed worktree '%s'\n", name)
 	}
 
-	return nil
+	return name == defaultBranch
 }
 
-func (m *Manager) PullAllWorktrees() error {
+// forEachWorktree runs op on every worktree, less the main one when excluded, across a pool of at
+// most maxConcurrency workers. With
+// failFast, worktrees not yet started when an operation fails are reported as ErrSkipped.
+func (m *Manager) forEachWorktree(action string, maxConcurrency int, failFast bool, op func(info *WorktreeListInfo) error) ([]WorktreeResult, error) {
 	worktrees, err := m.GetAllWorktrees()
 	if err != nil {
-		return fmt.Errorf("failed to get worktrees: %w", err)
+		return nil, fmt.Errorf("failed to get worktrees: %w", err)
 	}
 
-	for name, info := range worktrees {
-		fmt.Printf("Pulling worktree '%s'...\n", name)
-		if err := m.gitManager.PullWorktree(info.Path); err != nil {
-			fmt.Printf("Failed to pull worktree '%s': %v\n", name, err)
-			continue
+	if m.excludeMain {
+		maps.DeleteFunc(worktrees, func(name string, _ *WorktreeListInfo) bool { return m.IsMainWorktree(name) })
+	}
+
+	names := slices.Sorted(maps.Keys(worktrees))
+	results := make([]WorktreeResult, len(names))
+	jobs := make(chan int)
+	var failed atomic.Bool
+	var wg sync.WaitGroup
+
+	for range min(maxConcurrency, len(names)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i].Name = names[i]
+				if failFast && failed.Load() {
+					results[i].Err = ErrSkipped
+					continue
+				}
+
+				logVerbose("Running %s in worktree '%s'", action, names[i])
+				if err := op(worktrees[names[i]]); err != nil {
+					results[i].Err = err
+					failed.Store(true)
+				}
+			}
+		}()
+	}
+
+	for i := range names {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	var errs []error
+	for _, result := range results {
+		if result.Err != nil && !errors.Is(result.Err, ErrSkipped) {
+			errs = append(errs, fmt.Errorf("%s: %w", result.Name, result.Err))
 		}
-		fmt.Printf("Successfully pulled worktree '%s'\n", name)
+	}
+	if len(errs) > 0 {
+		return results, fmt.Errorf("%s failed for %d of %d worktrees: %w", action, len(errs), len(names), errors.Join(errs...))
 	}
 
-	return nil
+	return results, nil
 }
 
 func (m *Manager) RemoveWorktree(worktreeName string) error {
+	return m.removeWorktree(worktreeName, true, true)
+}
+
+// RemoveWorktreeWithoutForce removes a worktree only if it has no uncommitted changes.
+// Returns an error wrapping ErrWorktreeDirty if git refuses the removal.
+func (m *Manager) RemoveWorktreeWithoutForce(worktreeName string) error {
+	return m.removeWorktree(worktreeName, false, false)
+}
+
+// RemoveArchivedWorktree removes a worktree whose changes were archived first. Uncommitted changes
+// are discarded, but unlike RemoveWorktree a failing pre_remove hook still aborts the removal.
+func (m *Manager) RemoveArchivedWorktree(worktreeName string) error {
+	return m.removeWorktree(worktreeName, false, true)
+}
+
+// removeWorktree removes a worktree. force lets it proceed past failing pre_remove hooks and
+// discardChanges lets git remove a worktree with uncommitted changes.
+func (m *Manager) removeWorktree(worktreeName string, force, discardChanges bool) error {
+	unlock, err := m.lockRepo(true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
 	worktreePath := filepath.Join(m.repoPath, m.config.Settings.WorktreePrefix, worktreeName)
 
+	registered, _, err := m.gitManager.WorktreeExists(worktreePath)
+	if err != nil {
+		return err
+	}
+	if !registered {
+		return fmt.Errorf("cannot remove '%s': %w: %s", worktreeName, ErrWorktreeNotRegistered, worktreePath)
+	}
+
+	if err := m.runPreRemoveHooks(worktreeName, worktreePath); err != nil {
+		if !force {
+			return fmt.Errorf("%w; use --force to remove anyway", err)
+		}
+		logWarn("%v", err)
+	}
+
 	// Remove the worktree using git
-	if err := m.gitManager.RemoveWorktree(worktreePath); err != nil {
+	removeFunc := m.gitManager.RemoveWorktreeWithoutForce
+	if discardChanges {
+		removeFunc = m.gitManager.RemoveWorktree
+	}
+	if err := removeFunc(worktreePath); err != nil {
 		return fmt.Errorf("failed to remove worktree: %w", err)
 	}
 
+	m.runPostRemoveHooks(worktreeName, worktreePath)
+
 	// Remove from ad hoc worktrees list if it exists there
 	for i, name := range m.state.AdHocWorktrees {
 		if name == worktreeName {
@@ -780,16 +1504,274 @@ func (m *Manager) RemoveWorktree(worktreeName string) error {
 
 	// Remove base branch information
 	m.state.RemoveWorktreeBaseBranch(worktreeName)
+	m.state.RemoveSparsePatterns(worktreeName)
 
 	// Save the updated state
 	if err := m.SaveState(); err != nil {
 		// Log warning but don't fail the operation
-		fmt.Printf("Warning: failed to save state: %v\n", err)
+		logWarn("failed to save state: %v", err)
+	}
+
+	return nil
+}
+
+// NextAvailableWorktreeName returns base if no worktree uses that name, otherwise the first of
+// base-2, base-3, ... that is free. A name is taken when a worktree with it is registered with git,
+// its directory exists, or gbm.branchconfig.yaml reserves it for a tracked worktree.
+func (m *Manager) NextAvailableWorktreeName(base string) string {
+	taken := make(map[string]bool)
+	if worktrees, err := m.gitManager.GetWorktrees(); err != nil {
+		logVerbose("Failed to list worktrees: %v", err)
+	} else {
+		for _, wt := range worktrees {
+			taken[wt.Name] = true
+		}
+	}
+	if m.gbmConfig != nil {
+		for name := range m.gbmConfig.Worktrees {
+			taken[name] = true
+		}
+	}
+
+	worktreeDir := filepath.Join(m.repoPath, m.config.Settings.WorktreePrefix)
+	isFree := func(name string) bool {
+		return !taken[name] && !pathExists(filepath.Join(worktreeDir, name))
+	}
+
+	if isFree(base) {
+		return base
+	}
+	for i := 2; ; i++ {
+		if candidate := fmt.Sprintf("%s-%d", base, i); isFree(candidate) {
+			return candidate
+		}
+	}
+}
+
+// RenameWorktree renames an ad hoc worktree directory and migrates its state entries to the new name.
+// Worktrees tracked in gbm.branchconfig.yaml can't be renamed since their names come from the config.
+func (m *Manager) RenameWorktree(oldName, newName string) error {
+	if oldName == newName {
+		return fmt.Errorf("worktree '%s' already has that name", oldName)
+	}
+	if err := ValidateWorktreeName(newName); err != nil {
+		return err
+	}
+
+	unlock, err := m.lockRepo(true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if m.gbmConfig != nil {
+		if _, exists := m.gbmConfig.Worktrees[oldName]; exists {
+			return fmt.Errorf("worktree '%s' is tracked in %s; rename it there and run 'gbm sync' instead", oldName, DefaultBranchConfigFilename)
+		}
+		if _, exists := m.gbmConfig.Worktrees[newName]; exists {
+			return fmt.Errorf("worktree name '%s' is reserved by %s", newName, DefaultBranchConfigFilename)
+		}
+	}
+
+	oldPath := filepath.Join(m.repoPath, m.config.Settings.WorktreePrefix, oldName)
+	newPath := filepath.Join(m.repoPath, m.config.Settings.WorktreePrefix, newName)
+
+	if _, err := os.Stat(oldPath); os.IsNotExist(err) {
+		return fmt.Errorf("worktree '%s' does not exist", oldName)
+	}
+	if _, err := os.Stat(newPath); err == nil {
+		return fmt.Errorf("worktree '%s' already exists", newName)
+	}
+
+	if err := m.gitManager.MoveWorktree(oldPath, newPath); err != nil {
+		return fmt.Errorf("failed to rename worktree: %w", err)
+	}
+
+	for i, name := range m.state.AdHocWorktrees {
+		if name == oldName {
+			m.state.AdHocWorktrees[i] = newName
+			break
+		}
+	}
+
+	if baseBranch, exists := m.state.GetWorktreeBaseBranch(oldName); exists {
+		m.state.RemoveWorktreeBaseBranch(oldName)
+		m.state.SetWorktreeBaseBranch(newName, baseBranch)
+	}
+	if patterns, exists := m.state.GetSparsePatterns(oldName); exists {
+		m.state.RemoveSparsePatterns(oldName)
+		m.state.SetSparsePatterns(newName, patterns)
+	}
+
+	if m.state.CurrentWorktree == oldName {
+		m.state.CurrentWorktree = newName
+	}
+	if m.state.PreviousWorktree == oldName {
+		m.state.PreviousWorktree = newName
+	}
+
+	if err := m.SaveState(); err != nil {
+		return fmt.Errorf("worktree renamed but failed to save state: %w", err)
 	}
 
 	return nil
 }
 
+// PrunableBranch describes a local mergeback branch that is no longer checked out in any worktree
+type PrunableBranch struct {
+	Name   string
+	Target string
+	Merged bool
+}
+
+// PruneWorktrees removes git metadata for worktrees whose directories no longer exist
+func (m *Manager) PruneWorktrees(dryRun bool) ([]string, error) {
+	return m.gitManager.PruneWorktrees(dryRun)
+}
+
+// FindPrunableMergeBranches returns local mergeback branches (merge/ by default, see the
+// merge_branch_prefix setting) that are not checked out in any worktree.
+// When mergedOnly is set, only branches already merged into their target are returned.
+func (m *Manager) FindPrunableMergeBranches(mergedOnly bool) ([]PrunableBranch, error) {
+	// Without a prefix, mergeback branches can't be told apart from any other branch
+	prefix := m.config.Settings.MergeBranchPrefix
+	if prefix == "" {
+		return nil, nil
+	}
+
+	branches, err := m.gitManager.GetLocalBranchesWithPrefix(prefix + "/")
+	if err != nil {
+		return nil, err
+	}
+
+	worktrees, err := m.gitManager.GetWorktrees()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get worktrees: %w", err)
+	}
+
+	checkedOut := make(map[string]bool)
+	for _, wt := range worktrees {
+		checkedOut[wt.Branch] = true
+	}
+
+	var prunable []PrunableBranch
+	for _, branch := range branches {
+		if checkedOut[branch] {
+			continue
+		}
+
+		target := m.mergeBranchTarget(branch)
+		merged := false
+		if target != "" {
+			// Prefer the remote ref since mergebacks are usually completed through a pull request
+			targetRef := target
+			if exists, err := m.gitManager.VerifyRef(m.gitManager.remoteBranch(target)); err == nil && exists {
+				targetRef = m.gitManager.remoteBranch(target)
+			}
+			merged, err = m.gitManager.IsBranchMergedInto(branch, targetRef)
+			if err != nil {
+				merged = false
+			}
+		}
+
+		if mergedOnly && !merged {
+			continue
+		}
+
+		prunable = append(prunable, PrunableBranch{
+			Name:   branch,
+			Target: target,
+			Merged: merged,
+		})
+	}
+
+	return prunable, nil
+}
+
+// mergeBranchTarget resolves the branch a mergeback branch merges into.
+// Mergeback branches are named <prefix>/<worktree>_<target-worktree>, so the suffix is
+// matched against tracked worktrees, falling back to the default branch.
+func (m *Manager) mergeBranchTarget(branch string) string {
+	if idx := strings.LastIndex(branch, "_"); idx != -1 && m.gbmConfig != nil {
+		targetWorktree := branch[idx+1:]
+		for worktreeName, worktreeConfig := range m.gbmConfig.Worktrees {
+			if strings.EqualFold(worktreeName, targetWorktree) {
+				return worktreeConfig.Branch
+			}
+		}
+	}
+
+	defaultBranch, err := m.gitManager.GetDefaultBranch()
+	if err != nil {
+		return ""
+	}
+	return defaultBranch
+}
+
+// DeleteLocalBranch deletes a local branch, forcing deletion of unmerged branches when force is set
+func (m *Manager) DeleteLocalBranch(branchName string, force bool) error {
+	return m.gitManager.DeleteLocalBranch(branchName, force)
+}
+
+// DeleteRemoteBranch deletes a branch on the given remote
+func (m *Manager) DeleteRemoteBranch(remoteName, branchName string) error {
+	return m.gitManager.DeleteRemoteBranch(remoteName, branchName)
+}
+
+// ReconcileWorktreeState drops ad hoc and base branch state entries for worktrees whose
+// directories no longer exist. Returns the names that were (or would be) dropped.
+func (m *Manager) ReconcileWorktreeState(dryRun bool) ([]string, error) {
+	if !dryRun {
+		unlock, err := m.lockRepo(true)
+		if err != nil {
+			return nil, err
+		}
+		defer unlock()
+	}
+
+	stale := make(map[string]bool)
+	isMissing := func(worktreeName string) bool {
+		worktreePath := filepath.Join(m.repoPath, m.config.Settings.WorktreePrefix, worktreeName)
+		_, err := os.Stat(worktreePath)
+		return os.IsNotExist(err)
+	}
+
+	for _, name := range m.state.AdHocWorktrees {
+		if isMissing(name) {
+			stale[name] = true
+		}
+	}
+	for name := range m.state.WorktreeBaseBranch {
+		if isMissing(name) {
+			stale[name] = true
+		}
+	}
+
+	removed := make([]string, 0, len(stale))
+	for name := range stale {
+		removed = append(removed, name)
+	}
+	sort.Strings(removed)
+
+	if dryRun || len(removed) == 0 {
+		return removed, nil
+	}
+
+	m.state.AdHocWorktrees = slices.DeleteFunc(m.state.AdHocWorktrees, func(name string) bool {
+		return stale[name]
+	})
+	for _, name := range removed {
+		m.state.RemoveWorktreeBaseBranch(name)
+		m.state.RemoveSparsePatterns(name)
+	}
+
+	if err := m.SaveState(); err != nil {
+		return nil, fmt.Errorf("failed to save state: %w", err)
+	}
+
+	return removed, nil
+}
+
 func (m *Manager) GetWorktreeStatus(worktreePath string) (*GitStatus, error) {
 	return m.gitManager.GetWorktreeStatus(worktreePath)
 }
@@ -823,11 +1805,29 @@ func (m *Manager) GetRepoPath() string {
 	return m.repoPath
 }
 
+// GetGitPath returns the directory to run repository-wide git commands in. It differs from
+// GetRepoPath in bare repositories, where the repo path is the directory containing the git data.
+func (m *Manager) GetGitPath() string {
+	return m.gitPath
+}
+
 func (m *Manager) SaveConfig() error {
+	unlock, err := m.lockRepo(false)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
 	return m.config.Save(m.gbmDir)
 }
 
 func (m *Manager) SaveState() error {
+	unlock, err := m.lockRepo(false)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
 	return m.state.Save(m.gbmDir)
 }
 
@@ -855,21 +1855,22 @@ func (m *Manager) GetSortedWorktreeNames(worktrees map[string]*WorktreeListInfo)
 	// Sort tracked names alphabetically
 	sort.Strings(trackedNames)
 
-	// Sort ad hoc names by creation time (directory modification time) descending
-	sort.Slice(adHocNames, func(i, j int) bool {
-		pathI := worktrees[adHocNames[i]].Path
-		pathJ := worktrees[adHocNames[j]].Path
-
-		statI, errI := os.Stat(pathI)
-		statJ, errJ := os.Stat(pathJ)
-
-		// If we can't get stats, fall back to alphabetical
-		if errI != nil || errJ != nil {
-			return adHocNames[i] < adHocNames[j]
+	// Stat each directory once; worktrees whose directory can't be read sort as the oldest
+	modTimes := make(map[string]time.Time, len(adHocNames))
+	for _, name := range adHocNames {
+		if stat, err := os.Stat(worktrees[name].Path); err == nil {
+			modTimes[name] = stat.ModTime()
 		}
+	}
 
-		// Sort by modification time descending (newer first)
-		return statI.ModTime().After(statJ.ModTime())
+	// Sort ad hoc names by creation time (directory modification time) descending,
+	// then by name so worktrees created in the same batch keep a stable order
+	sort.Slice(adHocNames, func(i, j int) bool {
+		timeI, timeJ := modTimes[adHocNames[i]], modTimes[adHocNames[j]]
+		if !timeI.Equal(timeJ) {
+			return timeI.After(timeJ)
+		}
+		return adHocNames[i] < adHocNames[j]
 	})
 
 	// Return tracked worktrees first, then ad hoc worktrees
@@ -889,6 +1890,16 @@ func (m *Manager) GetWorktreeCommitHistory(worktreePath string, limit int) ([]Co
 	})
 }
 
+// GetCommitHistory retrieves the commit history of a worktree filtered by options
+func (m *Manager) GetCommitHistory(worktreePath string, options CommitHistoryOptions) ([]CommitInfo, error) {
+	return m.gitManager.GetCommitHistory(worktreePath, options)
+}
+
+// GetRangeFileChanges returns the files changed in a revision range such as "main...feature"
+func (m *Manager) GetRangeFileChanges(revRange string) ([]FileChange, error) {
+	return m.gitManager.GetFileChanges(m.gitPath, FileChangeOptions{Range: revRange})
+}
+
 // GetWorktreeFileChanges retrieves modified files for a specific worktree
 func (m *Manager) GetWorktreeFileChanges(worktreePath string) ([]FileChange, error) {
 	return m.gitManager.GetFileChanges(worktreePath, FileChangeOptions{
@@ -897,6 +1908,11 @@ func (m *Manager) GetWorktreeFileChanges(worktreePath string) ([]FileChange, err
 	})
 }
 
+// GetWorktreeStashes returns the stashes created on the worktree's branch
+func (m *Manager) GetWorktreeStashes(worktreePath string) ([]StashEntry, error) {
+	return m.gitManager.GetStashList(worktreePath)
+}
+
 // GetWorktreeCurrentBranch gets the current branch for a specific worktree
 func (m *Manager) GetWorktreeCurrentBranch(worktreePath string) (string, error) {
 	return m.gitManager.GetCurrentBranchInPath(worktreePath)
@@ -907,9 +1923,14 @@ func (m *Manager) GetWorktreeUpstreamBranch(worktreePath string) (string, error)
 	return m.gitManager.GetUpstreamBranch(worktreePath)
 }
 
-// GetWorktreeAheadBehindCount gets the ahead/behind count for a specific worktree
-func (m *Manager) GetWorktreeAheadBehindCount(worktreePath string) (int, int, error) {
-	return m.gitManager.GetAheadBehindCount(worktreePath)
+// GetWorktreeAheadBehindAgainst gets the ahead/behind count of a specific worktree relative to ref
+func (m *Manager) GetWorktreeAheadBehindAgainst(worktreePath, ref string) (int, int, error) {
+	return m.gitManager.GetAheadBehindAgainst(worktreePath, ref)
+}
+
+// GetWorktreeMergeBase gets the commit where a worktree's HEAD diverged from the given base branch
+func (m *Manager) GetWorktreeMergeBase(worktreePath, baseBranch string) (string, time.Time, error) {
+	return m.gitManager.GetMergeBaseWithTime(worktreePath, "HEAD", baseBranch)
 }
 
 // VerifyWorktreeRef verifies if a ref exists in a specific worktree
@@ -917,6 +1938,61 @@ func (m *Manager) VerifyWorktreeRef(ref string, worktreePath string) (bool, erro
 	return m.gitManager.VerifyRefInPath(worktreePath, ref)
 }
 
+// IsMergeInProgress reports whether the worktree has an unfinished merge
+func (m *Manager) IsMergeInProgress(worktreePath string) (bool, error) {
+	return m.gitManager.IsMergeInProgress(worktreePath)
+}
+
+// ListConflictedFiles returns the files with unresolved merge conflicts in the worktree
+func (m *Manager) ListConflictedFiles(worktreePath string) ([]string, error) {
+	return m.gitManager.ListConflictedFiles(worktreePath)
+}
+
+// RebaseWorktree rebases the worktree's branch onto ontoRef
+func (m *Manager) RebaseWorktree(worktreePath, ontoRef string) error {
+	return m.gitManager.RebaseWorktree(worktreePath, ontoRef)
+}
+
+// ContinueRebase resumes the in-progress rebase in the worktree
+func (m *Manager) ContinueRebase(worktreePath string) error {
+	return m.gitManager.ContinueRebase(worktreePath)
+}
+
+// AbortRebase abandons the in-progress rebase in the worktree
+func (m *Manager) AbortRebase(worktreePath string) error {
+	return m.gitManager.AbortRebase(worktreePath)
+}
+
+// IsRebaseInProgress reports whether the worktree has an unfinished rebase
+func (m *Manager) IsRebaseInProgress(worktreePath string) (bool, error) {
+	return m.gitManager.IsRebaseInProgress(worktreePath)
+}
+
+// CherryPick applies commits onto the worktree's branch
+func (m *Manager) CherryPick(worktreePath string, commits []string) error {
+	return m.gitManager.CherryPick(worktreePath, commits)
+}
+
+// ContinueCherryPick resumes the in-progress cherry-pick in the worktree
+func (m *Manager) ContinueCherryPick(worktreePath string) error {
+	return m.gitManager.ContinueCherryPick(worktreePath)
+}
+
+// CherryPickAbort abandons the in-progress cherry-pick in the worktree
+func (m *Manager) CherryPickAbort(worktreePath string) error {
+	return m.gitManager.CherryPickAbort(worktreePath)
+}
+
+// IsCherryPickInProgress reports whether the worktree has an unfinished cherry-pick
+func (m *Manager) IsCherryPickInProgress(worktreePath string) (bool, error) {
+	return m.gitManager.IsCherryPickInProgress(worktreePath)
+}
+
+// AbortMerge abandons an in-progress merge in the worktree
+func (m *Manager) AbortMerge(worktreePath string) error {
+	return m.gitManager.AbortMerge(worktreePath)
+}
+
 // GetWorktrees retrieves all worktrees from the git repository
 func (m *Manager) GetWorktrees() ([]*WorktreeInfo, error) {
 	return m.gitManager.GetWorktrees()
@@ -924,9 +2000,45 @@ func (m *Manager) GetWorktrees() ([]*WorktreeInfo, error) {
 
 // JIRA interaction methods - delegate to jira package
 
-// GetJiraTicketDetails retrieves detailed JIRA ticket information using the JIRA CLI
+// GetJiraTicketDetails retrieves detailed JIRA ticket information using the JIRA CLI. Results are
+// cached in .gbm/jira-cache for jira.cache_ttl. When the CLI doesn't report a link, it is built
+// from jira.base_url.
 func (m *Manager) GetJiraTicketDetails(jiraKey string) (*JiraTicketDetails, error) {
-	return GetJiraTicketDetails(jiraKey)
+	ttl := m.config.Jira.CacheTTL
+	cacheDir := filepath.Join(m.gbmDir, DefaultJiraCacheDirname)
+
+	var ticket *JiraTicketDetails
+	cached := false
+	if ttl > 0 {
+		ticket, cached = readJiraCache(cacheDir, jiraKey, ttl, time.Now())
+	}
+
+	if !cached {
+		var err error
+		ticket, err = GetJiraTicketDetails(jiraKey)
+		if err != nil {
+			return nil, err
+		}
+
+		if ttl > 0 {
+			if err := writeJiraCache(cacheDir, jiraKey, ticket, time.Now()); err != nil {
+				logVerbose("Failed to cache JIRA ticket %s: %v", jiraKey, err)
+			}
+		}
+	}
+
+	if ticket.URL == "" {
+		ticket.URL = m.BuildJiraURL(jiraKey)
+	}
+	return ticket, nil
+}
+
+// BuildJiraURL returns the <jira.base_url>/browse/<key> link for a ticket, or "" when jira.base_url is not set
+func (m *Manager) BuildJiraURL(key string) string {
+	if m.config.Jira.BaseURL == "" {
+		return ""
+	}
+	return jiraBrowseURL(m.config.Jira.BaseURL, key)
 }
 
 // FindProductionBranch finds the actual production deployment branch for hotfixes
@@ -161,3 +161,41 @@ func TestManager_AddWorktree_Integration(t *testing.T) {
 		})
 	}
 }
+
+func TestManager_AddWorktree_FromWorktreeHead(t *testing.T) {
+	manager, repoPath, _ := setupManagerForRemoverTests(t)
+
+	devPath := filepath.Join(repoPath, "worktrees", "dev")
+	devHead, err := manager.GetGitManager().GetCommitHashInPath(devPath, "HEAD")
+	require.NoError(t, err)
+
+	// HEAD is resolved in the given worktree, not the repository root
+	base, err := manager.ResolveCommitInPath(devPath, "HEAD")
+	require.NoError(t, err)
+	assert.Equal(t, devHead, base)
+
+	_, err = manager.ResolveCommitInPath(devPath, "no-such-ref")
+	assert.ErrorContains(t, err, "is not a branch, tag or commit")
+
+	require.NoError(t, manager.AddWorktree("experiment", "experiment/try", true, base))
+
+	experimentHead, err := manager.GetGitManager().GetCommitHashInPath(filepath.Join(repoPath, "worktrees", "experiment"), "HEAD")
+	require.NoError(t, err)
+	assert.Equal(t, devHead, experimentHead)
+
+	storedBase, exists := manager.GetState().GetWorktreeBaseBranch("experiment")
+	assert.True(t, exists)
+	assert.Equal(t, devHead, storedBase)
+}
+
+func TestManager_NextAvailableWorktreeName(t *testing.T) {
+	manager, repoPath, _ := setupManagerForRemoverTests(t)
+
+	assert.Equal(t, "experiment", manager.NextAvailableWorktreeName("experiment"))
+	assert.Equal(t, "dev-2", manager.NextAvailableWorktreeName("dev"))
+
+	// Leftover directories and names reserved by gbm.branchconfig.yaml count as taken too
+	must(t, os.MkdirAll(filepath.Join(repoPath, "worktrees", "dev-2"), 0o755))
+	manager.gbmConfig = &GBMConfig{Worktrees: map[string]WorktreeConfig{"dev-3": {Branch: "dev-3"}}}
+	assert.Equal(t, "dev-4", manager.NextAvailableWorktreeName("dev"))
+}
@@ -0,0 +1,53 @@
+package internal
+
+import (
+	"path/filepath"
+	"testing"
+
+	"gbm/internal/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewManager_BareRepository(t *testing.T) {
+	sourceRepo := testutils.NewMultiBranchRepo(t)
+	defer sourceRepo.Cleanup()
+
+	// Worktree-only layout: bare clone at project/repo.git with no working tree
+	projectDir := t.TempDir()
+	bareDir := filepath.Join(projectDir, "repo.git")
+	must(t, execGitCommandRun(projectDir, "clone", "--bare", sourceRepo.GetRemotePath(), bareDir))
+
+	assert.True(t, IsBareRepository(bareDir))
+	assert.False(t, IsBareRepository(sourceRepo.GetLocalPath()))
+
+	manager, err := NewManager(bareDir)
+	require.NoError(t, err)
+
+	resolvedProjectDir, err := filepath.EvalSymlinks(projectDir)
+	require.NoError(t, err)
+	assert.Equal(t, resolvedProjectDir, manager.GetRepoPath())
+	assert.Equal(t, bareDir, manager.GetGitPath())
+
+	// Repository-wide git commands run against the bare repository, not its parent
+	changes, err := manager.GetRangeFileChanges("main...develop")
+	require.NoError(t, err)
+	assert.NotEmpty(t, changes)
+
+	require.NoError(t, manager.SaveConfig())
+	assert.FileExists(t, filepath.Join(projectDir, DefaultConfigDirname, DefaultConfigFilename))
+	assert.NoDirExists(t, filepath.Join(bareDir, DefaultConfigDirname))
+
+	require.NoError(t, manager.AddWorktree("dev", "develop", false, ""))
+	worktreePath := filepath.Join(projectDir, DefaultWorktreeDirname, "dev")
+	assert.DirExists(t, worktreePath)
+
+	branch, err := manager.GetGitManager().GetCurrentBranchInPath(worktreePath)
+	require.NoError(t, err)
+	assert.Equal(t, "develop", branch)
+
+	path, err := manager.GetWorktreePath("dev")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(resolvedProjectDir, DefaultWorktreeDirname, "dev"), path)
+}
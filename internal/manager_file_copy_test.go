@@ -1,6 +1,7 @@
 package internal
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"testing"
@@ -119,9 +120,16 @@ func TestCopyFilesToWorktree_SourceNotExists(t *testing.T) {
 		},
 	}
 
-	// Should not fail when source worktree doesn't exist
+	var warnings []string
+	SetLogger(Logger{Warn: func(format string, args ...any) {
+		warnings = append(warnings, fmt.Sprintf(format, args...))
+	}})
+	defer SetLogger(Logger{})
+
+	// Should not fail when source worktree doesn't exist, only warn
 	err = manager.copyFilesToWorktree("test-worktree")
 	assert.NoError(t, err)
+	assert.Equal(t, []string{"source worktree 'nonexistent' does not exist, skipping file copy rule"}, warnings)
 }
 
 func TestAddWorktree_TrackedWorktreeNoFileCopy(t *testing.T) {
@@ -253,3 +261,161 @@ func TestAddWorktree_AdHocWorktreeFileCopy(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, envContent, string(copiedEnvContent))
 }
+
+func TestCopyFilesToWorktree_Exclude(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	manager := &Manager{
+		repoPath: tmpDir,
+		config: &Config{
+			Settings: ConfigSettings{
+				WorktreePrefix: DefaultWorktreeDirname,
+			},
+			FileCopy: ConfigFileCopy{
+				Rules: []FileCopyRule{
+					{
+						SourceWorktree: "main",
+						Files:          []string{"config/", ".env.local", "tools"},
+						Exclude:        []string{"*.secret", "node_modules/", "config/local/*.yaml", ".env.local"},
+					},
+				},
+			},
+		},
+	}
+
+	sourceWorktreePath := filepath.Join(tmpDir, "worktrees", "main")
+	files := map[string]string{
+		"config/app.yaml":                 "app",
+		"config/db.secret":                "password",
+		"config/local/dev.yaml":           "dev",
+		"config/local/README.md":          "readme",
+		"config/nested/api.secret":        "token",
+		".env.local":                      "LOCAL=1",
+		"tools/run.sh":                    "echo run",
+		"tools/node_modules/dep/index.js": "module.exports = {}",
+	}
+	for name, content := range files {
+		path := filepath.Join(sourceWorktreePath, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	}
+
+	targetWorktreePath := filepath.Join(tmpDir, "worktrees", "feature")
+	require.NoError(t, os.MkdirAll(targetWorktreePath, 0o755))
+
+	require.NoError(t, manager.copyFilesToWorktree("feature"))
+
+	assert.FileExists(t, filepath.Join(targetWorktreePath, "config", "app.yaml"))
+	assert.FileExists(t, filepath.Join(targetWorktreePath, "config", "local", "README.md"))
+	assert.FileExists(t, filepath.Join(targetWorktreePath, "tools", "run.sh"))
+
+	// Basename patterns match at any depth
+	assert.NoFileExists(t, filepath.Join(targetWorktreePath, "config", "db.secret"))
+	assert.NoFileExists(t, filepath.Join(targetWorktreePath, "config", "nested", "api.secret"))
+	assert.NoDirExists(t, filepath.Join(targetWorktreePath, "tools", "node_modules"))
+
+	// Patterns with a slash match the path from the worktree root
+	assert.NoFileExists(t, filepath.Join(targetWorktreePath, "config", "local", "dev.yaml"))
+
+	// Exclude wins over an explicit Files entry
+	assert.NoFileExists(t, filepath.Join(targetWorktreePath, ".env.local"))
+}
+
+func TestCopyFilesToWorktree_GlobPatterns(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	manager := &Manager{
+		repoPath: tmpDir,
+		config: &Config{
+			Settings: ConfigSettings{
+				WorktreePrefix: DefaultWorktreeDirname,
+			},
+			FileCopy: ConfigFileCopy{
+				Rules: []FileCopyRule{
+					{
+						SourceWorktree: "main",
+						Files:          []string{"*.env", "config/*.local.yaml", "*.missing", "[invalid"},
+						Exclude:        []string{"prod.env"},
+					},
+				},
+			},
+		},
+	}
+
+	sourceWorktreePath := filepath.Join(tmpDir, "worktrees", "main")
+	for _, name := range []string{"dev.env", "test.env", "prod.env", "README.md", "config/app.local.yaml", "config/app.yaml"} {
+		path := filepath.Join(sourceWorktreePath, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(name), 0o644))
+	}
+
+	targetWorktreePath := filepath.Join(tmpDir, "worktrees", "feature")
+	require.NoError(t, os.MkdirAll(targetWorktreePath, 0o755))
+
+	// Patterns that match nothing or are malformed only produce warnings
+	require.NoError(t, manager.copyFilesToWorktree("feature"))
+
+	assert.FileExists(t, filepath.Join(targetWorktreePath, "dev.env"))
+	assert.FileExists(t, filepath.Join(targetWorktreePath, "test.env"))
+	assert.FileExists(t, filepath.Join(targetWorktreePath, "config", "app.local.yaml"))
+
+	assert.NoFileExists(t, filepath.Join(targetWorktreePath, "prod.env"), "excluded files are not copied")
+	assert.NoFileExists(t, filepath.Join(targetWorktreePath, "README.md"))
+	assert.NoFileExists(t, filepath.Join(targetWorktreePath, "config", "app.yaml"))
+}
+
+func TestManager_CopyFilesToWorktree(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	manager := &Manager{
+		repoPath: tmpDir,
+		config: &Config{
+			Settings: ConfigSettings{
+				WorktreePrefix: DefaultWorktreeDirname,
+			},
+			FileCopy: ConfigFileCopy{
+				Rules: []FileCopyRule{
+					{SourceWorktree: "main", Files: []string{".env", "config/"}},
+				},
+			},
+		},
+	}
+
+	sourceWorktreePath := filepath.Join(tmpDir, "worktrees", "main")
+	require.NoError(t, os.MkdirAll(filepath.Join(sourceWorktreePath, "config"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(sourceWorktreePath, ".env"), []byte("API_KEY=new"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(sourceWorktreePath, "config", "app.json"), []byte("{}"), 0o644))
+
+	targetWorktreePath := filepath.Join(tmpDir, "worktrees", "feature")
+	require.NoError(t, os.MkdirAll(targetWorktreePath, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(targetWorktreePath, ".env"), []byte("API_KEY=old"), 0o644))
+
+	result, err := manager.CopyFilesToWorktree("feature", false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join("config", "app.json")}, result.Copied)
+	assert.Equal(t, []string{".env"}, result.Skipped)
+
+	content, err := os.ReadFile(filepath.Join(targetWorktreePath, ".env"))
+	require.NoError(t, err)
+	assert.Equal(t, "API_KEY=old", string(content))
+
+	result, err = manager.CopyFilesToWorktree("feature", true)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{".env", filepath.Join("config", "app.json")}, result.Copied)
+	assert.Empty(t, result.Skipped)
+
+	content, err = os.ReadFile(filepath.Join(targetWorktreePath, ".env"))
+	require.NoError(t, err)
+	assert.Equal(t, "API_KEY=new", string(content))
+
+	// The source worktree is never copied onto itself
+	result, err = manager.CopyFilesToWorktree("main", true)
+	require.NoError(t, err)
+	assert.Empty(t, result.Copied)
+	content, err = os.ReadFile(filepath.Join(sourceWorktreePath, ".env"))
+	require.NoError(t, err)
+	assert.Equal(t, "API_KEY=new", string(content))
+
+	_, err = manager.CopyFilesToWorktree("missing", false)
+	assert.ErrorContains(t, err, "does not exist")
+}
@@ -0,0 +1,69 @@
+package internal
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManager_RenameWorktree(t *testing.T) {
+	manager, repoPath, _ := setupManagerForRemoverTests(t)
+	manager.GetState().AdHocWorktrees = append(manager.GetState().AdHocWorktrees, "dev")
+	require.NoError(t, manager.SetCurrentWorktree("feat"))
+	require.NoError(t, manager.SetCurrentWorktree("dev"))
+
+	require.NoError(t, manager.RenameWorktree("dev", "develop"))
+
+	assert.NoDirExists(t, filepath.Join(repoPath, "worktrees", "dev"))
+	assert.DirExists(t, filepath.Join(repoPath, "worktrees", "develop"))
+
+	path, err := manager.GetWorktreePath("develop")
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(repoPath, "worktrees", "develop"), path)
+
+	state := manager.GetState()
+	assert.Contains(t, state.AdHocWorktrees, "develop")
+	assert.NotContains(t, state.AdHocWorktrees, "dev")
+	baseBranch, exists := state.GetWorktreeBaseBranch("develop")
+	assert.True(t, exists)
+	assert.Equal(t, "dev", baseBranch)
+	_, exists = state.GetWorktreeBaseBranch("dev")
+	assert.False(t, exists)
+	assert.Equal(t, "develop", state.CurrentWorktree)
+	assert.Equal(t, "feat", state.PreviousWorktree)
+
+	// State is persisted
+	saved, err := LoadState(filepath.Join(repoPath, DefaultConfigDirname))
+	require.NoError(t, err)
+	assert.Equal(t, "develop", saved.CurrentWorktree)
+
+	t.Run("target already exists", func(t *testing.T) {
+		err := manager.RenameWorktree("develop", "feat")
+		assert.ErrorContains(t, err, "worktree 'feat' already exists")
+	})
+
+	t.Run("source does not exist", func(t *testing.T) {
+		err := manager.RenameWorktree("missing", "other")
+		assert.ErrorContains(t, err, "worktree 'missing' does not exist")
+	})
+
+	t.Run("names that escape the worktree directory are refused", func(t *testing.T) {
+		for _, name := range []string{"", "..", "nested/name", "../outside", `back\slash`} {
+			err := manager.RenameWorktree("develop", name)
+			assert.Error(t, err, name)
+		}
+		assert.DirExists(t, filepath.Join(repoPath, "worktrees", "develop"))
+		assert.NoDirExists(t, filepath.Join(repoPath, "outside"))
+	})
+
+	t.Run("tracked worktrees are refused", func(t *testing.T) {
+		manager.gbmConfig = &GBMConfig{Worktrees: map[string]WorktreeConfig{"feat": {Branch: "feat"}}}
+		defer func() { manager.gbmConfig = nil }()
+
+		err := manager.RenameWorktree("feat", "feature")
+		assert.ErrorContains(t, err, "is tracked in")
+		assert.DirExists(t, filepath.Join(repoPath, "worktrees", "feat"))
+	})
+}
@@ -104,7 +104,7 @@ func CheckMergeBackStatus(configPath string) (*MergeBackStatus, error) {
 			// Get commits that need to be merged back
 			commits, err := getCommitsNeedingMergeBack(gitRoot, current.Parent.Config.Branch, current.Config.Branch)
 			if err != nil {
-				fmt.Println("⚠️  Warning:", err)
+				logWarn("%v", err)
 				current = current.Parent
 				continue
 			}
@@ -0,0 +1,171 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// ErrMergebackConflict is returned by MergebackAll when a step stops on merge conflicts
+var ErrMergebackConflict = errors.New("mergeback stopped on merge conflicts")
+
+// MergebackStep is a single merge of one tracked worktree's branch into its merge_into parent
+type MergebackStep struct {
+	SourceWorktree string
+	SourceBranch   string
+	TargetWorktree string
+	TargetBranch   string
+	// Commits is the number of commits pending from SourceBranch; zero for steps that are
+	// only needed to carry an earlier merge further up the chain
+	Commits int
+}
+
+// MergebackChainResult reports the outcome of MergebackAll
+type MergebackChainResult struct {
+	Completed []MergebackStep
+	// Conflicted is the step that stopped the chain, if any
+	Conflicted *MergebackStep
+	// ConflictWorktree is the mergeback worktree where the conflicts must be resolved
+	ConflictWorktree string
+}
+
+// MergebackBranchName builds the mergeback branch name <prefix>/<worktree>_<base>.
+// An empty prefix produces <worktree>_<base>.
+func MergebackBranchName(prefix, worktreeName, baseWorktreeName string) string {
+	branchName := worktreeName + "_" + strings.ToLower(baseWorktreeName)
+	if prefix == "" {
+		return branchName
+	}
+	return prefix + "/" + branchName
+}
+
+// MergebackWorktreeName builds the mergeback worktree name <prefix>_<worktree>_<base>.
+// An empty prefix produces <worktree>_<base>.
+func MergebackWorktreeName(prefix, worktreeName, baseWorktreeName string) string {
+	if prefix == "" {
+		return worktreeName + "_" + baseWorktreeName
+	}
+	return prefix + "_" + worktreeName + "_" + baseWorktreeName
+}
+
+// PlanMergebackChain computes every mergeback needed to bring pending commits all the way up
+// the merge_into tree, ordered bottom-up so each step runs after the steps feeding into it.
+func (m *Manager) PlanMergebackChain() ([]MergebackStep, error) {
+	if m.gbmConfig == nil || m.gbmConfig.Tree == nil {
+		return nil, fmt.Errorf("no %s loaded", DefaultBranchConfigFilename)
+	}
+
+	status, err := m.CheckMergeBackStatus()
+	if err != nil {
+		return nil, fmt.Errorf("failed to check mergeback status: %w", err)
+	}
+	if status == nil {
+		return nil, nil
+	}
+
+	pending := make(map[string]int)
+	for _, info := range status.MergeBacksNeeded {
+		pending[info.FromBranch] = info.TotalCount
+	}
+
+	// Merging into a worktree creates new commits that its own parent needs too,
+	// so every edge from a pending node up to its root becomes a step
+	steps := make(map[string]*WorktreeNode)
+	for name := range pending {
+		for node := m.gbmConfig.Tree.GetNode(name); node != nil && node.Parent != nil; node = node.Parent {
+			steps[node.Name] = node
+		}
+	}
+
+	nodes := make([]*WorktreeNode, 0, len(steps))
+	for _, node := range steps {
+		nodes = append(nodes, node)
+	}
+	sort.Slice(nodes, func(i, j int) bool {
+		if nodes[i].GetDepth() != nodes[j].GetDepth() {
+			return nodes[i].GetDepth() > nodes[j].GetDepth()
+		}
+		return nodes[i].Name < nodes[j].Name
+	})
+
+	plan := make([]MergebackStep, 0, len(nodes))
+	for _, node := range nodes {
+		plan = append(plan, MergebackStep{
+			SourceWorktree: node.Name,
+			SourceBranch:   node.Config.Branch,
+			TargetWorktree: node.Parent.Name,
+			TargetBranch:   node.Parent.Config.Branch,
+			Commits:        pending[node.Name],
+		})
+	}
+
+	return plan, nil
+}
+
+// MergebackAll creates a mergeback worktree for each step and merges into it, in order.
+// Mergeback worktrees that already exist are reused. When an earlier step merged into a step's
+// source worktree, that step merges from the earlier mergeback branch so the changes cascade up
+// the chain. Siblings merging into the same parent share the first sibling's mergeback worktree,
+// so the parent's own step carries all of them. It stops at the first step with merge conflicts
+// and returns ErrMergebackConflict.
+func (m *Manager) MergebackAll(steps []MergebackStep) (*MergebackChainResult, error) {
+	result := &MergebackChainResult{}
+	settings := m.config.Settings
+
+	// mergedInto tracks the mergeback worktree and branch created for each target worktree
+	type mergeback struct{ worktree, branch string }
+	mergedInto := make(map[string]mergeback)
+
+	for i := range steps {
+		step := steps[i]
+
+		sourceRef := mergedInto[step.SourceWorktree].branch
+		if sourceRef == "" {
+			sourceRef = m.resolveMergeSourceRef(step.SourceBranch)
+		}
+
+		target, ok := mergedInto[step.TargetWorktree]
+		if !ok {
+			target = mergeback{
+				worktree: MergebackWorktreeName(settings.MergebackPrefix, step.SourceWorktree, step.TargetWorktree),
+				branch:   MergebackBranchName(settings.MergeBranchPrefix, step.SourceWorktree, step.TargetWorktree),
+			}
+		}
+		worktreeName := target.worktree
+
+		// Reuse the worktree left by an earlier run so a chain can resume after resolving conflicts
+		worktreePath := filepath.Join(m.repoPath, settings.WorktreePrefix, worktreeName)
+		if _, err := os.Stat(worktreePath); os.IsNotExist(err) {
+			if err := m.AddWorktree(worktreeName, target.branch, true, step.TargetBranch); err != nil {
+				return result, fmt.Errorf("failed to add mergeback worktree '%s': %w", worktreeName, err)
+			}
+		}
+
+		message := fmt.Sprintf("Merge %s into %s", step.SourceBranch, step.TargetBranch)
+		if err := m.gitManager.MergeIntoWorktree(worktreePath, sourceRef, message); err != nil {
+			if errors.Is(err, ErrMergeConflict) {
+				result.Conflicted = &steps[i]
+				result.ConflictWorktree = worktreeName
+				return result, fmt.Errorf("%w in worktree '%s'", ErrMergebackConflict, worktreeName)
+			}
+			return result, fmt.Errorf("failed to merge %s into %s: %w", sourceRef, worktreeName, err)
+		}
+
+		mergedInto[step.TargetWorktree] = target
+		result.Completed = append(result.Completed, step)
+	}
+
+	return result, nil
+}
+
+// resolveMergeSourceRef prefers the remote-tracking branch so merges use the published state
+func (m *Manager) resolveMergeSourceRef(branch string) string {
+	remoteRef := RemoteFor(m.gitManager.GetDefaultRemote(), branch)
+	if exists, err := m.gitManager.VerifyRef(remoteRef); err == nil && exists {
+		return remoteRef
+	}
+	return branch
+}
@@ -0,0 +1,151 @@
+package internal
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gbm/internal/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// setupMergebackChainRepo creates a main <- preview <- prod chain where prod has one unmerged commit
+func setupMergebackChainRepo(t *testing.T, hotfixFile, hotfixContent string) (*testutils.GitTestRepo, *Manager) {
+	t.Helper()
+
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+
+	must(t, repo.CreateGBMConfig(map[string]testutils.WorktreeConfig{
+		"main":    {Branch: "main"},
+		"preview": {Branch: "preview", MergeInto: "main"},
+		"prod":    {Branch: "prod", MergeInto: "preview"},
+	}))
+	must(t, repo.WriteFile(".gitignore", "worktrees/\n.gbm/\n"))
+	must(t, repo.CommitChangesWithForceAdd("Add gbm.branchconfig.yaml configuration"))
+	must(t, repo.PushBranch("main"))
+	must(t, repo.CreateSynchronizedBranch("preview"))
+	must(t, repo.SwitchToBranch("main"))
+	must(t, repo.CreateSynchronizedBranch("prod"))
+
+	must(t, repo.WriteFile(hotfixFile, hotfixContent))
+	must(t, repo.CommitChanges("Hotfix on prod"))
+	must(t, repo.PushBranch("prod"))
+	must(t, repo.SwitchToBranch("main"))
+
+	manager, err := NewManager(repo.GetLocalPath())
+	require.NoError(t, err)
+	require.NoError(t, manager.LoadGBMConfig(filepath.Join(repo.GetLocalPath(), DefaultBranchConfigFilename)))
+
+	return repo, manager
+}
+
+func TestManager_MergebackChain(t *testing.T) {
+	repo, manager := setupMergebackChainRepo(t, "hotfix.txt", "Critical security fix")
+
+	must(t, repo.InLocalRepo(func() error {
+		steps, err := manager.PlanMergebackChain()
+		require.NoError(t, err)
+		require.Len(t, steps, 2)
+
+		assert.Equal(t, MergebackStep{SourceWorktree: "prod", SourceBranch: "prod", TargetWorktree: "preview", TargetBranch: "preview", Commits: 1}, steps[0])
+		assert.Equal(t, MergebackStep{SourceWorktree: "preview", SourceBranch: "preview", TargetWorktree: "main", TargetBranch: "main", Commits: 0}, steps[1])
+
+		result, err := manager.MergebackAll(steps)
+		require.NoError(t, err)
+		assert.Len(t, result.Completed, 2)
+		assert.Nil(t, result.Conflicted)
+
+		// The hotfix cascades through the preview mergeback into the main mergeback
+		worktreesDir := filepath.Join(repo.GetLocalPath(), "worktrees")
+		assert.FileExists(t, filepath.Join(worktreesDir, "MERGE_prod_preview", "hotfix.txt"))
+		assert.FileExists(t, filepath.Join(worktreesDir, "MERGE_preview_main", "hotfix.txt"))
+
+		branch, err := manager.GetGitManager().GetCurrentBranchInPath(filepath.Join(worktreesDir, "MERGE_preview_main"))
+		require.NoError(t, err)
+		assert.Equal(t, "merge/preview_main", branch)
+
+		// Running the chain again reuses the existing mergeback worktrees
+		result, err = manager.MergebackAll(steps)
+		require.NoError(t, err)
+		assert.Len(t, result.Completed, 2)
+		return nil
+	}))
+}
+
+func TestManager_MergebackAllStopsOnConflict(t *testing.T) {
+	repo, manager := setupMergebackChainRepo(t, "shared.txt", "prod version")
+
+	// Conflicting change on preview
+	must(t, repo.SwitchToBranch("preview"))
+	must(t, repo.WriteFile("shared.txt", "preview version"))
+	must(t, repo.CommitChanges("Preview change"))
+	must(t, repo.PushBranch("preview"))
+	must(t, repo.SwitchToBranch("main"))
+
+	must(t, repo.InLocalRepo(func() error {
+		steps, err := manager.PlanMergebackChain()
+		require.NoError(t, err)
+		require.Len(t, steps, 2)
+
+		result, err := manager.MergebackAll(steps)
+		assert.ErrorIs(t, err, ErrMergebackConflict)
+		assert.Empty(t, result.Completed)
+		require.NotNil(t, result.Conflicted)
+		assert.Equal(t, "prod", result.Conflicted.SourceWorktree)
+		assert.Equal(t, "MERGE_prod_preview", result.ConflictWorktree)
+
+		// Later steps are not started
+		_, statErr := os.Stat(filepath.Join(repo.GetLocalPath(), "worktrees", "MERGE_preview_main"))
+		assert.True(t, os.IsNotExist(statErr))
+		return nil
+	}))
+}
+
+func TestManager_MergebackAllSiblingsShareParentMergeback(t *testing.T) {
+	repo, manager := setupMergebackChainRepo(t, "prod.txt", "prod fix")
+
+	// A second child of preview with its own pending commit
+	must(t, repo.CreateGBMConfig(map[string]testutils.WorktreeConfig{
+		"main":    {Branch: "main"},
+		"preview": {Branch: "preview", MergeInto: "main"},
+		"prod":    {Branch: "prod", MergeInto: "preview"},
+		"hotfix":  {Branch: "hotfix", MergeInto: "preview"},
+	}))
+	must(t, repo.CommitChangesWithForceAdd("Add hotfix worktree"))
+	must(t, repo.PushBranch("main"))
+	must(t, repo.CreateSynchronizedBranch("hotfix"))
+	must(t, repo.WriteFile("hotfix.txt", "hotfix fix"))
+	must(t, repo.CommitChanges("Fix on hotfix"))
+	must(t, repo.PushBranch("hotfix"))
+	must(t, repo.SwitchToBranch("main"))
+	require.NoError(t, manager.LoadGBMConfig(filepath.Join(repo.GetLocalPath(), DefaultBranchConfigFilename)))
+
+	must(t, repo.InLocalRepo(func() error {
+		steps, err := manager.PlanMergebackChain()
+		require.NoError(t, err)
+		require.Len(t, steps, 3)
+
+		result, err := manager.MergebackAll(steps)
+		require.NoError(t, err)
+		assert.Len(t, result.Completed, 3)
+
+		// Both children land in one preview mergeback, and from there in main's
+		worktreesDir := filepath.Join(repo.GetLocalPath(), "worktrees")
+		assert.FileExists(t, filepath.Join(worktreesDir, "MERGE_hotfix_preview", "prod.txt"))
+		assert.FileExists(t, filepath.Join(worktreesDir, "MERGE_hotfix_preview", "hotfix.txt"))
+		assert.NoDirExists(t, filepath.Join(worktreesDir, "MERGE_prod_preview"))
+		assert.FileExists(t, filepath.Join(worktreesDir, "MERGE_preview_main", "prod.txt"))
+		assert.FileExists(t, filepath.Join(worktreesDir, "MERGE_preview_main", "hotfix.txt"))
+		return nil
+	}))
+}
+
+func TestMergebackWorktreeName(t *testing.T) {
+	assert.Equal(t, "MERGE_prod_preview", MergebackWorktreeName("MERGE", "prod", "preview"))
+	assert.Equal(t, "prod_preview", MergebackWorktreeName("", "prod", "preview"))
+}
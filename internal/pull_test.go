@@ -248,3 +248,51 @@ func TestManager_PullAllWorktrees(t *testing.T) {
 		}
 	})
 }
+
+func TestManager_PullAllWorktreesWithResults(t *testing.T) {
+	repo, manager := setupPushTestRepo(t)
+	createWorktreeWithChanges(t, repo, manager, "a-behind", "feature/a", 1)
+	createWorktreeWithChanges(t, repo, manager, "b-behind", "feature/b", 1)
+	createWorktreeWithChanges(t, repo, manager, "c-diverged", "feature/c", 1)
+
+	// The first concurrent push sets the upstream of every branch
+	_, err := manager.PushAllWorktreesWithResults(PushOptions{}, false)
+	require.NoError(t, err)
+	for _, name := range []string{"a-behind", "b-behind", "c-diverged"} {
+		upstream, err := manager.gitManager.GetUpstreamBranch(filepath.Join(repo.GetLocalPath(), "worktrees", name))
+		require.NoError(t, err)
+		assert.NotEmpty(t, upstream, name)
+	}
+
+	// Another developer pushes to every branch
+	clonePath := filepath.Join(t.TempDir(), "second-clone")
+	require.NoError(t, execGitCommandRun("", "clone", repo.GetRemotePath(), clonePath))
+	for _, branch := range []string{"a", "b", "c"} {
+		fileName := "remote-" + branch + ".txt"
+		require.NoError(t, execGitCommandRun(clonePath, "checkout", "feature/"+branch))
+		require.NoError(t, os.WriteFile(filepath.Join(clonePath, fileName), []byte("remote "+branch), 0644))
+		require.NoError(t, execGitCommandRun(clonePath, "add", fileName))
+		require.NoError(t, execGitCommandRun(clonePath, "-c", "user.name=Other", "-c", "user.email=other@example.com",
+			"commit", "-m", "Remote change to "+branch))
+		require.NoError(t, execGitCommandRun(clonePath, "push", "origin", "feature/"+branch))
+	}
+	divergedPath := filepath.Join(repo.GetLocalPath(), "worktrees", "c-diverged")
+	require.NoError(t, os.WriteFile(filepath.Join(divergedPath, "local-c.txt"), []byte("local c"), 0644))
+	require.NoError(t, execGitCommandRun(divergedPath, "add", "local-c.txt"))
+	require.NoError(t, execGitCommandRun(divergedPath, "commit", "-m", "Local change to c"))
+
+	results, err := manager.PullAllWorktreesWithResults(false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "pull failed for 1 of")
+
+	byName := make(map[string]error)
+	for _, result := range results {
+		byName[result.Name] = result.Err
+	}
+	assert.NoError(t, byName["a-behind"])
+	assert.NoError(t, byName["b-behind"])
+	assert.ErrorContains(t, byName["c-diverged"], "failed to fast-forward 'feature/c'")
+	assert.FileExists(t, filepath.Join(repo.GetLocalPath(), "worktrees", "a-behind", "remote-a.txt"))
+	assert.FileExists(t, filepath.Join(repo.GetLocalPath(), "worktrees", "b-behind", "remote-b.txt"))
+	assert.NoFileExists(t, filepath.Join(divergedPath, "remote-c.txt"))
+}
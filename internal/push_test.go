@@ -392,3 +392,115 @@ func TestManager_GetAllWorktrees_Integration(t *testing.T) {
 		})
 	}
 }
+
+func TestManager_PushWorktreeWithOptions(t *testing.T) {
+	repo, manager := setupPushTestRepo(t)
+	createWorktreeWithChanges(t, repo, manager, "rebased-wt", "feature/rebased", 1)
+	worktreePath := filepath.Join(repo.GetLocalPath(), "worktrees", "rebased-wt")
+
+	remoteHead := func() string {
+		output, err := ExecGitCommand(repo.GetRemotePath(), "rev-parse", "--verify", "--quiet", "refs/heads/feature/rebased")
+		if err != nil {
+			return ""
+		}
+		return string(output)
+	}
+
+	// A dry run leaves the remote untouched
+	require.NoError(t, manager.PushWorktreeWithOptions("rebased-wt", PushOptions{DryRun: true}))
+	assert.Empty(t, remoteHead())
+
+	require.NoError(t, manager.PushWorktree("rebased-wt"))
+	pushedHead := remoteHead()
+	require.NotEmpty(t, pushedHead)
+
+	// Rewrite the pushed commit: a plain push is rejected, force-with-lease succeeds
+	require.NoError(t, execGitCommandRun(worktreePath, "commit", "--amend", "-m", "Rewritten change"))
+	require.Error(t, manager.PushWorktree("rebased-wt"))
+	require.NoError(t, manager.PushWorktreeWithOptions("rebased-wt", PushOptions{ForceWithLease: true}))
+	assert.NotEqual(t, pushedHead, remoteHead())
+
+	// Tags are pushed alongside the branch
+	require.NoError(t, execGitCommandRun(worktreePath, "tag", "v1.0.0"))
+	require.NoError(t, manager.PushWorktreeWithOptions("rebased-wt", PushOptions{Tags: true}))
+	_, err := ExecGitCommand(repo.GetRemotePath(), "rev-parse", "--verify", "refs/tags/v1.0.0")
+	assert.NoError(t, err)
+}
+
+func TestManager_PushAllWorktreesWithResults(t *testing.T) {
+	repo, manager := setupPushTestRepo(t)
+	createWorktreeWithChanges(t, repo, manager, "a-rewritten", "feature/rewritten", 1)
+	createWorktreeWithChanges(t, repo, manager, "b-new", "feature/new", 1)
+
+	// Rewrite an already pushed commit so a plain push is rejected
+	must(t, manager.PushWorktree("a-rewritten"))
+	rewrittenPath := filepath.Join(repo.GetLocalPath(), "worktrees", "a-rewritten")
+	require.NoError(t, execGitCommandRun(rewrittenPath, "commit", "--amend", "-m", "Rewritten change"))
+
+	results, err := manager.PushAllWorktreesWithResults(PushOptions{}, false)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "push failed for 1 of 2 worktrees")
+	require.Len(t, results, 2)
+	assert.Equal(t, "a-rewritten", results[0].Name)
+	assert.Error(t, results[0].Err)
+	assert.Equal(t, "b-new", results[1].Name)
+	assert.NoError(t, results[1].Err)
+	verifyPushSuccess(t, repo, "feature/new", 1)
+
+	// With fail-fast, worktrees after the first failure are skipped
+	results, err = manager.forEachWorktree("push", 1, true, func(info *WorktreeListInfo) error {
+		return fmt.Errorf("push to %s rejected", filepath.Base(info.Path))
+	})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "push failed for 1 of 2 worktrees")
+	require.Len(t, results, 2)
+	assert.ErrorContains(t, results[0].Err, "push to a-rewritten rejected")
+	assert.ErrorIs(t, results[1].Err, ErrSkipped)
+}
+
+func TestManager_ExcludeMain(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+	t.Cleanup(func() { repo.Cleanup() })
+
+	must(t, repo.WriteFile(".gitignore", "worktrees/\n"))
+	must(t, repo.CommitChanges("Add .gitignore for worktrees"))
+	must(t, repo.PushBranch("main"))
+	must(t, repo.CreateBranch("production", "production content"))
+	must(t, repo.CreateBranch("staging", "staging content"))
+	must(t, repo.CreateGBMConfig(map[string]testutils.WorktreeConfig{
+		"prod":    {Branch: "production"},
+		"staging": {Branch: "staging", MergeInto: "prod"},
+	}))
+
+	manager, err := NewManager(repo.GetLocalPath())
+	require.NoError(t, err)
+	require.NoError(t, manager.LoadGBMConfig(""))
+
+	assert.True(t, manager.IsMainWorktree("prod"), "root of the merge_into tree")
+	assert.True(t, manager.IsMainWorktree("main"), "named after the default branch")
+	assert.False(t, manager.IsMainWorktree("staging"))
+	assert.False(t, manager.IsMainWorktree("feature"))
+
+	must(t, manager.AddWorktree("prod", "production", false, "production"))
+	must(t, manager.AddWorktree("staging", "staging", false, "staging"))
+	must(t, manager.AddWorktree("feature", "feature/x", true, "main"))
+
+	var visited []string
+	collect := func(info *WorktreeListInfo) error {
+		visited = append(visited, filepath.Base(info.Path))
+		return nil
+	}
+
+	_, err = manager.forEachWorktree("push", 1, false, collect)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"feature", "prod", "staging"}, visited)
+
+	visited = nil
+	manager.SetExcludeMain(true)
+	_, err = manager.forEachWorktree("push", 1, false, collect)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"feature", "staging"}, visited)
+}
@@ -0,0 +1,73 @@
+package internal
+
+import (
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// SetSparsePatterns makes worktrees created by this manager sparse checkouts of the given
+// directories. Nil or empty patterns mean a full checkout.
+func (m *Manager) SetSparsePatterns(patterns []string) {
+	m.sparsePatterns = patterns
+}
+
+// applySparseCheckout limits a worktree to patterns and records them in state so sync can
+// reapply them when it recreates the worktree
+func (m *Manager) applySparseCheckout(worktreeName string, patterns []string) error {
+	worktreePath := filepath.Join(m.repoPath, m.config.Settings.WorktreePrefix, worktreeName)
+	if err := m.gitManager.SetSparseCheckout(worktreePath, patterns); err != nil {
+		return fmt.Errorf("failed to set up sparse checkout for worktree '%s': %w", worktreeName, err)
+	}
+
+	m.state.SetSparsePatterns(worktreeName, patterns)
+	return nil
+}
+
+// reapplySparseCheckout restores the sparse checkout recorded for a worktree that was recreated.
+// Failures are reported as warnings, leaving a full checkout.
+func (m *Manager) reapplySparseCheckout(worktreeName string) {
+	patterns, exists := m.state.GetSparsePatterns(worktreeName)
+	if !exists || len(patterns) == 0 {
+		return
+	}
+
+	if err := m.applySparseCheckout(worktreeName, patterns); err != nil {
+		logWarn("%v", err)
+	}
+}
+
+// SetSparseCheckout turns the worktree at worktreePath into a cone-mode sparse checkout of the
+// given directories. Files at the top level of the worktree are always checked out. The setting
+// only applies to this worktree; the main repository and other worktrees keep their checkout.
+func (gm *GitManager) SetSparseCheckout(worktreePath string, patterns []string) error {
+	output, err := ExecGitCommand(worktreePath, "--version")
+	if err != nil {
+		return enhanceGitError(err, "get git version")
+	}
+	version, err := parseGitVersion(string(output))
+	if err != nil {
+		return err
+	}
+	if err := checkSparseCheckoutVersion(version); err != nil {
+		return err
+	}
+
+	args := append([]string{"sparse-checkout", "set", "--cone"}, patterns...)
+	if output, err := ExecGitCommandCombined(worktreePath, args...); err != nil {
+		return fmt.Errorf("git sparse-checkout set failed: %s", strings.TrimSpace(string(output)))
+	}
+
+	return nil
+}
+
+// checkSparseCheckoutVersion rejects git releases whose sparse-checkout writes core.sparseCheckout
+// to the config shared by every worktree
+func checkSparseCheckoutVersion(version [3]int) error {
+	if slices.Compare(version[:], MinSparseCheckoutGitVersion[:]) < 0 {
+		return fmt.Errorf("sparse worktrees need git %s or newer, which keeps sparse-checkout settings per worktree; found git %s",
+			formatGitVersion(MinSparseCheckoutGitVersion), formatGitVersion(version))
+	}
+	return nil
+}
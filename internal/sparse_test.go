@@ -0,0 +1,89 @@
+package internal
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gbm/internal/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManager_AddWorktreeSparse(t *testing.T) {
+	manager, repoPath, sourceRepo := setupManagerForRemoverTests(t)
+	must(t, sourceRepo.WriteFile("services/api/main.go", "package main"))
+	must(t, sourceRepo.WriteFile("services/web/index.html", "<html>"))
+	must(t, sourceRepo.WriteFile("libs/util.go", "package libs"))
+	must(t, sourceRepo.CommitChanges("Add monorepo layout"))
+
+	manager.SetSparsePatterns([]string{"services/api", "libs"})
+	require.NoError(t, manager.AddWorktree("api", "feature/api", true, "main"))
+
+	apiPath := filepath.Join(repoPath, "worktrees", "api")
+	assert.FileExists(t, filepath.Join(apiPath, "services", "api", "main.go"))
+	assert.FileExists(t, filepath.Join(apiPath, "libs", "util.go"))
+	assert.FileExists(t, filepath.Join(apiPath, "README.md"), "top-level files are always checked out")
+	assert.NoDirExists(t, filepath.Join(apiPath, "services", "web"))
+
+	// Only the new worktree is sparse
+	assert.FileExists(t, filepath.Join(repoPath, "services", "web", "index.html"))
+	_, err := ExecGitCommand(repoPath, "config", "--local", "--get", "core.sparseCheckout")
+	assert.Error(t, err, "core.sparseCheckout must not be set in the config shared by all worktrees")
+
+	saved, err := LoadState(filepath.Join(repoPath, DefaultConfigDirname))
+	require.NoError(t, err)
+	patterns, exists := saved.GetSparsePatterns("api")
+	assert.True(t, exists)
+	assert.Equal(t, []string{"services/api", "libs"}, patterns)
+
+	require.NoError(t, manager.RemoveWorktree("api"))
+	_, exists = manager.GetState().GetSparsePatterns("api")
+	assert.False(t, exists)
+}
+
+func TestManager_SyncReappliesSparseCheckout(t *testing.T) {
+	sourceRepo := testutils.NewMultiBranchRepo(t)
+	defer sourceRepo.Cleanup()
+	require.NoError(t, sourceRepo.CreateGBMConfig(map[string]testutils.WorktreeConfig{
+		"main": {Branch: "main", Description: "Main branch"},
+		"dev":  {Branch: "develop", Description: "Development branch"},
+	}))
+	require.NoError(t, sourceRepo.CommitChangesWithForceAdd("Add initial gbm config"))
+	require.NoError(t, sourceRepo.PushBranch("main"))
+
+	originalDir, _ := os.Getwd()
+	t.Cleanup(func() { _ = os.Chdir(originalDir) })
+
+	wd := t.TempDir()
+	require.NoError(t, os.Chdir(wd))
+	require.NoError(t, execGitCommandRun(wd, "clone", sourceRepo.GetRemotePath(), "."))
+
+	manager, err := NewManager(wd)
+	require.NoError(t, err)
+	require.NoError(t, manager.LoadGBMConfig(""))
+	require.NoError(t, manager.SyncWithConfirmation(SyncOptions{}, func(string) bool { return true }))
+
+	require.NoError(t, manager.applySparseCheckout("dev", []string{"docs"}))
+	require.NoError(t, manager.SaveState())
+
+	// Sync recreates the deleted worktree with the same sparse checkout
+	devPath := filepath.Join(wd, "worktrees", "dev")
+	require.NoError(t, os.RemoveAll(devPath))
+	require.NoError(t, manager.SyncWithConfirmation(SyncOptions{}, func(string) bool { return true }))
+
+	output, err := ExecGitCommand(devPath, "sparse-checkout", "list")
+	require.NoError(t, err)
+	assert.Equal(t, "docs", strings.TrimSpace(string(output)))
+}
+
+func TestCheckSparseCheckoutVersion(t *testing.T) {
+	assert.NoError(t, checkSparseCheckoutVersion([3]int{2, 36, 0}))
+	assert.NoError(t, checkSparseCheckoutVersion([3]int{2, 43, 1}))
+
+	err := checkSparseCheckoutVersion([3]int{2, 35, 8})
+	assert.ErrorContains(t, err, "need git 2.36.0 or newer")
+	assert.ErrorContains(t, err, "found git 2.35.8")
+}
@@ -18,9 +18,16 @@ type ConfigState struct {
 	PreviousWorktree string    `toml:"previous_worktree"`
 }
 
+// StateSchemaVersion is the version of the state.toml layout written by this build. Bump it and
+// extend migrateState whenever a field needs upgrading in existing state files.
+const StateSchemaVersion = 1
+
 // State represents the runtime state data that is frequently modified
 // This will be stored in a separate .gbm/state.toml file
 type State struct {
+	// SchemaVersion is the StateSchemaVersion the file was last written with; 0 for files that
+	// predate versioning
+	SchemaVersion      int               `toml:"schema_version"`
 	LastSync           time.Time         `toml:"last_sync"`
 	TrackedVars        []string          `toml:"tracked_vars"`
 	AdHocWorktrees     []string          `toml:"ad_hoc_worktrees"`
@@ -28,11 +35,17 @@ type State struct {
 	PreviousWorktree   string            `toml:"previous_worktree"`
 	LastMergebackCheck time.Time         `toml:"last_mergeback_check"`
 	WorktreeBaseBranch map[string]string `toml:"worktree_base_branch"`
+	// SparsePatterns holds the sparse-checkout directories of worktrees created with --sparse, so
+	// they can be reapplied when sync recreates the worktree
+	SparsePatterns map[string][]string `toml:"sparse_patterns"`
+	// WorktreePrefix is the settings.worktree_prefix existing worktrees were created under
+	WorktreePrefix string `toml:"worktree_prefix"`
 }
 
 // DefaultState returns a new State with default values
 func DefaultState() *State {
 	return &State{
+		SchemaVersion:      StateSchemaVersion,
 		LastSync:           time.Time{},
 		TrackedVars:        []string{},
 		AdHocWorktrees:     []string{},
@@ -40,6 +53,7 @@ func DefaultState() *State {
 		PreviousWorktree:   "",
 		LastMergebackCheck: time.Time{},
 		WorktreeBaseBranch: make(map[string]string),
+		SparsePatterns:     make(map[string][]string),
 	}
 }
 
@@ -53,9 +67,11 @@ func LoadState(gbmDir string) (*State, error) {
 		if _, err := toml.DecodeFile(statePath, &state); err != nil {
 			return nil, fmt.Errorf("failed to decode state file: %w", err)
 		}
-		// Initialize WorktreeBaseBranch map if it doesn't exist (for backward compatibility)
-		if state.WorktreeBaseBranch == nil {
-			state.WorktreeBaseBranch = make(map[string]string)
+		if migrateState(&state) {
+			// Persist the upgrade, but don't fail the command if the state file is read-only
+			if err := state.Save(gbmDir); err != nil {
+				logWarn("Failed to save migrated state: %v", err)
+			}
 		}
 		return &state, nil
 	}
@@ -64,22 +80,41 @@ func LoadState(gbmDir string) (*State, error) {
 	return DefaultState(), nil
 }
 
+// migrateState upgrades state decoded from an older state.toml to StateSchemaVersion and reports
+// whether the file needs rewriting. State from a newer gbm keeps its version.
+func migrateState(state *State) bool {
+	// Fields may be missing from any file, e.g. an empty table is not written, so nil maps and
+	// slices are always replaced
+	if state.WorktreeBaseBranch == nil {
+		state.WorktreeBaseBranch = make(map[string]string)
+	}
+	if state.SparsePatterns == nil {
+		state.SparsePatterns = make(map[string][]string)
+	}
+	if state.TrackedVars == nil {
+		state.TrackedVars = []string{}
+	}
+	if state.AdHocWorktrees == nil {
+		state.AdHocWorktrees = []string{}
+	}
+
+	if state.SchemaVersion >= StateSchemaVersion {
+		return false
+	}
+
+	logVerbose("Migrated state from schema version %d to %d", state.SchemaVersion, StateSchemaVersion)
+	state.SchemaVersion = StateSchemaVersion
+	return true
+}
+
 // Save saves the state to .gbm/state.toml
 func (s *State) Save(gbmDir string) error {
 	if err := os.MkdirAll(gbmDir, 0o755); err != nil {
 		return fmt.Errorf("failed to create .gbm directory: %w", err)
 	}
 
-	statePath := filepath.Join(gbmDir, DefaultStateFilename)
-	file, err := os.Create(statePath)
-	if err != nil {
-		return fmt.Errorf("failed to create state file: %w", err)
-	}
-	defer func() { _ = file.Close() }()
-
-	encoder := toml.NewEncoder(file)
-	if err := encoder.Encode(s); err != nil {
-		return fmt.Errorf("failed to encode state: %w", err)
+	if err := writeTOMLAtomic(filepath.Join(gbmDir, DefaultStateFilename), s); err != nil {
+		return fmt.Errorf("failed to write state file: %w", err)
 	}
 
 	return nil
@@ -108,3 +143,27 @@ func (s *State) RemoveWorktreeBaseBranch(worktreeName string) {
 		delete(s.WorktreeBaseBranch, worktreeName)
 	}
 }
+
+// SetSparsePatterns stores the sparse-checkout patterns for a worktree
+func (s *State) SetSparsePatterns(worktreeName string, patterns []string) {
+	if s.SparsePatterns == nil {
+		s.SparsePatterns = make(map[string][]string)
+	}
+	s.SparsePatterns[worktreeName] = patterns
+}
+
+// GetSparsePatterns retrieves the sparse-checkout patterns for a worktree
+func (s *State) GetSparsePatterns(worktreeName string) ([]string, bool) {
+	if s.SparsePatterns == nil {
+		return nil, false
+	}
+	patterns, exists := s.SparsePatterns[worktreeName]
+	return patterns, exists
+}
+
+// RemoveSparsePatterns removes the sparse-checkout patterns for a worktree
+func (s *State) RemoveSparsePatterns(worktreeName string) {
+	if s.SparsePatterns != nil {
+		delete(s.SparsePatterns, worktreeName)
+	}
+}
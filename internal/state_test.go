@@ -20,6 +20,7 @@ func TestDefaultState(t *testing.T) {
 	assert.Empty(t, state.AdHocWorktrees)
 	assert.Empty(t, state.CurrentWorktree)
 	assert.Empty(t, state.PreviousWorktree)
+	assert.Equal(t, StateSchemaVersion, state.SchemaVersion)
 }
 
 func TestStateLoadAndSave(t *testing.T) {
@@ -101,3 +102,40 @@ func TestStateTomlFormat(t *testing.T) {
 	assert.Contains(t, string(content), "last_mergeback_check")
 	assert.Contains(t, string(content), "last_sync")
 }
+
+func TestLoadState_MigratesUnversionedState(t *testing.T) {
+	tmpDir := t.TempDir()
+	statePath := filepath.Join(tmpDir, DefaultStateFilename)
+
+	// A state file from before worktree_base_branch and schema_version existed
+	require.NoError(t, os.WriteFile(statePath, []byte("current_worktree = \"dev\"\nprevious_worktree = \"main\"\n"), 0o644))
+
+	state, err := LoadState(tmpDir)
+	require.NoError(t, err)
+	assert.Equal(t, StateSchemaVersion, state.SchemaVersion)
+	assert.Equal(t, "dev", state.CurrentWorktree)
+	assert.NotNil(t, state.WorktreeBaseBranch)
+	assert.NotPanics(t, func() { state.SetWorktreeBaseBranch("feat", "develop") })
+
+	// The upgrade is written back
+	content, err := os.ReadFile(statePath)
+	require.NoError(t, err)
+	assert.Contains(t, string(content), "schema_version = 1")
+	assert.Contains(t, string(content), `current_worktree = "dev"`)
+}
+
+func TestLoadState_KeepsNewerSchemaVersion(t *testing.T) {
+	tmpDir := t.TempDir()
+	statePath := filepath.Join(tmpDir, DefaultStateFilename)
+	original := []byte("schema_version = 99\ncurrent_worktree = \"dev\"\n")
+	require.NoError(t, os.WriteFile(statePath, original, 0o644))
+
+	state, err := LoadState(tmpDir)
+	require.NoError(t, err)
+	assert.Equal(t, 99, state.SchemaVersion)
+	assert.NotNil(t, state.WorktreeBaseBranch)
+
+	content, err := os.ReadFile(statePath)
+	require.NoError(t, err)
+	assert.Equal(t, original, content)
+}
@@ -53,6 +53,7 @@ func (im *IconManager) GitDirty() string    { return im.config.Icons.GitDirty }
 func (im *IconManager) GitAhead() string    { return im.config.Icons.GitAhead }
 func (im *IconManager) GitBehind() string   { return im.config.Icons.GitBehind }
 func (im *IconManager) GitDiverged() string { return im.config.Icons.GitDiverged }
+func (im *IconManager) GitConflict() string { return im.config.Icons.GitConflict }
 func (im *IconManager) GitUnknown() string  { return im.config.Icons.GitUnknown }
 
 var (
@@ -217,6 +218,15 @@ func FormatGitStatus(status *GitStatus) string {
 		return StatusInfoStyle.Render(iconManager.GitUnknown())
 	}
 
+	if status.Unmerged > 0 {
+		return StatusErrorStyle.Render(iconManager.GitConflict())
+	}
+
+	// Same rename marker as GetStatusIcon, so list and switch agree
+	if status.Renamed > 0 {
+		return StatusWarningStyle.Render("»")
+	}
+
 	if status.IsDirty {
 		return StatusWarningStyle.Render(iconManager.GitDirty())
 	}
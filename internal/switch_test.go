@@ -1,7 +1,10 @@
 package internal
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"gbm/internal/testutils"
 
@@ -140,6 +143,43 @@ func TestManager_GetSortedWorktreeNames(t *testing.T) {
 	assert.Equal(t, sortedNames, sortedNames2)
 }
 
+func TestManager_GetSortedWorktreeNames_AdHocOrder(t *testing.T) {
+	repo := testutils.NewGitTestRepo(t,
+		testutils.WithDefaultBranch("main"),
+		testutils.WithUser("Test User", "test@example.com"),
+	)
+	t.Cleanup(func() {
+		if repo != nil {
+			repo.Cleanup()
+		}
+	})
+
+	manager, err := NewManager(repo.GetLocalPath())
+	require.NoError(t, err)
+
+	// Worktrees created in one batch share an mtime; a newer one and a missing one surround them
+	baseDir := t.TempDir()
+	batchTime := time.Now().Add(-time.Hour).Truncate(time.Second)
+	worktrees := make(map[string]*WorktreeListInfo)
+	for name, modTime := range map[string]time.Time{
+		"zeta":   batchTime,
+		"alpha":  batchTime,
+		"mid":    batchTime,
+		"newest": batchTime.Add(time.Minute),
+	} {
+		path := filepath.Join(baseDir, name)
+		require.NoError(t, os.Mkdir(path, 0o755))
+		require.NoError(t, os.Chtimes(path, modTime, modTime))
+		worktrees[name] = &WorktreeListInfo{Path: path}
+	}
+	worktrees["missing"] = &WorktreeListInfo{Path: filepath.Join(baseDir, "missing")}
+
+	expected := []string{"newest", "alpha", "mid", "zeta", "missing"}
+	for range 5 {
+		assert.Equal(t, expected, manager.GetSortedWorktreeNames(worktrees))
+	}
+}
+
 func TestManager_GetStatusIcon(t *testing.T) {
 	// Setup repository
 	repo := testutils.NewGitTestRepo(t,
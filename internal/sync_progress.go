@@ -0,0 +1,58 @@
+package internal
+
+import "fmt"
+
+// SyncStepKind identifies the kind of work a sync step performs
+type SyncStepKind string
+
+const (
+	SyncStepFetch        SyncStepKind = "fetch"
+	SyncStepCreateBranch SyncStepKind = "create-branch"
+	SyncStepRemoveOrphan SyncStepKind = "remove-orphan"
+	SyncStepCreate       SyncStepKind = "create"
+	SyncStepPromote      SyncStepKind = "promote"
+	SyncStepUpdate       SyncStepKind = "update"
+)
+
+// SyncStep is a progress event emitted by SyncWithConfirmation before each unit of work
+type SyncStep struct {
+	Kind SyncStepKind
+	// Worktree is the worktree being worked on; empty for repository-wide steps such as fetching
+	Worktree string
+	// Branch is the branch the worktree ends up on, when relevant
+	Branch string
+	// Target is the worktree a promoted worktree moves to
+	Target string
+	// Base is the branch a missing branch is created from
+	Base string
+}
+
+func (s SyncStep) String() string {
+	switch s.Kind {
+	case SyncStepFetch:
+		return "Fetching from remote"
+	case SyncStepCreateBranch:
+		return fmt.Sprintf("Creating branch %s from %s", s.Branch, s.Base)
+	case SyncStepRemoveOrphan:
+		return fmt.Sprintf("Removing orphaned worktree %s", s.Worktree)
+	case SyncStepCreate:
+		return fmt.Sprintf("Creating worktree %s (%s)", s.Worktree, s.Branch)
+	case SyncStepPromote:
+		return fmt.Sprintf("Promoting worktree %s (%s) to %s", s.Worktree, s.Branch, s.Target)
+	case SyncStepUpdate:
+		return fmt.Sprintf("Updating worktree %s to %s", s.Worktree, s.Branch)
+	default:
+		return string(s.Kind)
+	}
+}
+
+// SyncProgress receives progress events from SyncWithConfirmation so callers can show what a
+// long sync is doing
+type SyncProgress interface {
+	SyncStep(step SyncStep)
+}
+
+// NopSyncProgress discards progress events
+type NopSyncProgress struct{}
+
+func (NopSyncProgress) SyncStep(SyncStep) {}
@@ -3,6 +3,7 @@ package internal
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"gbm/internal/testutils"
@@ -69,11 +70,11 @@ func TestManager_SyncBasicOperations(t *testing.T) {
 
 			// For the idempotent test, run sync twice
 			if len(tt.expectedDirs) == 4 { // Standard config test
-				err = manager.SyncWithConfirmation(false, false, func(string) bool { return true })
+				err = manager.SyncWithConfirmation(SyncOptions{}, func(string) bool { return true })
 				require.NoError(t, err) // First sync for idempotent test
 			}
 
-			err = manager.SyncWithConfirmation(false, false, func(string) bool { return true })
+			err = manager.SyncWithConfirmation(SyncOptions{}, func(string) bool { return true })
 			require.NoError(t, err)
 
 			for _, expectedDir := range tt.expectedDirs {
@@ -215,7 +216,7 @@ func TestManager_SyncScenarios(t *testing.T) {
 			require.NoError(t, manager.LoadGBMConfig(""))
 
 			// Initial sync to create worktrees
-			err = manager.SyncWithConfirmation(false, false, func(string) bool { return true })
+			err = manager.SyncWithConfirmation(SyncOptions{}, func(string) bool { return true })
 			require.NoError(t, err)
 
 			// Modify gbm config as per test (in the source repo), then push and pull in clone
@@ -229,7 +230,7 @@ func TestManager_SyncScenarios(t *testing.T) {
 			}
 			// Reload gbm.branchconfig.yaml after pulling updates
 			require.NoError(t, manager.LoadGBMConfig(""))
-			err = manager.SyncWithConfirmation(false, false, func(string) bool { return true })
+			err = manager.SyncWithConfirmation(SyncOptions{}, func(string) bool { return true })
 			require.NoError(t, err)
 
 			// Validate results
@@ -254,7 +255,7 @@ func TestManager_SyncIntegration(t *testing.T) {
 		require.NoError(t, manager.LoadGBMConfig(""))
 
 		// Initial sync
-		err = manager.SyncWithConfirmation(false, false, func(string) bool { return true })
+		err = manager.SyncWithConfirmation(SyncOptions{}, func(string) bool { return true })
 		require.NoError(t, err)
 
 		// Manually corrupt worktrees by removing dev worktree directory but keeping git worktree entry
@@ -265,7 +266,7 @@ func TestManager_SyncIntegration(t *testing.T) {
 		require.NoError(t, execGitCommandRun(wd, "worktree", "prune"))
 
 		// Sync with force should recreate the removed worktree
-		err = manager.SyncWithConfirmation(false, true, func(string) bool { return true })
+		err = manager.SyncWithConfirmation(SyncOptions{Force: true}, func(string) bool { return true })
 		require.NoError(t, err)
 
 		// Verify dev worktree was recreated
@@ -320,7 +321,7 @@ func TestManager_SyncWorkreePromotion(t *testing.T) {
 		require.NoError(t, manager.LoadGBMConfig(""))
 
 		// Initial sync creates worktrees
-		err = manager.SyncWithConfirmation(false, false, func(string) bool { return true })
+		err = manager.SyncWithConfirmation(SyncOptions{}, func(string) bool { return true })
 		require.NoError(t, err)
 
 		// Modify config to cause promotion in source repo: production worktree should now point to production-v2
@@ -346,7 +347,7 @@ func TestManager_SyncWorkreePromotion(t *testing.T) {
 		}
 		// Reload gbm.branchconfig.yaml after pulling updates
 		require.NoError(t, manager.LoadGBMConfig(""))
-		err = manager.SyncWithConfirmation(false, false, func(string) bool { return true })
+		err = manager.SyncWithConfirmation(SyncOptions{}, func(string) bool { return true })
 		require.NoError(t, err)
 
 		// Validate promotion occurred correctly
@@ -383,7 +384,7 @@ func TestManager_GetSyncStatus(t *testing.T) {
 		assert.Contains(t, status.MissingWorktrees, "prod")
 
 		// After sync, should be in sync
-		err = manager.SyncWithConfirmation(false, false, func(string) bool { return true })
+		err = manager.SyncWithConfirmation(SyncOptions{}, func(string) bool { return true })
 		require.NoError(t, err)
 
 		status, err = manager.GetSyncStatus()
@@ -392,5 +393,375 @@ func TestManager_GetSyncStatus(t *testing.T) {
 		assert.Empty(t, status.MissingWorktrees)
 		assert.Empty(t, status.BranchChanges)
 		assert.Empty(t, status.OrphanedWorktrees)
+
+		// A worktree switched to a detached HEAD is not reported as a branch change
+		require.NoError(t, execGitCommandRun(filepath.Join(wd, "worktrees", "dev"), "checkout", "--detach"))
+
+		status, err = manager.GetSyncStatus()
+		require.NoError(t, err)
+		assert.True(t, status.InSync)
+		assert.Empty(t, status.BranchChanges)
 	})
 }
+
+func TestManager_SyncStashDirtyWorktrees(t *testing.T) {
+	tests := []struct {
+		name        string
+		dirtyFile   string
+		dirtyText   string
+		expectError bool
+	}{
+		{
+			name:      "untracked changes are restored on the new branch",
+			dirtyFile: "notes.txt",
+			dirtyText: "work in progress",
+		},
+		{
+			name:        "conflicting changes are reported and kept in the stash",
+			dirtyFile:   "content.txt",
+			dirtyText:   "local edit",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sourceRepo := testutils.NewMultiBranchRepo(t)
+			defer sourceRepo.Cleanup()
+			require.NoError(t, sourceRepo.CreateGBMConfig(map[string]testutils.WorktreeConfig{
+				"main": {Branch: "main", Description: "Main branch"},
+				"dev":  {Branch: "develop", Description: "Development branch"},
+			}))
+			require.NoError(t, sourceRepo.CommitChangesWithForceAdd("Add initial gbm config"))
+			require.NoError(t, sourceRepo.PushBranch("main"))
+
+			wd := t.TempDir()
+			require.NoError(t, os.Chdir(wd))
+			require.NoError(t, execGitCommandRun(wd, "clone", sourceRepo.GetRemotePath(), "."))
+
+			manager, err := NewManager(wd)
+			require.NoError(t, err)
+			require.NoError(t, manager.LoadGBMConfig(""))
+			require.NoError(t, manager.SyncWithConfirmation(SyncOptions{}, func(string) bool { return true }))
+
+			devPath := filepath.Join(wd, "worktrees", "dev")
+			require.NoError(t, os.WriteFile(filepath.Join(devPath, tt.dirtyFile), []byte(tt.dirtyText), 0o644))
+
+			// Point dev at a different branch so sync recreates the worktree
+			gbmContent := `worktrees:
+  main:
+    branch: main
+    description: "Main branch"
+  dev:
+    branch: feature/auth
+    description: "Development branch"
+`
+			require.NoError(t, os.WriteFile(filepath.Join(wd, DefaultBranchConfigFilename), []byte(gbmContent), 0o644))
+			require.NoError(t, manager.LoadGBMConfig(""))
+
+			err = manager.SyncWithConfirmation(SyncOptions{StashDirty: true}, func(string) bool { return true })
+
+			branch, branchErr := manager.GetGitManager().GetCurrentBranchInPath(devPath)
+			require.NoError(t, branchErr)
+			assert.Equal(t, "feature/auth", branch)
+
+			if tt.expectError {
+				assert.ErrorIs(t, err, ErrStashConflict)
+				assert.ErrorContains(t, err, "dev")
+				return
+			}
+
+			require.NoError(t, err)
+			content, err := os.ReadFile(filepath.Join(devPath, tt.dirtyFile))
+			require.NoError(t, err)
+			assert.Equal(t, tt.dirtyText, string(content))
+		})
+	}
+}
+
+func TestManager_SyncRefusesDirtyWorktrees(t *testing.T) {
+	sourceRepo := testutils.NewMultiBranchRepo(t)
+	defer sourceRepo.Cleanup()
+	require.NoError(t, sourceRepo.CreateGBMConfig(map[string]testutils.WorktreeConfig{
+		"main": {Branch: "main", Description: "Main branch"},
+		"dev":  {Branch: "develop", Description: "Development branch"},
+	}))
+	require.NoError(t, sourceRepo.CommitChangesWithForceAdd("Add initial gbm config"))
+	require.NoError(t, sourceRepo.PushBranch("main"))
+
+	originalDir, _ := os.Getwd()
+	t.Cleanup(func() { _ = os.Chdir(originalDir) })
+
+	wd := t.TempDir()
+	require.NoError(t, os.Chdir(wd))
+	require.NoError(t, execGitCommandRun(wd, "clone", sourceRepo.GetRemotePath(), "."))
+
+	manager, err := NewManager(wd)
+	require.NoError(t, err)
+	require.NoError(t, manager.LoadGBMConfig(""))
+	require.NoError(t, manager.SyncWithConfirmation(SyncOptions{}, func(string) bool { return true }))
+
+	devPath := filepath.Join(wd, "worktrees", "dev")
+	notesPath := filepath.Join(devPath, "notes.txt")
+	require.NoError(t, os.WriteFile(notesPath, []byte("work in progress"), 0o644))
+
+	gbmContent := `worktrees:
+  main:
+    branch: main
+    description: "Main branch"
+  dev:
+    branch: feature/auth
+    description: "Development branch"
+`
+	require.NoError(t, os.WriteFile(filepath.Join(wd, DefaultBranchConfigFilename), []byte(gbmContent), 0o644))
+	require.NoError(t, manager.LoadGBMConfig(""))
+
+	status, err := manager.GetSyncStatus()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dev"}, status.DirtyWorktrees)
+
+	err = manager.SyncWithConfirmation(SyncOptions{}, func(string) bool { return true })
+	assert.ErrorIs(t, err, ErrDirtyWorktrees)
+	assert.ErrorContains(t, err, "dev")
+	assert.FileExists(t, notesPath, "refused sync must leave local changes alone")
+
+	require.NoError(t, manager.SyncWithConfirmation(SyncOptions{ResetDirty: true}, func(string) bool { return true }))
+	branch, err := manager.GetGitManager().GetCurrentBranchInPath(devPath)
+	require.NoError(t, err)
+	assert.Equal(t, "feature/auth", branch)
+	assert.NoFileExists(t, notesPath)
+}
+
+// recordingSyncProgress collects sync progress events for assertions
+type recordingSyncProgress struct {
+	steps []SyncStep
+}
+
+func (r *recordingSyncProgress) SyncStep(step SyncStep) {
+	r.steps = append(r.steps, step)
+}
+
+func TestManager_SyncReportsProgress(t *testing.T) {
+	sourceRepo := testutils.NewMultiBranchRepo(t)
+	defer sourceRepo.Cleanup()
+	require.NoError(t, sourceRepo.CreateGBMConfig(map[string]testutils.WorktreeConfig{
+		"main": {Branch: "main", Description: "Main branch"},
+		"dev":  {Branch: "develop", Description: "Development branch"},
+	}))
+	require.NoError(t, sourceRepo.CommitChangesWithForceAdd("Add initial gbm config"))
+	require.NoError(t, sourceRepo.PushBranch("main"))
+
+	originalDir, _ := os.Getwd()
+	t.Cleanup(func() { _ = os.Chdir(originalDir) })
+
+	wd := t.TempDir()
+	require.NoError(t, os.Chdir(wd))
+	require.NoError(t, execGitCommandRun(wd, "clone", sourceRepo.GetRemotePath(), "."))
+
+	manager, err := NewManager(wd)
+	require.NoError(t, err)
+	require.NoError(t, manager.LoadGBMConfig(""))
+
+	progress := &recordingSyncProgress{}
+	require.NoError(t, manager.SyncWithConfirmation(SyncOptions{Progress: progress}, func(string) bool { return true }))
+
+	require.NotEmpty(t, progress.steps)
+	assert.Equal(t, SyncStep{Kind: SyncStepFetch}, progress.steps[0])
+	assert.Contains(t, progress.steps, SyncStep{Kind: SyncStepCreate, Worktree: "dev", Branch: "develop"})
+	assert.Equal(t, "Creating worktree dev (develop)", SyncStep{Kind: SyncStepCreate, Worktree: "dev", Branch: "develop"}.String())
+}
+
+func TestManager_SyncNoFetchWorksOffline(t *testing.T) {
+	sourceRepo := testutils.NewMultiBranchRepo(t)
+	defer sourceRepo.Cleanup()
+	require.NoError(t, sourceRepo.CreateGBMConfig(map[string]testutils.WorktreeConfig{
+		"main": {Branch: "main", Description: "Main branch"},
+		"dev":  {Branch: "develop", Description: "Development branch"},
+	}))
+	require.NoError(t, sourceRepo.CommitChangesWithForceAdd("Add initial gbm config"))
+	require.NoError(t, sourceRepo.PushBranch("main"))
+
+	originalDir, _ := os.Getwd()
+	t.Cleanup(func() { _ = os.Chdir(originalDir) })
+
+	wd := t.TempDir()
+	require.NoError(t, os.Chdir(wd))
+	require.NoError(t, execGitCommandRun(wd, "clone", sourceRepo.GetRemotePath(), "."))
+
+	// Simulate being offline: the remote is no longer reachable
+	require.NoError(t, execGitCommandRun(wd, "remote", "set-url", "origin", filepath.Join(t.TempDir(), "unreachable")))
+
+	manager, err := NewManager(wd)
+	require.NoError(t, err)
+	require.NoError(t, manager.LoadGBMConfig(""))
+
+	err = manager.SyncWithConfirmation(SyncOptions{}, func(string) bool { return true })
+	assert.ErrorContains(t, err, "failed to fetch")
+
+	progress := &recordingSyncProgress{}
+	require.NoError(t, manager.SyncWithConfirmation(SyncOptions{NoFetch: true, Progress: progress}, func(string) bool { return true }))
+
+	assert.NotContains(t, progress.steps, SyncStep{Kind: SyncStepFetch})
+	assert.Contains(t, progress.steps, SyncStep{Kind: SyncStepCreate, Worktree: "dev", Branch: "develop"})
+	assert.DirExists(t, filepath.Join(wd, "worktrees", "dev"))
+}
+
+func TestManager_SyncRecreatesDeletedWorktreeDirectory(t *testing.T) {
+	sourceRepo := testutils.NewMultiBranchRepo(t)
+	defer sourceRepo.Cleanup()
+	require.NoError(t, sourceRepo.CreateGBMConfig(map[string]testutils.WorktreeConfig{
+		"main": {Branch: "main", Description: "Main branch"},
+		"dev":  {Branch: "develop", Description: "Development branch"},
+	}))
+	require.NoError(t, sourceRepo.CommitChangesWithForceAdd("Add initial gbm config"))
+	require.NoError(t, sourceRepo.PushBranch("main"))
+
+	originalDir, _ := os.Getwd()
+	t.Cleanup(func() { _ = os.Chdir(originalDir) })
+
+	wd := t.TempDir()
+	require.NoError(t, os.Chdir(wd))
+	require.NoError(t, execGitCommandRun(wd, "clone", sourceRepo.GetRemotePath(), "."))
+
+	manager, err := NewManager(wd)
+	require.NoError(t, err)
+	require.NoError(t, manager.LoadGBMConfig(""))
+	require.NoError(t, manager.SyncWithConfirmation(SyncOptions{}, func(string) bool { return true }))
+
+	// Delete the directory behind git's back; the worktree stays registered
+	devPath := filepath.Join(wd, "worktrees", "dev")
+	require.NoError(t, os.RemoveAll(devPath))
+
+	status, err := manager.GetSyncStatus()
+	require.NoError(t, err)
+	assert.Contains(t, status.MissingWorktrees, "dev")
+
+	require.NoError(t, manager.SyncWithConfirmation(SyncOptions{}, func(string) bool { return true }))
+	assert.FileExists(t, filepath.Join(devPath, ".git"))
+
+	registered, onDisk, err := manager.GetGitManager().WorktreeExists(devPath)
+	require.NoError(t, err)
+	assert.True(t, registered)
+	assert.True(t, onDisk)
+}
+
+func TestManager_SyncCreatesMissingBranches(t *testing.T) {
+	sourceRepo := testutils.NewMultiBranchRepo(t)
+	defer sourceRepo.Cleanup()
+	require.NoError(t, sourceRepo.CreateGBMConfig(map[string]testutils.WorktreeConfig{
+		"main": {Branch: "main", Description: "Main branch"},
+		"qa":   {Branch: "qa/next", BaseBranch: "develop", Description: "QA branch"},
+	}))
+	require.NoError(t, sourceRepo.CommitChangesWithForceAdd("Add initial gbm config"))
+	require.NoError(t, sourceRepo.PushBranch("main"))
+
+	originalDir, _ := os.Getwd()
+	t.Cleanup(func() { _ = os.Chdir(originalDir) })
+
+	wd := t.TempDir()
+	require.NoError(t, os.Chdir(wd))
+	require.NoError(t, execGitCommandRun(wd, "clone", sourceRepo.GetRemotePath(), "."))
+
+	manager, err := NewManager(wd)
+	require.NoError(t, err)
+	require.NoError(t, manager.LoadGBMConfig(""))
+
+	err = manager.SyncWithConfirmation(SyncOptions{}, func(string) bool { return true })
+	assert.ErrorContains(t, err, "does not exist")
+
+	err = manager.SyncWithConfirmation(SyncOptions{CreateMissingBranches: true}, func(string) bool { return false })
+	assert.ErrorContains(t, err, "creation of branch 'qa/next' cancelled by user")
+
+	progress := &recordingSyncProgress{}
+	require.NoError(t, manager.SyncWithConfirmation(SyncOptions{CreateMissingBranches: true, Progress: progress}, func(string) bool { return true }))
+
+	step := SyncStep{Kind: SyncStepCreateBranch, Worktree: "qa", Branch: "qa/next", Base: "develop"}
+	assert.Contains(t, progress.steps, step)
+	assert.Equal(t, "Creating branch qa/next from develop", step.String())
+	assert.DirExists(t, filepath.Join(wd, "worktrees", "qa"))
+
+	branchHead, err := manager.GetGitManager().GetCommitHash("qa/next")
+	require.NoError(t, err)
+	baseHead, err := manager.GetGitManager().GetCommitHash("origin/develop")
+	require.NoError(t, err)
+	assert.Equal(t, baseHead, branchHead)
+}
+
+func TestManager_SyncFetchesBeforeCreatingMissingBranches(t *testing.T) {
+	sourceRepo := testutils.NewMultiBranchRepo(t)
+	defer sourceRepo.Cleanup()
+	require.NoError(t, sourceRepo.CreateGBMConfig(map[string]testutils.WorktreeConfig{
+		"main": {Branch: "main", Description: "Main branch"},
+		"qa":   {Branch: "qa/next", BaseBranch: "develop", Description: "QA branch"},
+	}))
+	require.NoError(t, sourceRepo.CommitChangesWithForceAdd("Add initial gbm config"))
+	require.NoError(t, sourceRepo.PushBranch("main"))
+
+	originalDir, _ := os.Getwd()
+	t.Cleanup(func() { _ = os.Chdir(originalDir) })
+
+	wd := t.TempDir()
+	require.NoError(t, os.Chdir(wd))
+	require.NoError(t, execGitCommandRun(wd, "clone", sourceRepo.GetRemotePath(), "."))
+
+	// qa/next is pushed after the clone, so it only exists on the remote until the next fetch
+	require.NoError(t, sourceRepo.CreateBranchFrom("qa/next", "develop", "qa content"))
+
+	manager, err := NewManager(wd)
+	require.NoError(t, err)
+	require.NoError(t, manager.LoadGBMConfig(""))
+
+	progress := &recordingSyncProgress{}
+	require.NoError(t, manager.SyncWithConfirmation(SyncOptions{CreateMissingBranches: true, Progress: progress}, func(message string) bool {
+		assert.NotContains(t, message, "does not exist", "qa/next exists on the remote and must not be recreated")
+		return true
+	}))
+	for _, step := range progress.steps {
+		assert.NotEqual(t, SyncStepCreateBranch, step.Kind)
+	}
+
+	worktreeHead, err := ExecGitCommand(filepath.Join(wd, "worktrees", "qa"), "rev-parse", "HEAD")
+	require.NoError(t, err)
+	remoteHead, err := manager.GetGitManager().GetCommitHash("origin/qa/next")
+	require.NoError(t, err)
+	assert.Equal(t, remoteHead, strings.TrimSpace(string(worktreeHead)))
+}
+
+func TestManager_SyncCallsAfterFetch(t *testing.T) {
+	sourceRepo := testutils.NewMultiBranchRepo(t)
+	defer sourceRepo.Cleanup()
+	require.NoError(t, sourceRepo.CreateGBMConfig(map[string]testutils.WorktreeConfig{
+		"main": {Branch: "main", Description: "Main branch"},
+		"dev":  {Branch: "develop", Description: "Development branch"},
+	}))
+	require.NoError(t, sourceRepo.CommitChangesWithForceAdd("Add initial gbm config"))
+	require.NoError(t, sourceRepo.PushBranch("main"))
+
+	originalDir, _ := os.Getwd()
+	t.Cleanup(func() { _ = os.Chdir(originalDir) })
+
+	wd := t.TempDir()
+	require.NoError(t, os.Chdir(wd))
+	require.NoError(t, execGitCommandRun(wd, "clone", sourceRepo.GetRemotePath(), "."))
+
+	manager, err := NewManager(wd)
+	require.NoError(t, err)
+	require.NoError(t, manager.LoadGBMConfig(""))
+
+	for _, noFetch := range []bool{false, true} {
+		progress := &recordingSyncProgress{}
+		var stepsBeforeAfterFetch []int
+		require.NoError(t, manager.SyncWithConfirmation(SyncOptions{
+			NoFetch:    noFetch,
+			Progress:   progress,
+			AfterFetch: func() { stepsBeforeAfterFetch = append(stepsBeforeAfterFetch, len(progress.steps)) },
+		}, func(string) bool { return true }))
+
+		// Called exactly once, as soon as the fetch step is over
+		expectedSteps := 1
+		if noFetch {
+			expectedSteps = 0
+		}
+		assert.Equal(t, []int{expectedSteps}, stepsBeforeAfterFetch, "noFetch=%v", noFetch)
+	}
+}
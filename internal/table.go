@@ -29,6 +29,7 @@ func NewTable(headers []string) *Table {
 		"ENV VARIABLE": 15,
 		"ENV VAR":      12,
 		"ISSUES":       20,
+		"LAST COMMIT":  12,
 	}
 
 	return &Table{
@@ -52,6 +53,7 @@ func NewTestTable(headers []string, termWidth int) *Table {
 		"ENV VARIABLE": 15,
 		"ENV VAR":      12,
 		"ISSUES":       20,
+		"LAST COMMIT":  12,
 	}
 
 	return &Table{
@@ -131,7 +133,7 @@ func (t *Table) getResponsiveHeaders() ([]string, []int) {
 	columnIndices := make([]int, 0)
 
 	// Always include these columns first (in priority order)
-	priorityOrder := []string{"ENV VARIABLE", "WORKTREE", "BRANCH", "GIT STATUS", "SYNC STATUS", "STATUS"}
+	priorityOrder := []string{"ENV VARIABLE", "WORKTREE", "BRANCH", "GIT STATUS", "SYNC STATUS", "LAST COMMIT", "STATUS"}
 
 	for _, priorityHeader := range priorityOrder {
 		for i, header := range t.headers {
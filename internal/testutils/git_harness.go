@@ -36,6 +36,7 @@ type WorktreeConfig struct {
 	Branch      string `yaml:"branch"`
 	MergeInto   string `yaml:"merge_into,omitempty"`
 	Description string `yaml:"description,omitempty"`
+	BaseBranch  string `yaml:"base_branch,omitempty"`
 }
 
 var defaultConfig = RepoConfig{
@@ -0,0 +1,138 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+)
+
+// AddTrackedWorktree adds a worktree entry to gbm.branchconfig.yaml in the repository root and
+// saves it. The file is created if it doesn't exist yet; merge_into must name a tracked worktree.
+func (m *Manager) AddTrackedWorktree(name, branch, description, mergeInto string) error {
+	if name == "" || branch == "" {
+		return fmt.Errorf("worktree name and branch must not be empty")
+	}
+
+	if err := m.loadOrCreateGBMConfig(); err != nil {
+		return err
+	}
+
+	if _, exists := m.gbmConfig.Worktrees[name]; exists {
+		return fmt.Errorf("worktree '%s' is already tracked in %s", name, DefaultBranchConfigFilename)
+	}
+
+	worktrees := maps.Clone(m.gbmConfig.Worktrees)
+	if worktrees == nil {
+		worktrees = make(map[string]WorktreeConfig)
+	}
+	worktrees[name] = WorktreeConfig{Branch: branch, MergeInto: mergeInto, Description: description}
+
+	if err := m.saveGBMConfigWorktrees(worktrees); err != nil {
+		return fmt.Errorf("invalid worktree '%s': %w", name, err)
+	}
+
+	return nil
+}
+
+// TrackWorktree promotes an existing ad hoc worktree into gbm.branchconfig.yaml so that
+// `gbm sync` manages it from now on
+func (m *Manager) TrackWorktree(worktreeName, branch, description, mergeInto string) error {
+	unlock, err := m.lockRepo(true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	worktreePath := filepath.Join(m.repoPath, m.config.Settings.WorktreePrefix, worktreeName)
+	if _, err := os.Stat(worktreePath); os.IsNotExist(err) {
+		return fmt.Errorf("worktree '%s' does not exist", worktreeName)
+	}
+
+	if err := m.AddTrackedWorktree(worktreeName, branch, description, mergeInto); err != nil {
+		return err
+	}
+
+	m.state.AdHocWorktrees = slices.DeleteFunc(m.state.AdHocWorktrees, func(name string) bool {
+		return name == worktreeName
+	})
+	if err := m.SaveState(); err != nil {
+		return fmt.Errorf("worktree tracked but failed to save state: %w", err)
+	}
+
+	return nil
+}
+
+// UntrackWorktree removes a worktree from gbm.branchconfig.yaml and keeps its directory as an
+// ad hoc worktree. Worktrees that others merge into can't be untracked.
+func (m *Manager) UntrackWorktree(worktreeName string) error {
+	unlock, err := m.lockRepo(true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	if m.gbmConfig == nil {
+		if err := m.LoadGBMConfig(""); err != nil {
+			return fmt.Errorf("no %s loaded", DefaultBranchConfigFilename)
+		}
+	}
+
+	if _, exists := m.gbmConfig.Worktrees[worktreeName]; !exists {
+		return fmt.Errorf("worktree '%s' is not tracked in %s", worktreeName, DefaultBranchConfigFilename)
+	}
+
+	worktrees := maps.Clone(m.gbmConfig.Worktrees)
+	delete(worktrees, worktreeName)
+
+	if err := m.saveGBMConfigWorktrees(worktrees); err != nil {
+		return fmt.Errorf("cannot untrack worktree '%s': %w", worktreeName, err)
+	}
+
+	worktreePath := filepath.Join(m.repoPath, m.config.Settings.WorktreePrefix, worktreeName)
+	if _, err := os.Stat(worktreePath); err == nil && !contains(m.state.AdHocWorktrees, worktreeName) {
+		m.state.AdHocWorktrees = append(m.state.AdHocWorktrees, worktreeName)
+		if err := m.SaveState(); err != nil {
+			return fmt.Errorf("worktree untracked but failed to save state: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// loadOrCreateGBMConfig loads gbm.branchconfig.yaml from the repository root, starting from an
+// empty config when the file doesn't exist yet
+func (m *Manager) loadOrCreateGBMConfig() error {
+	if m.gbmConfig != nil {
+		return nil
+	}
+
+	if err := m.LoadGBMConfig(""); err != nil {
+		if !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		m.gbmConfig = &GBMConfig{}
+	}
+
+	return nil
+}
+
+// saveGBMConfigWorktrees validates the worktree tree, writes it to gbm.branchconfig.yaml in the
+// repository root and only then replaces the in-memory config
+func (m *Manager) saveGBMConfigWorktrees(worktrees map[string]WorktreeConfig) error {
+	updated := &GBMConfig{Worktrees: worktrees}
+	tree, err := NewWorktreeManager(updated)
+	if err != nil {
+		return err
+	}
+	updated.Tree = tree
+
+	if err := updated.Save(filepath.Join(m.repoPath, DefaultBranchConfigFilename)); err != nil {
+		return err
+	}
+
+	m.gbmConfig = updated
+	return nil
+}
@@ -0,0 +1,68 @@
+package internal
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManager_AddTrackedWorktree(t *testing.T) {
+	manager, repoPath, _ := setupManagerForRemoverTests(t)
+	configPath := filepath.Join(repoPath, DefaultBranchConfigFilename)
+
+	// The branch config is created on first use
+	require.NoError(t, manager.AddTrackedWorktree("dev", "dev", "Development", ""))
+	require.NoError(t, manager.AddTrackedWorktree("feat", "feat", "", "dev"))
+
+	parsed, err := ParseGBMConfig(configPath)
+	require.NoError(t, err)
+	assert.Equal(t, map[string]WorktreeConfig{
+		"dev":  {Branch: "dev", Description: "Development"},
+		"feat": {Branch: "feat", MergeInto: "dev"},
+	}, parsed.Worktrees)
+	assert.Equal(t, parsed.Worktrees, manager.GetGBMConfig().Worktrees)
+
+	// Invalid entries leave the file untouched
+	err = manager.AddTrackedWorktree("dev", "other", "", "")
+	assert.ErrorContains(t, err, "already tracked")
+	err = manager.AddTrackedWorktree("hotfix", "hotfix", "", "missing")
+	assert.ErrorContains(t, err, "non-existent merge_into target 'missing'")
+
+	parsed, err = ParseGBMConfig(configPath)
+	require.NoError(t, err)
+	assert.Len(t, parsed.Worktrees, 2)
+	assert.NotContains(t, manager.GetGBMConfig().Worktrees, "hotfix")
+}
+
+func TestManager_TrackUntrackWorktree(t *testing.T) {
+	manager, repoPath, _ := setupManagerForRemoverTests(t)
+	manager.state.AdHocWorktrees = []string{"dev", "feat"}
+
+	require.NoError(t, manager.TrackWorktree("dev", "dev", "", ""))
+	require.NoError(t, manager.TrackWorktree("feat", "feat", "Feature work", "dev"))
+	assert.Empty(t, manager.GetState().AdHocWorktrees)
+
+	parsed, err := ParseGBMConfig(filepath.Join(repoPath, DefaultBranchConfigFilename))
+	require.NoError(t, err)
+	assert.Equal(t, WorktreeConfig{Branch: "feat", MergeInto: "dev", Description: "Feature work"}, parsed.Worktrees["feat"])
+
+	err = manager.TrackWorktree("missing", "missing", "", "")
+	assert.ErrorContains(t, err, "worktree 'missing' does not exist")
+
+	// dev can't be untracked while feat merges into it
+	err = manager.UntrackWorktree("dev")
+	assert.ErrorContains(t, err, "cannot untrack worktree 'dev'")
+
+	require.NoError(t, manager.UntrackWorktree("feat"))
+	assert.Equal(t, []string{"feat"}, manager.GetState().AdHocWorktrees)
+	assert.DirExists(t, filepath.Join(repoPath, "worktrees", "feat"))
+
+	parsed, err = ParseGBMConfig(filepath.Join(repoPath, DefaultBranchConfigFilename))
+	require.NoError(t, err)
+	assert.NotContains(t, parsed.Worktrees, "feat")
+
+	err = manager.UntrackWorktree("feat")
+	assert.ErrorContains(t, err, "is not tracked")
+}
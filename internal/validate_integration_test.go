@@ -55,3 +55,23 @@ func TestManager_ValidateConfig_MissingBranches(t *testing.T) {
 	err = manager.ValidateConfig()
 	require.Error(t, err)
 }
+
+func TestManager_LoadGBMConfig_DuplicateBranches(t *testing.T) {
+	repo := testutils.NewMultiBranchRepo(t)
+
+	worktrees := map[string]testutils.WorktreeConfig{
+		"main":    {Branch: "main", Description: "Main"},
+		"dev":     {Branch: "develop", MergeInto: "main", Description: "Dev"},
+		"staging": {Branch: "develop", MergeInto: "main", Description: "Staging"},
+	}
+	require.NoError(t, repo.CreateGBMConfig(worktrees))
+	require.NoError(t, repo.CommitChangesWithForceAdd("Add gbm.branchconfig.yaml"))
+
+	manager, err := NewManager(repo.GetLocalPath())
+	require.NoError(t, err)
+
+	// The config is rejected when loaded, naming both worktrees sharing the branch
+	err = manager.LoadGBMConfig("")
+	require.ErrorIs(t, err, ErrDuplicateBranch)
+	require.ErrorContains(t, err, "branch 'develop' is assigned to multiple worktrees: dev, staging")
+}
@@ -0,0 +1,161 @@
+package internal
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Entries written by ArchiveWorktree, relative to the archive's top-level directory
+const (
+	archiveUncommittedPatch = "uncommitted.patch"
+	archiveBasePatch        = "base.patch"
+	archiveUntrackedDir     = "untracked"
+)
+
+// ArchiveWorktree snapshots a worktree into a <worktree>-<timestamp>.tar.gz file in destDir so its
+// work can be recovered after removal. The archive holds a patch of uncommitted changes to tracked
+// files, a copy of every untracked (non-ignored) file, and, when the worktree's base branch is
+// recorded, a patch of the working tree against its merge-base with that branch.
+func (m *Manager) ArchiveWorktree(worktreeName, destDir string) error {
+	worktreePath, err := m.GetWorktreePath(worktreeName)
+	if err != nil {
+		return err
+	}
+
+	uncommitted, err := ExecGitCommand(worktreePath, "diff", "--binary", "HEAD")
+	if err != nil {
+		return fmt.Errorf("failed to diff worktree '%s': %w", worktreeName, enhanceGitError(err, "diff"))
+	}
+
+	output, err := ExecGitCommand(worktreePath, "ls-files", "--others", "--exclude-standard", "-z")
+	if err != nil {
+		return fmt.Errorf("failed to list untracked files in '%s': %w", worktreeName, enhanceGitError(err, "ls-files"))
+	}
+	var untracked []string
+	for file := range strings.SplitSeq(string(output), "\x00") {
+		if file != "" {
+			untracked = append(untracked, file)
+		}
+	}
+
+	var basePatch []byte
+	if baseBranch, exists := m.state.GetWorktreeBaseBranch(worktreeName); exists && baseBranch != "" {
+		mergeBase, _, err := m.GetWorktreeMergeBase(worktreePath, baseBranch)
+		if err != nil || mergeBase == "" {
+			logWarn("Skipping base patch for '%s': no merge-base with %s", worktreeName, baseBranch)
+		} else if basePatch, err = ExecGitCommand(worktreePath, "diff", "--binary", mergeBase); err != nil {
+			return fmt.Errorf("failed to diff worktree '%s' against %s: %w", worktreeName, baseBranch, enhanceGitError(err, "diff"))
+		}
+	}
+
+	if err := os.MkdirAll(destDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create archive directory: %w", err)
+	}
+
+	stamp := time.Now().Format("20060102-150405")
+	archiveName := fmt.Sprintf("%s-%s", strings.ReplaceAll(worktreeName, "/", "-"), stamp)
+	archivePath := filepath.Join(destDir, archiveName+".tar.gz")
+
+	file, err := os.Create(archivePath)
+	if err != nil {
+		return fmt.Errorf("failed to create archive: %w", err)
+	}
+
+	if err := writeWorktreeArchive(file, archiveName, worktreePath, uncommitted, basePatch, untracked); err != nil {
+		_ = file.Close()
+		_ = os.Remove(archivePath)
+		return fmt.Errorf("failed to write archive for worktree '%s': %w", worktreeName, err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("failed to write archive for worktree '%s': %w", worktreeName, err)
+	}
+
+	logInfo("Archived worktree '%s' to %s", worktreeName, archivePath)
+	return nil
+}
+
+// writeWorktreeArchive writes the patches and untracked files as a gzipped tarball under root/
+func writeWorktreeArchive(w io.Writer, root, worktreePath string, uncommitted, basePatch []byte, untracked []string) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	modTime := time.Now()
+
+	writeBytes := func(name string, data []byte) error {
+		header := &tar.Header{
+			Name:    path.Join(root, name),
+			Mode:    0o644,
+			Size:    int64(len(data)),
+			ModTime: modTime,
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return err
+		}
+		_, err := tw.Write(data)
+		return err
+	}
+
+	if err := writeBytes(archiveUncommittedPatch, uncommitted); err != nil {
+		return err
+	}
+	if basePatch != nil {
+		if err := writeBytes(archiveBasePatch, basePatch); err != nil {
+			return err
+		}
+	}
+
+	for _, rel := range untracked {
+		if err := addFileToArchive(tw, filepath.Join(worktreePath, rel), path.Join(root, archiveUntrackedDir, filepath.ToSlash(rel))); err != nil {
+			return err
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+// addFileToArchive copies a regular file or symlink into the archive
+func addFileToArchive(tw *tar.Writer, srcPath, name string) error {
+	info, err := os.Lstat(srcPath)
+	if err != nil {
+		return err
+	}
+
+	var link string
+	if info.Mode()&os.ModeSymlink != 0 {
+		if link, err = os.Readlink(srcPath); err != nil {
+			return err
+		}
+	} else if !info.Mode().IsRegular() {
+		return nil
+	}
+
+	header, err := tar.FileInfoHeader(info, link)
+	if err != nil {
+		return err
+	}
+	header.Name = name
+	if err := tw.WriteHeader(header); err != nil {
+		return err
+	}
+	if link != "" {
+		return nil
+	}
+
+	src, err := os.Open(srcPath)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+
+	_, err = io.Copy(tw, src)
+	return err
+}
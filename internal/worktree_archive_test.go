@@ -0,0 +1,92 @@
+package internal
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// readArchive returns the contents of every file in a .tar.gz keyed by entry name
+func readArchive(t *testing.T, archivePath string) map[string]string {
+	file, err := os.Open(archivePath)
+	require.NoError(t, err)
+	defer file.Close()
+
+	gz, err := gzip.NewReader(file)
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+
+	entries := make(map[string]string)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		data, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		entries[header.Name] = string(data)
+	}
+	return entries
+}
+
+func TestManager_ArchiveWorktree(t *testing.T) {
+	manager, repoPath, _ := setupManagerForRemoverTests(t)
+
+	must(t, manager.AddWorktree("topic", "topic", true, "main"))
+	topicPath, err := manager.GetWorktreePath("topic")
+	require.NoError(t, err)
+
+	// Committed work on the branch, an uncommitted edit and an untracked file
+	must(t, os.WriteFile(filepath.Join(topicPath, "committed.txt"), []byte("committed work\n"), 0o644))
+	_, err = ExecGitCommand(topicPath, "add", "committed.txt")
+	require.NoError(t, err)
+	_, err = ExecGitCommand(topicPath, "commit", "-m", "Add committed work")
+	require.NoError(t, err)
+	must(t, os.WriteFile(filepath.Join(topicPath, "committed.txt"), []byte("edited work\n"), 0o644))
+	must(t, os.MkdirAll(filepath.Join(topicPath, "notes"), 0o755))
+	must(t, os.WriteFile(filepath.Join(topicPath, "notes", "todo.txt"), []byte("untracked notes\n"), 0o644))
+
+	destDir := filepath.Join(repoPath, ".gbm", "archives")
+	require.NoError(t, manager.ArchiveWorktree("topic", destDir))
+
+	archives, err := filepath.Glob(filepath.Join(destDir, "topic-*.tar.gz"))
+	require.NoError(t, err)
+	require.Len(t, archives, 1)
+
+	entries := readArchive(t, archives[0])
+	root := filepath.Base(archives[0][:len(archives[0])-len(".tar.gz")])
+
+	uncommitted := entries[root+"/uncommitted.patch"]
+	assert.Contains(t, uncommitted, "-committed work")
+	assert.Contains(t, uncommitted, "+edited work")
+
+	base := entries[root+"/base.patch"]
+	assert.Contains(t, base, "+edited work")
+	assert.NotContains(t, base, "committed work")
+
+	assert.Equal(t, "untracked notes\n", entries[root+"/untracked/notes/todo.txt"])
+
+	t.Run("missing worktree", func(t *testing.T) {
+		assert.ErrorContains(t, manager.ArchiveWorktree("missing", destDir), "does not exist")
+	})
+
+	t.Run("no recorded base skips the base patch", func(t *testing.T) {
+		manager.GetState().RemoveWorktreeBaseBranch("dev")
+		otherDir := t.TempDir()
+		require.NoError(t, manager.ArchiveWorktree("dev", otherDir))
+
+		archives, err := filepath.Glob(filepath.Join(otherDir, "dev-*.tar.gz"))
+		require.NoError(t, err)
+		require.Len(t, archives, 1)
+		for name := range readArchive(t, archives[0]) {
+			assert.NotContains(t, name, "base.patch")
+		}
+	})
+}
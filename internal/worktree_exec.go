@@ -0,0 +1,58 @@
+package internal
+
+import (
+	"errors"
+	"io"
+	"os/exec"
+	"path/filepath"
+)
+
+// RunInWorktree runs command in the named worktree with its output streamed to stdout and stderr.
+// GBM_WORKTREE_NAME, GBM_WORKTREE_PATH and GBM_BRANCH are set in the command's environment, as for
+// hooks. stdin may be nil.
+func (m *Manager) RunInWorktree(worktreeName string, command []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	worktreePath, err := m.GetWorktreePath(worktreeName)
+	if err != nil {
+		return err
+	}
+
+	return m.runInWorktreePath(worktreeName, worktreePath, command, stdin, stdout, stderr)
+}
+
+// RunInAllWorktrees runs command in every worktree, less the main one when excluded, one at a time or
+// up to bulkConcurrency at once when parallel. Each worktree's output goes to the writer output
+// returns for it, which is closed once the command has finished. Results are sorted by name and the
+// returned error aggregates all failures.
+func (m *Manager) RunInAllWorktrees(command []string, parallel bool, output func(worktreeName string) io.WriteCloser) ([]WorktreeResult, error) {
+	concurrency := 1
+	if parallel {
+		concurrency = bulkConcurrency
+	}
+
+	return m.forEachWorktree("exec", concurrency, false, func(info *WorktreeListInfo) error {
+		name := filepath.Base(info.Path)
+		out := output(name)
+		err := m.runInWorktreePath(name, info.Path, command, nil, out, out)
+		return errors.Join(err, out.Close())
+	})
+}
+
+func (m *Manager) runInWorktreePath(worktreeName, worktreePath string, command []string, stdin io.Reader, stdout, stderr io.Writer) error {
+	if len(command) == 0 {
+		return errors.New("no command given")
+	}
+
+	branch, err := m.gitManager.GetCurrentBranchInPath(worktreePath)
+	if err != nil || branch == "HEAD" {
+		branch = "" // Detached HEAD
+	}
+
+	cmd := exec.Command(command[0], command[1:]...)
+	cmd.Dir = worktreePath
+	cmd.Env = hookEnv(worktreeName, worktreePath, "GBM_BRANCH="+branch)
+	cmd.Stdin = stdin
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+
+	return cmd.Run()
+}
@@ -0,0 +1,70 @@
+package internal
+
+import (
+	"bytes"
+	"io"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type closingBuffer struct {
+	bytes.Buffer
+	closed bool
+}
+
+func (b *closingBuffer) Close() error {
+	b.closed = true
+	return nil
+}
+
+func TestManager_RunInWorktree(t *testing.T) {
+	manager, _, _ := setupManagerForRemoverTests(t)
+
+	var stdout, stderr bytes.Buffer
+	err := manager.RunInWorktree("dev", []string{"sh", "-c", `echo "$GBM_WORKTREE_NAME $GBM_BRANCH $(basename "$PWD")"; echo oops >&2`}, nil, &stdout, &stderr)
+	require.NoError(t, err)
+	assert.Equal(t, "dev dev dev\n", stdout.String())
+	assert.Equal(t, "oops\n", stderr.String())
+
+	err = manager.RunInWorktree("dev", []string{"sh", "-c", "exit 3"}, nil, io.Discard, io.Discard)
+	assert.ErrorContains(t, err, "exit status 3")
+
+	err = manager.RunInWorktree("missing", []string{"true"}, nil, io.Discard, io.Discard)
+	assert.Error(t, err)
+
+	err = manager.RunInWorktree("dev", nil, nil, io.Discard, io.Discard)
+	assert.ErrorContains(t, err, "no command given")
+}
+
+func TestManager_RunInAllWorktrees(t *testing.T) {
+	manager, _, _ := setupManagerForRemoverTests(t)
+
+	for _, parallel := range []bool{false, true} {
+		var mu sync.Mutex
+		outputs := map[string]*closingBuffer{}
+		output := func(worktreeName string) io.WriteCloser {
+			mu.Lock()
+			defer mu.Unlock()
+			outputs[worktreeName] = &closingBuffer{}
+			return outputs[worktreeName]
+		}
+
+		results, err := manager.RunInAllWorktrees([]string{"sh", "-c", `echo "$GBM_WORKTREE_NAME"; [ "$GBM_BRANCH" != feat ]`}, parallel, output)
+		assert.ErrorContains(t, err, "exec failed for 1 of 2 worktrees")
+
+		require.Len(t, results, 2)
+		assert.Equal(t, "dev", results[0].Name)
+		assert.NoError(t, results[0].Err)
+		assert.Equal(t, "feat", results[1].Name)
+		assert.Error(t, results[1].Err)
+
+		for _, name := range []string{"dev", "feat"} {
+			require.Contains(t, outputs, name)
+			assert.Equal(t, name+"\n", outputs[name].String())
+			assert.True(t, outputs[name].closed)
+		}
+	}
+}
@@ -0,0 +1,261 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// WorktreePrefixChange describes worktrees left behind under a previous settings.worktree_prefix
+type WorktreePrefixChange struct {
+	OldPrefix string
+	NewPrefix string
+	Worktrees []*WorktreeInfo
+}
+
+// CheckWorktreePrefixChange compares the configured worktree prefix with the one recorded in state.
+// It returns nil when nothing needs migrating; in that case the current prefix is recorded (once
+// worktrees exist under it) so that a later change can be detected.
+func (m *Manager) CheckWorktreePrefixChange() (*WorktreePrefixChange, error) {
+	current := m.config.Settings.WorktreePrefix
+	previous := m.state.WorktreePrefix
+	if previous == current {
+		return nil, nil
+	}
+
+	if previous != "" {
+		stranded, err := m.worktreesUnderPrefix(previous)
+		if err != nil {
+			return nil, err
+		}
+		if len(stranded) > 0 {
+			return &WorktreePrefixChange{OldPrefix: previous, NewPrefix: current, Worktrees: stranded}, nil
+		}
+	} else {
+		// Don't write state into repositories gbm hasn't created any worktrees in yet
+		managed, err := m.worktreesUnderPrefix(current)
+		if err != nil {
+			return nil, err
+		}
+		if len(managed) == 0 {
+			return nil, nil
+		}
+	}
+
+	m.state.WorktreePrefix = current
+	if err := m.SaveState(); err != nil {
+		return nil, fmt.Errorf("failed to record worktree prefix: %w", err)
+	}
+
+	return nil, nil
+}
+
+// MigrateWorktreePrefix moves every worktree under oldPrefix into newPrefix with `git worktree move`,
+// which also updates git's worktree metadata. Worktrees that fail to move (e.g. locked ones) are
+// reported together; the new prefix is only recorded in state once all of them have moved.
+func (m *Manager) MigrateWorktreePrefix(oldPrefix, newPrefix string) error {
+	if oldPrefix == "" || newPrefix == "" {
+		return fmt.Errorf("worktree prefix must not be empty")
+	}
+	if oldPrefix == newPrefix {
+		return fmt.Errorf("worktree prefix is already '%s'", newPrefix)
+	}
+
+	unlock, err := m.lockRepo(true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	worktrees, err := m.worktreesUnderPrefix(oldPrefix)
+	if err != nil {
+		return err
+	}
+
+	oldRoot := filepath.Join(m.repoPath, oldPrefix)
+	newRoot := filepath.Join(m.repoPath, newPrefix)
+	if err := os.MkdirAll(newRoot, 0o755); err != nil {
+		return fmt.Errorf("failed to create worktree directory %s: %w", newRoot, err)
+	}
+
+	var errs []error
+	for _, wt := range worktrees {
+		target := filepath.Join(newRoot, wt.Name)
+		if _, err := os.Stat(target); err == nil {
+			errs = append(errs, fmt.Errorf("worktree '%s': %s already exists", wt.Name, target))
+			continue
+		}
+
+		logVerbose("Moving worktree %s to %s", wt.Path, target)
+		if err := m.gitManager.MoveWorktree(wt.Path, target); err != nil {
+			errs = append(errs, fmt.Errorf("worktree '%s': %w", wt.Name, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to move %d of %d worktrees: %w", len(errs), len(worktrees), errors.Join(errs...))
+	}
+
+	// Best-effort cleanup; the old directory may still hold files gbm doesn't manage
+	_ = os.Remove(oldRoot)
+
+	m.state.WorktreePrefix = newPrefix
+	if err := m.SaveState(); err != nil {
+		return fmt.Errorf("worktrees moved but failed to save state: %w", err)
+	}
+
+	return nil
+}
+
+// worktreesUnderPrefix returns the git worktrees located directly under the given prefix directory
+func (m *Manager) worktreesUnderPrefix(prefix string) ([]*WorktreeInfo, error) {
+	worktrees, err := m.gitManager.GetWorktrees()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get worktrees: %w", err)
+	}
+
+	return m.filterWorktreesUnderPrefix(worktrees, prefix), nil
+}
+
+// filterWorktreesUnderPrefix returns the worktrees located directly under the given prefix directory
+func (m *Manager) filterWorktreesUnderPrefix(worktrees []*WorktreeInfo, prefix string) []*WorktreeInfo {
+	prefixDir := m.resolvedPrefixDir(prefix)
+
+	var matches []*WorktreeInfo
+	for _, wt := range worktrees {
+		if isWorktreeInDir(wt.Path, prefixDir) {
+			matches = append(matches, wt)
+		}
+	}
+
+	return matches
+}
+
+// resolvedWorktreePrefix returns the directory of settings.worktree_prefix with symlinks resolved
+func (m *Manager) resolvedWorktreePrefix() string {
+	return m.resolvedPrefixDir(m.config.Settings.WorktreePrefix)
+}
+
+// resolvedPrefixDir returns the directory of a worktree prefix with symlinks resolved. git records
+// worktree paths with symlinks resolved, so the prefix has to be compared the same way (e.g. /var
+// vs /private/var on macOS, or a prefix that is itself a symlink).
+func (m *Manager) resolvedPrefixDir(prefix string) string {
+	return resolvePath(filepath.Join(m.repoPath, prefix))
+}
+
+// isWorktreeInDir reports whether the worktree at path lives directly in resolvedDir. Only the parent
+// is resolved, so a worktree whose directory was deleted still matches, and worktrees nested inside
+// another worktree (or inside a prefix that points into one) don't.
+func isWorktreeInDir(path, resolvedDir string) bool {
+	return resolvePath(filepath.Dir(filepath.Clean(path))) == resolvedDir
+}
+
+// PlanWorktreeRootRelocation validates moving every worktree to newPrefix (relative to the
+// repository, e.g. "../gbm-worktrees") and returns the worktrees that would move. Nothing is
+// changed on disk.
+func (m *Manager) PlanWorktreeRootRelocation(newPrefix string) (*WorktreePrefixChange, error) {
+	oldPrefix := m.config.Settings.WorktreePrefix
+	if err := ValidateWorktreePrefix(newPrefix); err != nil {
+		return nil, err
+	}
+
+	oldRoot := filepath.Join(m.repoPath, oldPrefix)
+	newRoot := filepath.Join(m.repoPath, newPrefix)
+	if newRoot == oldRoot {
+		return nil, fmt.Errorf("worktree prefix is already '%s'", oldPrefix)
+	}
+	if isWithinDir(newRoot, oldRoot) {
+		return nil, fmt.Errorf("cannot move worktrees into %s, which is inside the current worktree directory %s", newRoot, oldRoot)
+	}
+
+	worktrees, err := m.gitManager.GetWorktrees()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get worktrees: %w", err)
+	}
+	// The main worktree comes first; the worktree directory normally lives inside it
+	for _, wt := range worktrees[min(1, len(worktrees)):] {
+		if isWithinDir(newRoot, wt.Path) {
+			return nil, fmt.Errorf("cannot move worktrees into %s, which is inside worktree '%s'", newRoot, wt.Name)
+		}
+	}
+
+	moving := m.filterWorktreesUnderPrefix(worktrees, oldPrefix)
+	for _, wt := range moving {
+		if target := filepath.Join(newRoot, wt.Name); pathExists(target) {
+			return nil, fmt.Errorf("cannot move worktree '%s': %s already exists", wt.Name, target)
+		}
+	}
+
+	if err := checkDirWritable(newRoot); err != nil {
+		return nil, err
+	}
+
+	return &WorktreePrefixChange{OldPrefix: oldPrefix, NewPrefix: newPrefix, Worktrees: moving}, nil
+}
+
+// RelocateWorktreeRoot moves every worktree, tracked and ad hoc, to newPrefix with `git worktree
+// move` and saves newPrefix as settings.worktree_prefix
+func (m *Manager) RelocateWorktreeRoot(newPrefix string) error {
+	unlock, err := m.lockRepo(true)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	change, err := m.PlanWorktreeRootRelocation(newPrefix)
+	if err != nil {
+		return err
+	}
+
+	if len(change.Worktrees) > 0 {
+		if err := m.MigrateWorktreePrefix(change.OldPrefix, change.NewPrefix); err != nil {
+			return err
+		}
+	} else {
+		m.state.WorktreePrefix = newPrefix
+		if err := m.SaveState(); err != nil {
+			return fmt.Errorf("failed to save state: %w", err)
+		}
+	}
+
+	m.config.Settings.WorktreePrefix = newPrefix
+	m.gitManager.worktreePrefix = newPrefix
+	if err := m.SaveConfig(); err != nil {
+		return fmt.Errorf("worktrees moved but failed to save settings.worktree_prefix: %w", err)
+	}
+
+	return nil
+}
+
+// isWithinDir reports whether path is dir or lies below it
+func isWithinDir(path, dir string) bool {
+	rel, err := filepath.Rel(dir, path)
+	return err == nil && filepath.IsLocal(rel)
+}
+
+func pathExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// checkDirWritable verifies files can be created in dir or, when it doesn't exist yet, in its
+// nearest existing parent
+func checkDirWritable(dir string) error {
+	existing := dir
+	for !pathExists(existing) {
+		parent := filepath.Dir(existing)
+		if parent == existing {
+			break
+		}
+		existing = parent
+	}
+
+	probe, err := os.CreateTemp(existing, ".gbm-write-test-*")
+	if err != nil {
+		return fmt.Errorf("cannot create %s: %s is not writable: %w", dir, existing, err)
+	}
+	_ = probe.Close()
+	_ = os.Remove(probe.Name())
+	return nil
+}
@@ -0,0 +1,191 @@
+package internal
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gbm/internal/testutils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestManager_WorktreePrefixMigration(t *testing.T) {
+	manager, repoPath, _ := setupManagerForRemoverTests(t)
+
+	// The first check records the prefix the existing worktrees live under
+	change, err := manager.CheckWorktreePrefixChange()
+	require.NoError(t, err)
+	assert.Nil(t, change)
+	assert.Equal(t, "worktrees", manager.GetState().WorktreePrefix)
+
+	// After the prefix changes the old worktrees are hidden from GetAllWorktrees
+	manager.GetConfig().Settings.WorktreePrefix = "wt"
+	worktrees, err := manager.GetAllWorktrees()
+	require.NoError(t, err)
+	assert.Empty(t, worktrees)
+
+	change, err = manager.CheckWorktreePrefixChange()
+	require.NoError(t, err)
+	require.NotNil(t, change)
+	assert.Equal(t, "worktrees", change.OldPrefix)
+	assert.Equal(t, "wt", change.NewPrefix)
+	assert.Len(t, change.Worktrees, 2)
+
+	require.NoError(t, manager.MigrateWorktreePrefix(change.OldPrefix, change.NewPrefix))
+
+	worktrees, err = manager.GetAllWorktrees()
+	require.NoError(t, err)
+	assert.Len(t, worktrees, 2)
+	assert.DirExists(t, filepath.Join(repoPath, "wt", "dev"))
+	assert.NoDirExists(t, filepath.Join(repoPath, "worktrees"))
+
+	change, err = manager.CheckWorktreePrefixChange()
+	require.NoError(t, err)
+	assert.Nil(t, change)
+
+	state, err := LoadState(filepath.Join(repoPath, DefaultConfigDirname))
+	require.NoError(t, err)
+	assert.Equal(t, "wt", state.WorktreePrefix)
+
+	assert.ErrorContains(t, manager.MigrateWorktreePrefix("wt", "wt"), "already 'wt'")
+}
+
+func TestManager_WorktreePrefixMigration_LockedWorktree(t *testing.T) {
+	manager, repoPath, _ := setupManagerForRemoverTests(t)
+	_, err := manager.CheckWorktreePrefixChange()
+	require.NoError(t, err)
+
+	must(t, execGitCommandRun(repoPath, "worktree", "lock", filepath.Join(repoPath, "worktrees", "feat")))
+
+	err = manager.MigrateWorktreePrefix("worktrees", "wt")
+	assert.ErrorContains(t, err, "failed to move 1 of 2 worktrees")
+	assert.ErrorContains(t, err, "worktree 'feat'")
+	assert.DirExists(t, filepath.Join(repoPath, "wt", "dev"))
+	assert.Equal(t, "worktrees", manager.GetState().WorktreePrefix, "prefix is only recorded once every worktree has moved")
+}
+
+func TestManager_RelocateWorktreeRoot(t *testing.T) {
+	manager, repoPath, _ := setupManagerForRemoverTests(t)
+
+	newRoot := filepath.Join(t.TempDir(), "gbm-worktrees")
+	newPrefix, err := filepath.Rel(repoPath, newRoot)
+	require.NoError(t, err)
+
+	_, err = manager.PlanWorktreeRootRelocation("worktrees")
+	assert.ErrorContains(t, err, "already 'worktrees'")
+	_, err = manager.PlanWorktreeRootRelocation(newRoot)
+	assert.ErrorContains(t, err, "must be relative")
+	_, err = manager.PlanWorktreeRootRelocation("worktrees/nested")
+	assert.ErrorContains(t, err, "inside the current worktree directory")
+	_, err = manager.PlanWorktreeRootRelocation(filepath.Join("worktrees", "..", "worktrees", "dev", "wt"))
+	assert.ErrorContains(t, err, "inside the current worktree directory")
+
+	outside := filepath.Join(t.TempDir(), "outside")
+	must(t, execGitCommandRun(repoPath, "worktree", "add", "-b", "outside", outside))
+	outsidePrefix, err := filepath.Rel(repoPath, filepath.Join(outside, "wt"))
+	require.NoError(t, err)
+	_, err = manager.PlanWorktreeRootRelocation(outsidePrefix)
+	assert.ErrorContains(t, err, "inside worktree 'outside'")
+
+	// Planning doesn't touch the disk
+	change, err := manager.PlanWorktreeRootRelocation(newPrefix)
+	require.NoError(t, err)
+	assert.Equal(t, "worktrees", change.OldPrefix)
+	assert.Len(t, change.Worktrees, 2)
+	assert.NoDirExists(t, newRoot)
+
+	require.NoError(t, manager.RelocateWorktreeRoot(newPrefix))
+	assert.DirExists(t, filepath.Join(newRoot, "dev"))
+	assert.DirExists(t, filepath.Join(newRoot, "feat"))
+	assert.NoDirExists(t, filepath.Join(repoPath, "worktrees"))
+
+	worktrees, err := manager.GetAllWorktrees()
+	require.NoError(t, err)
+	assert.Len(t, worktrees, 2)
+
+	config, err := LoadConfig(filepath.Join(repoPath, DefaultConfigDirname))
+	require.NoError(t, err)
+	assert.Equal(t, newPrefix, config.Settings.WorktreePrefix)
+	state, err := LoadState(filepath.Join(repoPath, DefaultConfigDirname))
+	require.NoError(t, err)
+	assert.Equal(t, newPrefix, state.WorktreePrefix)
+	// The relocated prefix points outside the repository and still passes config validation
+	for _, problem := range ValidateConfigFiles(repoPath) {
+		assert.NotContains(t, problem.Message, "worktree_prefix")
+	}
+
+	// New worktrees are created under the new prefix too
+	require.NoError(t, manager.AddWorktree("hotfix", "hotfix", true, "main"))
+	assert.DirExists(t, filepath.Join(newRoot, "hotfix"))
+}
+
+func TestManager_WorktreePrefixSymlinks(t *testing.T) {
+	sourceRepo := testutils.NewMultiBranchRepo(t)
+	defer sourceRepo.Cleanup()
+	require.NoError(t, sourceRepo.CreateGBMConfig(map[string]testutils.WorktreeConfig{
+		"main": {Branch: "main", Description: "Main branch"},
+		"dev":  {Branch: "develop", Description: "Development branch"},
+	}))
+	require.NoError(t, sourceRepo.CommitChangesWithForceAdd("Add initial gbm config"))
+	require.NoError(t, sourceRepo.PushBranch("main"))
+
+	// Lay out the temp dir like macOS, where /var is a symlink to /private/var and git reports
+	// the resolved paths
+	tmp := t.TempDir()
+	privateVar := filepath.Join(tmp, "private", "var")
+	require.NoError(t, os.MkdirAll(filepath.Join(privateVar, "worktree-store"), 0o755))
+	require.NoError(t, os.Symlink(privateVar, filepath.Join(tmp, "var")))
+
+	repoPath := filepath.Join(tmp, "var", "repo")
+	require.NoError(t, execGitCommandRun(filepath.Join(tmp, "var"), "clone", sourceRepo.GetRemotePath(), "repo"))
+
+	// The worktree prefix is itself a symlink to a directory elsewhere
+	require.NoError(t, os.Symlink(filepath.Join(privateVar, "worktree-store"), filepath.Join(repoPath, "worktrees")))
+
+	originalDir, _ := os.Getwd()
+	t.Cleanup(func() { _ = os.Chdir(originalDir) })
+	require.NoError(t, os.Chdir(repoPath))
+
+	manager, err := NewManager(repoPath)
+	require.NoError(t, err)
+	require.NoError(t, manager.LoadGBMConfig(""))
+	require.NoError(t, manager.SyncWithConfirmation(SyncOptions{}, func(string) bool { return true }))
+
+	devPath := filepath.Join(repoPath, "worktrees", "dev")
+	assert.FileExists(t, filepath.Join(privateVar, "worktree-store", "dev", ".git"))
+
+	// A worktree nested inside another one is not a managed worktree
+	require.NoError(t, execGitCommandRun(devPath, "worktree", "add", "-b", "scratch", filepath.Join(devPath, "scratch")))
+
+	status, err := manager.GetSyncStatus()
+	require.NoError(t, err)
+	assert.True(t, status.InSync, "status: %+v", status)
+	assert.Empty(t, status.OrphanedWorktrees)
+
+	worktrees, err := manager.GetAllWorktrees()
+	require.NoError(t, err)
+	assert.Contains(t, worktrees, "dev")
+	assert.NotContains(t, worktrees, "scratch")
+
+	inWorktree, name, err := manager.IsInWorktree(devPath)
+	require.NoError(t, err)
+	assert.True(t, inWorktree)
+	assert.Equal(t, "dev", name)
+
+	inWorktree, _, err = manager.IsInWorktree(filepath.Join(devPath, "scratch"))
+	require.NoError(t, err)
+	assert.False(t, inWorktree)
+
+	// A worktree deleted by hand is still recognised as managed, and sync recreates it
+	require.NoError(t, execGitCommandRun(devPath, "worktree", "remove", "--force", filepath.Join(devPath, "scratch")))
+	require.NoError(t, os.RemoveAll(devPath))
+	status, err = manager.GetSyncStatus()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dev"}, status.MissingWorktrees)
+	assert.Empty(t, status.OrphanedWorktrees)
+
+	require.NoError(t, manager.SyncWithConfirmation(SyncOptions{}, func(string) bool { return true }))
+	assert.FileExists(t, filepath.Join(devPath, ".git"))
+}
@@ -2,6 +2,9 @@ package internal
 
 import (
 	"fmt"
+	"maps"
+	"slices"
+	"strings"
 )
 
 // WorktreeNode represents a node in the worktree tree
@@ -37,6 +40,9 @@ func NewWorktreeManager(config *GBMConfig) (*WorktreeManager, error) {
 
 	// Second pass: establish parent-child relationships
 	for name, node := range manager.nodes {
+		if node.Config.MergeInto == name {
+			return nil, fmt.Errorf("%w: worktree '%s' has merge_into set to itself", ErrSelfMerge, name)
+		}
 		if node.Config.MergeInto != "" {
 			// MergeInto contains the worktree name, not branch name
 			parent, exists := manager.nodes[node.Config.MergeInto]
@@ -56,48 +62,79 @@ func NewWorktreeManager(config *GBMConfig) (*WorktreeManager, error) {
 		return nil, err
 	}
 
+	if err := manager.detectDuplicateBranches(); err != nil {
+		return nil, err
+	}
+
 	return manager, nil
 }
 
 var ErrNoRootNodesFound = fmt.Errorf("no root nodes found (all nodes have merge_into)")
 var ErrCircularDependency = fmt.Errorf("circular dependency detected")
+var ErrSelfMerge = fmt.Errorf("worktree merges into itself")
+var ErrAmbiguousMergeChain = fmt.Errorf("ambiguous merge chain")
+var ErrDuplicateBranch = fmt.Errorf("duplicate branch")
 
-// detectCycles uses DFS to detect circular dependencies in the worktree tree
+// detectCycles follows each worktree's merge_into chain and reports the first loop it finds,
+// e.g. "preview → production → preview". Worktrees are visited in name order so the reported
+// chain is stable.
 func (wm *WorktreeManager) detectCycles() error {
-	visited := make(map[string]bool)
-	recStack := make(map[string]bool)
+	checked := make(map[string]bool)
+
+	for _, start := range slices.Sorted(maps.Keys(wm.nodes)) {
+		var chain []string
+		position := make(map[string]int)
 
-	for name := range wm.nodes {
-		if !visited[name] {
-			if wm.dfsHasCycle(name, visited, recStack) {
-				return fmt.Errorf("%w: worktree '%s' is part of a circular dependency", ErrCircularDependency, name)
+		for name := start; name != "" && !checked[name]; name = wm.nodes[name].Config.MergeInto {
+			if i, onChain := position[name]; onChain {
+				cycle := append(chain[i:], name)
+				return fmt.Errorf("%w: %s", ErrCircularDependency, strings.Join(cycle, " → "))
 			}
+			position[name] = len(chain)
+			chain = append(chain, name)
+		}
+
+		for _, name := range chain {
+			checked[name] = true
 		}
 	}
 	return nil
 }
 
-// dfsHasCycle performs depth-first search to detect cycles
-func (wm *WorktreeManager) dfsHasCycle(nodeName string, visited, recStack map[string]bool) bool {
-	visited[nodeName] = true
-	recStack[nodeName] = true
+// detectDuplicateBranches reports a branch declared by several worktrees. A branch can only be
+// checked out once, and mergeback follows branches through the tree, so one declared with different
+// merge_into targets (e.g. "feature" merging into both staging and main) is reported as ambiguous.
+func (wm *WorktreeManager) detectDuplicateBranches() error {
+	worktreesByBranch := make(map[string][]string)
+	for name, node := range wm.nodes {
+		worktreesByBranch[node.Config.Branch] = append(worktreesByBranch[node.Config.Branch], name)
+	}
 
-	node := wm.nodes[nodeName]
+	for _, branch := range slices.Sorted(maps.Keys(worktreesByBranch)) {
+		names := worktreesByBranch[branch]
+		slices.Sort(names)
 
-	// Follow the merge_into relationship (parent relationship)
-	if node.Config.MergeInto != "" {
-		if !visited[node.Config.MergeInto] {
-			if wm.dfsHasCycle(node.Config.MergeInto, visited, recStack) {
-				return true
+		targets := make([]string, 0, len(names))
+		ambiguous := false
+		for _, name := range names {
+			target := wm.nodes[name].Config.MergeInto
+			if target != wm.nodes[names[0]].Config.MergeInto {
+				ambiguous = true
 			}
-		} else if recStack[node.Config.MergeInto] {
-			// Found a back edge - cycle detected
-			return true
+			if target == "" {
+				target = "(root)"
+			}
+			targets = append(targets, fmt.Sprintf("%s → %s", name, target))
 		}
-	}
 
-	recStack[nodeName] = false
-	return false
+		if ambiguous {
+			return fmt.Errorf("%w: branch '%s' merges into different targets: %s", ErrAmbiguousMergeChain, branch, strings.Join(targets, ", "))
+		}
+		if len(names) > 1 {
+			return fmt.Errorf("%w: branch '%s' is assigned to multiple worktrees: %s", ErrDuplicateBranch, branch, strings.Join(names, ", "))
+		}
+	}
+	return nil
 }
 
 // GetNode returns a node by name
@@ -146,6 +183,22 @@ func (wn *WorktreeNode) GetChildren() []*WorktreeNode {
 	return wn.Children
 }
 
+// GetSiblings returns the other children of this node's parent (worktrees merging into the same
+// target). Root nodes have no siblings.
+func (wn *WorktreeNode) GetSiblings() []*WorktreeNode {
+	if wn.Parent == nil {
+		return nil
+	}
+
+	siblings := make([]*WorktreeNode, 0, len(wn.Parent.Children)-1)
+	for _, child := range wn.Parent.Children {
+		if child != wn {
+			siblings = append(siblings, child)
+		}
+	}
+	return siblings
+}
+
 // GetPath returns the path from this node to the root
 func (wn *WorktreeNode) GetPath() []*WorktreeNode {
 	path := make([]*WorktreeNode, 0)
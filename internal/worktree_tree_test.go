@@ -112,7 +112,78 @@ func TestNewWorktreeManager(t *testing.T) {
 			},
 			expectErr: func(t *testing.T, err error) {
 				require.Error(t, err)
-				assert.Contains(t, err.Error(), "circular dependency detected")
+				assert.ErrorIs(t, err, ErrCircularDependency)
+				assert.EqualError(t, err, "circular dependency detected: preview → production → preview")
+			},
+		},
+		{
+			name: "Cycle below a root reports only the loop",
+			config: &GBMConfig{
+				Worktrees: map[string]WorktreeConfig{
+					"master":  {Branch: "master"},
+					"feature": {Branch: "feature", MergeInto: "preview"},
+					"preview": {Branch: "preview", MergeInto: "staging"},
+					"staging": {Branch: "staging", MergeInto: "feature"},
+				},
+			},
+			expectErr: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, ErrCircularDependency)
+				assert.EqualError(t, err, "circular dependency detected: feature → preview → staging → feature")
+			},
+		},
+		{
+			name: "Self merge",
+			config: &GBMConfig{
+				Worktrees: map[string]WorktreeConfig{
+					"master":  {Branch: "master"},
+					"preview": {Branch: "preview", MergeInto: "preview"},
+				},
+			},
+			expectErr: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, ErrSelfMerge)
+				assert.Contains(t, err.Error(), "worktree 'preview' has merge_into set to itself")
+			},
+		},
+		{
+			name: "Same branch with different merge targets",
+			config: &GBMConfig{
+				Worktrees: map[string]WorktreeConfig{
+					"main":     {Branch: "main"},
+					"staging":  {Branch: "staging", MergeInto: "main"},
+					"hotfix":   {Branch: "hotfix", MergeInto: "staging"},
+					"hotfix-2": {Branch: "hotfix", MergeInto: "main"},
+				},
+			},
+			expectErr: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, ErrAmbiguousMergeChain)
+				assert.EqualError(t, err, "ambiguous merge chain: branch 'hotfix' merges into different targets: hotfix → staging, hotfix-2 → main")
+			},
+		},
+		{
+			name: "Same branch as a root and a child",
+			config: &GBMConfig{
+				Worktrees: map[string]WorktreeConfig{
+					"main":    {Branch: "main"},
+					"release": {Branch: "main", MergeInto: "main"},
+				},
+			},
+			expectErr: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, ErrAmbiguousMergeChain)
+				assert.Contains(t, err.Error(), "main → (root), release → main")
+			},
+		},
+		{
+			name: "Same branch with the same merge target",
+			config: &GBMConfig{
+				Worktrees: map[string]WorktreeConfig{
+					"main":    {Branch: "main"},
+					"dev":     {Branch: "develop", MergeInto: "main"},
+					"staging": {Branch: "develop", MergeInto: "main"},
+				},
+			},
+			expectErr: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, ErrDuplicateBranch)
+				assert.EqualError(t, err, "duplicate branch: branch 'develop' is assigned to multiple worktrees: dev, staging")
 			},
 		},
 	}
@@ -223,6 +294,25 @@ func TestWorktreeNode_Relationships(t *testing.T) {
 	assert.Len(t, productionChildren, 0)
 }
 
+func TestWorktreeNode_GetSiblings(t *testing.T) {
+	config := &GBMConfig{
+		Worktrees: map[string]WorktreeConfig{
+			"master":     {Branch: "master"},
+			"preview":    {Branch: "preview", MergeInto: "master"},
+			"hotfix":     {Branch: "hotfix", MergeInto: "master"},
+			"production": {Branch: "production", MergeInto: "preview"},
+		},
+	}
+
+	manager, err := NewWorktreeManager(config)
+	require.NoError(t, err)
+
+	assert.Nil(t, manager.GetNode("master").GetSiblings())
+	assert.Equal(t, []*WorktreeNode{manager.GetNode("hotfix")}, manager.GetNode("preview").GetSiblings())
+	assert.Equal(t, []*WorktreeNode{manager.GetNode("preview")}, manager.GetNode("hotfix").GetSiblings())
+	assert.Empty(t, manager.GetNode("production").GetSiblings())
+}
+
 func TestWorktreeNode_GetPath(t *testing.T) {
 	config := &GBMConfig{
 		Worktrees: map[string]WorktreeConfig{
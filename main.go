@@ -10,7 +10,7 @@ func main() {
 	defer cmd.CloseLogFile()
 
 	if err := cmd.Execute(); err != nil {
-		cmd.PrintError("Error: %v", err)
-		os.Exit(1)
+		cmd.ReportError(err)
+		os.Exit(cmd.ExitCode(err))
 	}
 }